@@ -1,47 +1,19 @@
 package client
 
 import (
-	"github.com/spf13/viper"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
-	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/sdkclient"
+
 	"github.com/stackitcloud/stackit-sdk-go/services/foo"
-	// (...)
 )
 
-func ConfigureClient(p *print.Printer, cliVersion string) (*foo.APIClient, error) {
-	authCfgOption, err := auth.AuthenticationConfig(p, auth.AuthorizeUser)
-	if err != nil {
-		return nil, &errors.AuthError{}
-	}
-
-	region := viper.GetString(config.RegionKey)
-	cfgOptions := []sdkConfig.ConfigurationOption{
-		utils.UserAgentConfigOption(cliVersion),
-		sdkConfig.WithRegion(region), // Configuring region is needed if "foo" is a regional API
-		authCfgOption,
-	}
-
-	customEndpoint := viper.GetString(config.fooCustomEndpointKey)
-
-	if customEndpoint != "" {
-		cfgOptions = append(cfgOptions, sdkConfig.WithEndpoint(customEndpoint))
-	}
-
-	if p.IsVerbosityDebug() {
-		cfgOptions = append(cfgOptions,
-			sdkConfig.WithMiddleware(print.RequestResponseCapturer(p, nil)),
-		)
-	}
-
-	apiClient, err := foo.NewAPIClient(cfgOptions...)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "create new API client: %v", err)
-		return nil, &errors.AuthError{}
-	}
+var service = sdkclient.Service[*foo.APIClient]{
+	CustomEndpointKey: config.FooCustomEndpointKey,
+	Region:            sdkclient.RegionAlways, // Use sdkclient.RegionNone if "foo" is not a regional API
+	NewAPIClient:      foo.NewAPIClient,
+}
 
-	return apiClient, nil
+func ConfigureClient(p *print.Printer, cliVersion string) (*foo.APIClient, error) {
+	return sdkclient.ConfigureClient(p, cliVersion, service)
 }
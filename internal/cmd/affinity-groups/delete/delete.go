@@ -10,6 +10,7 @@ import (
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
@@ -40,7 +41,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -83,6 +84,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			return nil
 		},
 	}
+	journal.Enable(cmd)
 	return cmd
 }
 
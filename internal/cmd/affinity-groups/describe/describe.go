@@ -39,7 +39,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -112,7 +112,7 @@ func outputResult(p *print.Printer, model inputModel, resp iaas.AffinityGroup) e
 			table.AddSeparator()
 		}
 
-		if err := table.Display(p); err != nil {
+		if err := table.Display(p, outputFormat); err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
 		return nil
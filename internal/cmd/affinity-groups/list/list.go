@@ -12,6 +12,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -43,7 +44,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -63,6 +64,11 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 
 			if items := result.Items; items != nil {
+				filteredItems, err := filter.Items(model.Filter, *items)
+				if err != nil {
+					return err
+				}
+				*items = filteredItems
 				if model.Limit != nil && len(*items) > int(*model.Limit) {
 					*items = (*items)[:*model.Limit]
 				}
@@ -79,6 +85,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func buildRequest(ctx context.Context, model inputModel, apiClient *iaas.APIClient) iaas.ApiListAffinityGroupsRequest {
@@ -108,6 +116,12 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 	return &model, nil
 }
 
+var affinityGroupFields = []tables.Field[iaas.AffinityGroup]{
+	{Header: "ID", Value: func(ag *iaas.AffinityGroup) any { return utils.PtrString(ag.Id) }},
+	{Header: "NAME", Value: func(ag *iaas.AffinityGroup) any { return utils.PtrString(ag.Name) }},
+	{Header: "POLICY", Value: func(ag *iaas.AffinityGroup) any { return utils.PtrString(ag.Policy) }},
+}
+
 func outputResult(p *print.Printer, model inputModel, items []iaas.AffinityGroup) error {
 	var outputFormat string
 	if model.GlobalFlagModel != nil {
@@ -115,18 +129,10 @@ func outputResult(p *print.Printer, model inputModel, items []iaas.AffinityGroup
 	}
 
 	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "POLICY")
-		for _, item := range items {
-			table.AddRow(
-				utils.PtrString(item.Id),
-				utils.PtrString(item.Name),
-				utils.PtrString(item.Policy),
-			)
-			table.AddSeparator()
-		}
+		table := tables.NewTableFromFields(affinityGroupFields, items)
+		table.EnableRowSeparators()
 
-		if err := table.Display(p); err != nil {
+		if err := table.Display(p, outputFormat); err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
 
@@ -0,0 +1,36 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/delete"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/set"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Provides functionality for command aliases",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Provides functionality for command aliases.",
+			`An alias is a shortcut for a command and its flags, e.g. "stackit kc my-cluster" for "stackit ske kubeconfig create my-cluster --login --overwrite".`,
+			"Aliases are stored in the configuration of the active profile. They can't shadow built-in commands.",
+		),
+		Args: args.NoArgs,
+		Run:  utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *params.CmdParams) {
+	cmd.AddCommand(set.NewCmd(params))
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(delete.NewCmd(params))
+}
@@ -0,0 +1,83 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/alias"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+
+	"github.com/spf13/cobra"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all command aliases",
+		Long:  "Lists all command aliases of the active profile.",
+		Args:  args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List the aliases`,
+				"$ stackit alias list"),
+			examples.NewExample(
+				`List the aliases in a json format`,
+				"$ stackit alias list --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			aliases, err := filter.Items(model.Filter, alias.List())
+			if err != nil {
+				return err
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, aliases)
+		},
+	}
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+
+	p.DebugInputModel(model)
+	return &model
+}
+
+var aliasFields = []tables.Field[alias.Alias]{
+	{Header: "NAME", Value: func(a *alias.Alias) any { return a.Name }},
+	{Header: "EXPANSION", Value: func(a *alias.Alias) any { return a.Expansion }},
+}
+
+func outputResult(p *print.Printer, outputFormat string, aliases []alias.Alias) error {
+	return p.OutputResult(outputFormat, aliases, func() error {
+		if len(aliases) == 0 {
+			p.Info("No aliases set\n")
+			return nil
+		}
+
+		table := tables.NewTableFromFields(aliasFields, aliases)
+		table.EnableRowSeparators()
+		err := table.Display(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
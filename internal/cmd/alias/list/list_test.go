@@ -0,0 +1,45 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/alias"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		aliases      []alias.Alias
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "aliases",
+			args: args{
+				aliases: []alias.Alias{
+					{Name: "kc", Expansion: "ske kubeconfig create $1 --login --overwrite"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	p := print.NewPrinter()
+	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(p, tt.args.outputFormat, tt.args.aliases); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/alias"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	nameArg      = "NAME"
+	expansionArg = "EXPANSION"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Name      string
+	Expansion string
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("set %s %s", nameArg, expansionArg),
+		Short: "Sets a command alias",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Sets a command alias, which is expanded when running \"stackit NAME [args]\".",
+			"The expansion is the command to run, without \"stackit\". Positional parameters ($1, $2, ...) in it are replaced with the arguments given to the alias, the other arguments are appended.",
+			"Quote the expansion, or pass it after \"--\" if it contains flags. An existing alias with the same name is replaced.",
+			"Aliases can't shadow built-in commands.",
+		),
+		Args: args.MinimumArgs(nameArg, expansionArg),
+		Example: examples.Build(
+			examples.NewExample(
+				`Set the alias "kc" to create a kubeconfig for the SKE cluster given as argument`,
+				"$ stackit alias set kc 'ske kubeconfig create $1 --login --overwrite'"),
+			examples.NewExample(
+				`Set the alias "prod-clusters" to list the SKE clusters of the project with ID "xxx"`,
+				"$ stackit alias set prod-clusters -- ske cluster list --project-id xxx"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if utils.IsBuiltinCommand(cmd.Root(), model.Name) {
+				return &errors.ArgValidationError{
+					Arg:     model.Name,
+					Details: "an alias can't shadow a built-in command",
+				}
+			}
+
+			if _, ok := alias.Get(model.Name); ok && !model.AssumeYes {
+				prompt := fmt.Sprintf("Are you sure you want to replace the alias %q?", model.Name)
+				err = params.Printer.PromptForConfirmation(prompt)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = alias.Set(model.Name, model.Expansion)
+			if err != nil {
+				return fmt.Errorf("set alias: %w", err)
+			}
+
+			params.Printer.Info("Set alias %q to %q\n", model.Name, model.Expansion)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	name := inputArgs[0]
+	err := alias.ValidateName(name)
+	if err != nil {
+		return nil, &errors.ArgValidationError{
+			Arg:     name,
+			Details: err.Error(),
+		}
+	}
+
+	expansion := buildExpansion(inputArgs[1:])
+	err = alias.ValidateExpansion(expansion)
+	if err != nil {
+		return nil, &errors.ArgValidationError{
+			Arg:     expansionArg,
+			Details: err.Error(),
+		}
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Name:            name,
+		Expansion:       expansion,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// A single argument is the expansion as it is, several arguments are quoted where needed and joined
+func buildExpansion(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word == "" || strings.ContainsAny(word, " \t\n'\"\\") {
+			word = "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+		}
+		quoted = append(quoted, word)
+	}
+	return strings.Join(quoted, " ")
+}
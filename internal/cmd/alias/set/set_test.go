@@ -0,0 +1,95 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+)
+
+const (
+	testName      = "kc"
+	testExpansion = "ske kubeconfig create $1 --login --overwrite"
+)
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testName,
+		testExpansion,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Name:      testName,
+		Expansion: testExpansion,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "expansion missing",
+			argValues:   []string{testName},
+			isValid:     false,
+		},
+		{
+			description: "expansion as several arguments",
+			argValues:   []string{testName, "server", "command", "create", "--script", "echo 'hello world'"},
+			isValid:     true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Expansion = `server command create --script 'echo '\''hello world'\'''`
+			}),
+		},
+		{
+			description: "invalid name",
+			argValues:   fixtureArgValues(func(argValues []string) { argValues[0] = "Kc" }),
+			isValid:     false,
+		},
+		{
+			description: "name with separator",
+			argValues:   fixtureArgValues(func(argValues []string) { argValues[0] = "k.c" }),
+			isValid:     false,
+		},
+		{
+			description: "unterminated quote",
+			argValues:   fixtureArgValues(func(argValues []string) { argValues[1] = "ske cluster describe 'my-cluster" }),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileFlag = "file"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	File string
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Makes a project match a manifest",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s",
+			"Makes a project match a manifest, by creating, updating and deleting the resources declared in it.",
+			"The manifest is a YAML file declaring networks, security groups, servers, DNS zones and record sets and PostgreSQL Flex instances, identified by their name.",
+			`Resources that don't exist are created and resources that differ are updated. Resources with "state: absent" are deleted, other resources of the project are left as they are.`,
+			"The changes are shown and confirmed before they are applied. They are applied in dependency order, e.g. networks before the servers attached to them, waiting for each of them to finish.",
+			`Run "stackit plan" to show the changes without applying them.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Make the project match the manifest in "env.yaml"`,
+				"$ stackit apply -f env.yaml"),
+			examples.NewExample(
+				`Make the project match the manifest in "env.yaml", without confirmation`,
+				"$ stackit apply -f env.yaml --assume-yes"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := apply.Load(model.File)
+			if err != nil {
+				return err
+			}
+			clients, err := apply.ConfigureClients(params.Printer, params.CliVersion, manifest.Services())
+			if err != nil {
+				return err
+			}
+			plan, err := apply.NewPlan(ctx, clients, model.ProjectId, model.Region, manifest)
+			if err != nil {
+				return fmt.Errorf("plan changes: %w", err)
+			}
+
+			params.Printer.Outputf("%s", plan.Diff())
+			if plan.IsEmpty() {
+				return nil
+			}
+
+			projectLabel, err := projectname.GetProjectName(ctx, params.Printer, params.CliVersion, cmd)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get project name: %v", err)
+				projectLabel = model.ProjectId
+			}
+
+			if !model.AssumeYes {
+				prompt := fmt.Sprintf("Are you sure you want to apply these changes to project %q?", projectLabel)
+				err = params.Printer.PromptForConfirmation(prompt)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = plan.Apply(ctx, params.Printer)
+			if err != nil {
+				return err
+			}
+			params.Printer.Outputf("Applied the manifest to project %q: %s.\n", projectLabel, plan.Summary())
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	journal.Enable(cmd)
+	// The changes depend on each other, "stackit plan" shows them instead
+	dryrun.Disable(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(fileFlag, "f", "", "Path to the manifest file, or to a directory whose YAML files are merged into one manifest")
+
+	err := flags.MarkFlagsRequired(cmd, fileFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		File:            flags.FlagToStringValue(p, cmd, fileFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
@@ -49,11 +49,16 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return &cliErr.SessionExpiredError{}
 			}
 
+			output := map[string]string{
+				"access_token": accessToken,
+			}
+			if print.IsTemplateOutputFormat(model.OutputFormat) {
+				return params.Printer.OutputResult(model.OutputFormat, output, nil)
+			}
+
 			switch model.OutputFormat {
 			case print.JSONOutputFormat:
-				details, err := json.MarshalIndent(map[string]string{
-					"access_token": accessToken,
-				}, "", "  ")
+				details, err := json.MarshalIndent(output, "", "  ")
 				if err != nil {
 					return fmt.Errorf("marshal image list: %w", err)
 				}
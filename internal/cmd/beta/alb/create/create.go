@@ -15,6 +15,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/alb/client"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta alb create --configuration my-loadbalancer.json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -98,6 +99,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
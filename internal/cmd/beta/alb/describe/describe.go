@@ -41,7 +41,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -101,7 +101,7 @@ func outputResult(p *print.Printer, outputFormat string, loadbalancer *alb.LoadB
 			content = append(content, buildTargetPoolsTable(*loadbalancer.TargetPools))
 		}
 
-		err := tables.DisplayTables(p, content)
+		err := tables.DisplayTables(p, content, outputFormat)
 		if err != nil {
 			return fmt.Errorf("display output: %w", err)
 		}
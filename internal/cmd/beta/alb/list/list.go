@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -77,6 +78,11 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			if items := response.LoadBalancers; items == nil || len(*items) == 0 {
 				params.Printer.Info("No load balancers found for project %q", projectLabel)
 			} else {
+				filteredItems, err := filter.Items(model.Filter, *items)
+				if err != nil {
+					return err
+				}
+				*items = filteredItems
 				if model.Limit != nil && len(*items) > int(*model.Limit) {
 					*items = (*items)[:*model.Limit]
 				}
@@ -95,6 +101,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -125,26 +133,26 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *alb.APIClie
 
 	return request
 }
+
+var loadBalancerFields = []tables.Field[alb.LoadBalancer]{
+	{Header: "NAME", Value: func(lb *alb.LoadBalancer) any { return utils.PtrString(lb.Name) }},
+	{Header: "EXTERNAL ADDRESS", Value: func(lb *alb.LoadBalancer) any { return utils.PtrString(lb.ExternalAddress) }},
+	{Header: "REGION", Value: func(lb *alb.LoadBalancer) any { return utils.PtrString(lb.Region) }},
+	{Header: "STATUS", Value: func(lb *alb.LoadBalancer) any { return utils.PtrString(lb.Status) }},
+	{Header: "VERSION", Value: func(lb *alb.LoadBalancer) any { return utils.PtrString(lb.Version) }},
+	{Header: "ERRORS", Value: func(lb *alb.LoadBalancer) any {
+		var errNo int
+		if lb.Errors != nil {
+			errNo = len(*lb.Errors)
+		}
+		return errNo
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, items []alb.LoadBalancer) error {
 	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NAME", "EXTERNAL ADDRESS", "REGION", "STATUS", "VERSION", "ERRORS")
-		for i := range items {
-			item := &items[i]
-
-			var errNo int
-			if item.Errors != nil {
-				errNo = len(*item.Errors)
-			}
-			table.AddRow(utils.PtrString(item.Name),
-				utils.PtrString(item.ExternalAddress),
-				utils.PtrString(item.Region),
-				utils.PtrString(item.Status),
-				utils.PtrString(item.Version),
-				errNo,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(loadBalancerFields, items)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/alb/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -41,7 +42,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta alb observability-credentials add --username xxx --password @./password.txt --display-name yyy"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
@@ -73,6 +74,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
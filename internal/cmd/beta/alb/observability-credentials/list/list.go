@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -75,6 +76,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 
 			items := *resp.Credentials
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -88,6 +93,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Number of credentials to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -115,6 +122,13 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *alb.APIClie
 	return req
 }
 
+var credentialsFields = []tables.Field[alb.CredentialsResponse]{
+	{Header: "CREDENTIAL REF", Value: func(c *alb.CredentialsResponse) any { return utils.PtrString(c.CredentialsRef) }},
+	{Header: "DISPLAYNAME", Value: func(c *alb.CredentialsResponse) any { return utils.PtrString(c.DisplayName) }},
+	{Header: "USERNAME", Value: func(c *alb.CredentialsResponse) any { return utils.PtrString(c.Username) }},
+	{Header: "REGION", Value: func(c *alb.CredentialsResponse) any { return utils.PtrString(c.Region) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, items []alb.CredentialsResponse) error {
 	if items == nil {
 		p.Outputln("no credentials found")
@@ -122,19 +136,12 @@ func outputResult(p *print.Printer, outputFormat string, items []alb.Credentials
 	}
 
 	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("CREDENTIAL REF", "DISPLAYNAME", "USERNAME", "REGION")
-
-		for _, item := range items {
-			table.AddRow(
-				utils.PtrString(item.CredentialsRef),
-				utils.PtrString(item.DisplayName),
-				utils.PtrString(item.Username),
-				utils.PtrString(item.Region),
-			)
-		}
+		table := tables.NewTableFromFields(credentialsFields, items)
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/alb/client"
@@ -45,7 +46,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta alb observability-credentials update credentials-xxx --username user1 --displayname user1 --password @./new-password.txt"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model := parseInput(params.Printer, cmd, args)
 
 			// Configure API client
@@ -84,6 +85,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
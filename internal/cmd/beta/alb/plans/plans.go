@@ -35,7 +35,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -75,6 +75,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 
+	tables.EnableColumns(cmd)
 	return cmd
 }
 
@@ -98,19 +99,18 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *alb.APIClie
 	return request
 }
 
+var planFields = []tables.Field[alb.PlanDetails]{
+	{Header: "PLAN ID", Value: func(plan *alb.PlanDetails) any { return utils.PtrString(plan.PlanId) }},
+	{Header: "NAME", Value: func(plan *alb.PlanDetails) any { return utils.PtrString(plan.Name) }},
+	{Header: "FLAVOR", Value: func(plan *alb.PlanDetails) any { return utils.PtrString(plan.FlavorName) }},
+	{Header: "MAX CONNS", Value: func(plan *alb.PlanDetails) any { return utils.PtrString(plan.MaxConnections) }},
+	{Header: "DESCRIPTION", Value: func(plan *alb.PlanDetails) any { return utils.Truncate(plan.Description, 70) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, items []alb.PlanDetails) error {
 	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("PLAN ID", "NAME", "FLAVOR", "MAX CONNS", "DESCRIPTION")
-		for _, item := range items {
-			table.AddRow(utils.PtrString(item.PlanId),
-				utils.PtrString(item.Name),
-				utils.PtrString(item.FlavorName),
-				utils.PtrString(item.MaxConnections),
-				utils.Truncate(item.Description, 70),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(planFields, items)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
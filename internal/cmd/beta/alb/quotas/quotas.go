@@ -34,7 +34,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -96,7 +96,7 @@ func outputResult(p *print.Printer, outputFormat string, response alb.GetQuotaRe
 		table.AddRow("REGION", utils.PtrString(response.Region))
 		table.AddSeparator()
 		table.AddRow("MAX LOADBALANCERS", utils.PtrString(response.MaxLoadBalancers))
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
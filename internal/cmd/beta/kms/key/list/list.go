@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -44,7 +45,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit beta kms key list --keyring-id "my-keyring-id" --output-format json`),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd)
 			if err != nil {
 				return err
@@ -63,6 +64,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get KMS Keys: %w", err)
 			}
 
+			if resp != nil && resp.Keys != nil {
+				items, err := filter.Items(model.Filter, *resp.Keys)
+				if err != nil {
+					return err
+				}
+				resp.Keys = &items
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, model.ProjectId, model.KeyRingId, resp)
 		},
 	}
@@ -96,6 +105,17 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, keyRingIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
+}
+
+var keyFields = []tables.Field[kms.Key]{
+	{Header: "ID", Value: func(k *kms.Key) any { return utils.PtrString(k.Id) }},
+	{Header: "NAME", Value: func(k *kms.Key) any { return utils.PtrString(k.DisplayName) }},
+	{Header: "SCOPE", Value: func(k *kms.Key) any { return utils.PtrString(k.Purpose) }},
+	{Header: "ALGORITHM", Value: func(k *kms.Key) any { return utils.PtrString(k.Algorithm) }},
+	{Header: "DELETION DATE", Value: func(k *kms.Key) any { return utils.PtrString(k.DeletionDate) }},
+	{Header: "STATUS", Value: func(k *kms.Key) any { return utils.PtrString(k.State) }},
 }
 
 func outputResult(p *print.Printer, outputFormat, projectId, keyRingId string, resp *kms.KeyList) error {
@@ -105,6 +125,10 @@ func outputResult(p *print.Printer, outputFormat, projectId, keyRingId string, r
 
 	keys := *resp.Keys
 
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, keys, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(keys, "", "  ")
@@ -125,21 +149,9 @@ func outputResult(p *print.Printer, outputFormat, projectId, keyRingId string, r
 			p.Outputf("No keys found for project %q under the key ring %q\n", projectId, keyRingId)
 			return nil
 		}
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "SCOPE", "ALGORITHM", "DELETION DATE", "STATUS")
-
-		for _, key := range keys {
-			table.AddRow(
-				utils.PtrString(key.Id),
-				utils.PtrString(key.DisplayName),
-				utils.PtrString(key.Purpose),
-				utils.PtrString(key.Algorithm),
-				utils.PtrString(key.DeletionDate),
-				utils.PtrString(key.State),
-			)
-		}
+		table := tables.NewTableFromFields(keyFields, keys)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	kmsUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/kms/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -45,7 +46,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit beta kms key restore "MY_KEY_ID" --keyring-id "my-keyring-id"`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -89,6 +90,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 	}
 
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
@@ -127,6 +129,10 @@ func outputResult(p *print.Printer, outputFormat string, resp *kms.Key) error {
 		return fmt.Errorf("response is nil")
 	}
 
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, resp, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(resp, "", "  ")
@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/kms/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
 
@@ -51,7 +52,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta kms keyring create --name my-keyring -o yaml"),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd)
 			if err != nil {
 				return err
@@ -100,6 +101,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
@@ -148,6 +150,10 @@ func outputResult(p *print.Printer, model *inputModel, resp *kms.KeyRing) error
 		return fmt.Errorf("response is nil")
 	}
 
+	if print.IsTemplateOutputFormat(model.OutputFormat) {
+		return p.OutputResult(model.OutputFormat, resp, nil)
+	}
+
 	switch model.OutputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(resp, "", "  ")
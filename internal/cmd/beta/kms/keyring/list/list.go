@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/kms/client"
@@ -38,7 +39,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta kms keyring list --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd)
 			if err != nil {
 				return err
@@ -57,10 +58,20 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get KMS key rings: %w", err)
 			}
 
+			if resp != nil && resp.KeyRings != nil {
+				items, err := filter.Items(model.Filter, *resp.KeyRings)
+				if err != nil {
+					return err
+				}
+				resp.KeyRings = &items
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, model.ProjectId, resp)
 		},
 	}
 
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 	return cmd
 }
 
@@ -83,6 +94,12 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *kms.APIClie
 	return req
 }
 
+var keyRingFields = []tables.Field[kms.KeyRing]{
+	{Header: "ID", Value: func(kr *kms.KeyRing) any { return utils.PtrString(kr.Id) }},
+	{Header: "NAME", Value: func(kr *kms.KeyRing) any { return utils.PtrString(kr.DisplayName) }},
+	{Header: "STATUS", Value: func(kr *kms.KeyRing) any { return utils.PtrString(kr.State) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectId string, resp *kms.KeyRingList) error {
 	if resp == nil || resp.KeyRings == nil {
 		return fmt.Errorf("response was nil / empty")
@@ -90,6 +107,10 @@ func outputResult(p *print.Printer, outputFormat, projectId string, resp *kms.Ke
 
 	keyRings := *resp.KeyRings
 
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, keyRings, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(keyRings, "", "  ")
@@ -111,19 +132,9 @@ func outputResult(p *print.Printer, outputFormat, projectId string, resp *kms.Ke
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATUS")
-
-		for i := range keyRings {
-			keyRing := keyRings[i]
-			table.AddRow(
-				utils.PtrString(keyRing.Id),
-				utils.PtrString(keyRing.DisplayName),
-				utils.PtrString(keyRing.State),
-			)
-		}
+		table := tables.NewTableFromFields(keyRingFields, keyRings)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
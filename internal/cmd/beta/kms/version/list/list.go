@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit beta kms version list --key-id "my-key-id" --keyring-id "my-keyring-id" -o json`),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd)
 			if err != nil {
 				return err
@@ -65,6 +66,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get key version: %w", err)
 			}
 
+			if resp != nil && resp.Versions != nil {
+				items, err := filter.Items(model.Filter, *resp.Versions)
+				if err != nil {
+					return err
+				}
+				resp.Versions = &items
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, model.ProjectId, model.KeyId, resp)
 		},
 	}
@@ -93,12 +102,24 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *kms.APIClie
 	return apiClient.ListVersions(ctx, model.ProjectId, model.Region, model.KeyRingId, model.KeyId)
 }
 
+var versionFields = []tables.Field[kms.Version]{
+	{Header: "ID", Value: func(v *kms.Version) any { return utils.PtrString(v.KeyId) }},
+	{Header: "NUMBER", Value: func(v *kms.Version) any { return utils.PtrString(v.Number) }},
+	{Header: "CREATED AT", Value: func(v *kms.Version) any { return utils.PtrString(v.CreatedAt) }},
+	{Header: "DESTROY DATE", Value: func(v *kms.Version) any { return utils.PtrString(v.DestroyDate) }},
+	{Header: "STATUS", Value: func(v *kms.Version) any { return utils.PtrString(v.State) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectId, keyId string, resp *kms.VersionList) error {
 	if resp == nil || resp.Versions == nil {
 		return fmt.Errorf("response is nil / empty")
 	}
 	versions := *resp.Versions
 
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, versions, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(versions, "", "  ")
@@ -119,20 +140,9 @@ func outputResult(p *print.Printer, outputFormat, projectId, keyId string, resp
 			p.Outputf("No key versions found for project %q for the key %q\n", projectId, keyId)
 			return nil
 		}
-		table := tables.NewTable()
-		table.SetHeader("ID", "NUMBER", "CREATED AT", "DESTROY DATE", "STATUS")
-
-		for _, version := range versions {
-			table.AddRow(
-				utils.PtrString(version.KeyId),
-				utils.PtrString(version.Number),
-				utils.PtrString(version.CreatedAt),
-				utils.PtrString(version.DestroyDate),
-				utils.PtrString(version.State),
-			)
-		}
+		table := tables.NewTableFromFields(versionFields, versions)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -147,4 +157,6 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, keyRingIdFlag, keyIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -44,7 +45,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit beta kms wrapping-key list --keyring-id "my-keyring-id" --output-format json`),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd)
 			if err != nil {
 				return err
@@ -63,6 +64,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get KMS wrapping keys: %w", err)
 			}
 
+			if resp != nil && resp.WrappingKeys != nil {
+				items, err := filter.Items(model.Filter, *resp.WrappingKeys)
+				if err != nil {
+					return err
+				}
+				resp.WrappingKeys = &items
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, model.KeyRingId, resp)
 		},
 	}
@@ -95,6 +104,17 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), keyRingIdFlag, "ID of the KMS key ring where the key is stored")
 	err := flags.MarkFlagsRequired(cmd, keyRingIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
+}
+
+var wrappingKeyFields = []tables.Field[kms.WrappingKey]{
+	{Header: "ID", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.Id) }},
+	{Header: "NAME", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.DisplayName) }},
+	{Header: "SCOPE", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.Purpose) }},
+	{Header: "ALGORITHM", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.Algorithm) }},
+	{Header: "EXPIRES AT", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.ExpiresAt) }},
+	{Header: "STATUS", Value: func(wk *kms.WrappingKey) any { return utils.PtrString(wk.State) }},
 }
 
 func outputResult(p *print.Printer, outputFormat, keyRingId string, resp *kms.WrappingKeyList) error {
@@ -104,6 +124,10 @@ func outputResult(p *print.Printer, outputFormat, keyRingId string, resp *kms.Wr
 
 	wrappingKeys := *resp.WrappingKeys
 
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, wrappingKeys, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(wrappingKeys, "", "  ")
@@ -124,22 +148,9 @@ func outputResult(p *print.Printer, outputFormat, keyRingId string, resp *kms.Wr
 			p.Outputf("No wrapping keys found under the key ring %q\n", keyRingId)
 			return nil
 		}
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "SCOPE", "ALGORITHM", "EXPIRES AT", "STATUS")
-
-		for i := range wrappingKeys {
-			wrappingKey := wrappingKeys[i]
-			table.AddRow(
-				utils.PtrString(wrappingKey.Id),
-				utils.PtrString(wrappingKey.DisplayName),
-				utils.PtrString(wrappingKey.Purpose),
-				utils.PtrString(wrappingKey.Algorithm),
-				utils.PtrString(wrappingKey.ExpiresAt),
-				utils.PtrString(wrappingKey.State),
-			)
-		}
+		table := tables.NewTableFromFields(wrappingKeyFields, wrappingKeys)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
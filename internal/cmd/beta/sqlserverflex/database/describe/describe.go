@@ -45,7 +45,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta sqlserverflex database describe my-database --instance-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -125,7 +125,7 @@ func outputResult(p *print.Printer, outputFormat string, resp *sqlserverflex.Get
 				table.AddRow("COLLATION", *database.Options.CollationName)
 			}
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
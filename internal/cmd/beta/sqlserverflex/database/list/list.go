@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -48,7 +49,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta sqlserverflex database list --instance-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -77,6 +78,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			databases := *resp.Databases
 
+			databases, err = filter.Items(model.Filter, databases)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(databases) > int(*model.Limit) {
 				databases = databases[:*model.Limit]
@@ -96,6 +101,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -127,15 +134,15 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *sqlserverfl
 	return req
 }
 
+var databaseFields = []tables.Field[sqlserverflex.Database]{
+	{Header: "ID", Value: func(d *sqlserverflex.Database) any { return utils.PtrString(d.Id) }},
+	{Header: "NAME", Value: func(d *sqlserverflex.Database) any { return utils.PtrString(d.Name) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, databases []sqlserverflex.Database) error {
 	return p.OutputResult(outputFormat, databases, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME")
-		for i := range databases {
-			database := databases[i]
-			table.AddRow(utils.PtrString(database.Id), utils.PtrString(database.Name))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(databaseFields, databases)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -43,7 +43,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta sqlserverflex instance describe xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -126,7 +126,7 @@ func outputResult(p *print.Printer, outputFormat string, instance *sqlserverflex
 			table.AddRow("RAM (GB)", utils.PtrString(instance.Flavor.Memory))
 			table.AddSeparator()
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
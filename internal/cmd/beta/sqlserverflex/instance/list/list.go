@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta sqlserverflex instance list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -75,6 +76,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			instances := *resp.Items
 
+			instances, err = filter.Items(model.Filter, instances)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(instances) > int(*model.Limit) {
 				instances = instances[:*model.Limit]
@@ -90,6 +95,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -120,19 +127,16 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *sqlserverfl
 	return req
 }
 
+var instanceFields = []tables.Field[sqlserverflex.InstanceListInstance]{
+	{Header: "ID", Value: func(i *sqlserverflex.InstanceListInstance) any { return utils.PtrString(i.Id) }},
+	{Header: "NAME", Value: func(i *sqlserverflex.InstanceListInstance) any { return utils.PtrString(i.Name) }},
+	{Header: "STATUS", Value: func(i *sqlserverflex.InstanceListInstance) any { return utils.PtrString(i.Status) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, instances []sqlserverflex.InstanceListInstance) error {
 	return p.OutputResult(outputFormat, instances, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATUS")
-		for i := range instances {
-			instance := instances[i]
-			table.AddRow(
-				utils.PtrString(instance.Id),
-				utils.PtrString(instance.Name),
-				utils.PtrString(instance.Status),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(instanceFields, instances)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
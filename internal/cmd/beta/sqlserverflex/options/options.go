@@ -107,7 +107,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit beta sqlserverflex options --user-roles --db-compatibilities --instance-id <INSTANCE_ID>"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -296,7 +296,7 @@ func outputResult(p *print.Printer, model *inputModel, flavors *sqlserverflex.Li
 			content = append(content, buildDBCollationsTable(options.DBCollations.DBCollations))
 		}
 
-		err := tables.DisplayTables(p, content)
+		err := tables.DisplayTables(p, content, model.OutputFormat)
 		if err != nil {
 			return fmt.Errorf("display output: %w", err)
 		}
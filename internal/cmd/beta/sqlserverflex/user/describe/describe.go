@@ -52,7 +52,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.SingleArg(userIdArg, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -136,7 +136,7 @@ func outputResult(p *print.Printer, outputFormat string, user *sqlserverflex.Use
 			table.AddRow("PORT", *user.Port)
 		}
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
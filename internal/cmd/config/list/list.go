@@ -30,14 +30,20 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Lists the current CLI configuration values",
-		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s",
 			"Lists the current CLI configuration values, based on the following sources (in order of precedence):",
+			"- Flag",
+			`  Example: you can set the project ID for a single command with the "--project-id" flag.`,
 			"- Environment variable",
 			`  The environment variable is the name of the setting, with underscores ("_") instead of dashes ("-") and the "STACKIT" prefix.`,
 			"  Example: you can set the project ID by setting the environment variable STACKIT_PROJECT_ID.",
+			"- Local config file",
+			fmt.Sprintf(`  The nearest %q file, searched from the current directory upwards, can set the profile, project ID, region and defaults of global flags.`, config.LocalConfigFileName),
+			fmt.Sprintf(`  Example: you can pin the project ID of a repository by adding "project-id: xxx" to the %q file at its root.`, config.LocalConfigFileName),
 			"- Configuration set in CLI",
 			`  These are set using the "stackit config set" command`,
 			`  Example: you can set the project ID by running "stackit config set --project-id xxx"`,
+			"The source of each value is listed next to it.",
 		),
 		Args: args.NoArgs,
 		Example: examples.Build(
@@ -58,7 +64,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get profile: %w", err)
 			}
 
-			return outputResult(params.Printer, model.OutputFormat, configData, activeProfile)
+			sources := getSources(cmd, configData)
+			return outputResult(params.Printer, model.OutputFormat, configData, sources, activeProfile, config.GetLocalConfigFilePath())
 		},
 	}
 	return cmd
@@ -72,12 +79,39 @@ func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
 	}
 }
 
-func outputResult(p *print.Printer, outputFormat string, configData map[string]any, activeProfile string) error {
+// getSources returns where the value of each config key comes from, e.g. "environment variable"
+func getSources(cmd *cobra.Command, configData map[string]any) map[string]string {
+	sources := map[string]string{}
+	for key := range configData {
+		if !slices.Contains(config.ConfigKeys, key) {
+			continue
+		}
+		// Config keys set with a global flag are named like it
+		flag := cmd.Flags().Lookup(strings.ReplaceAll(key, "_", "-"))
+		if flag != nil && flag.Changed {
+			sources[key] = config.SourceFlag
+			continue
+		}
+		sources[key] = config.GetSource(key)
+	}
+	return sources
+}
+
+func outputResult(p *print.Printer, outputFormat string, configData map[string]any, sources map[string]string, activeProfile, localConfigFilePath string) error {
+	if print.IsTemplateOutputFormat(outputFormat) {
+		if activeProfile != "" {
+			configData["profile"] = activeProfile
+		}
+		configData["sources"] = sources
+		return p.OutputResult(outputFormat, configData, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		if activeProfile != "" {
 			configData["profile"] = activeProfile
 		}
+		configData["sources"] = sources
 		details, err := json.MarshalIndent(configData, "", "  ")
 		if err != nil {
 			return fmt.Errorf("marshal config list: %w", err)
@@ -85,6 +119,7 @@ func outputResult(p *print.Printer, outputFormat string, configData map[string]a
 		p.Outputln(string(details))
 		return nil
 	case print.YAMLOutputFormat:
+		configData["sources"] = sources
 		details, err := yaml.MarshalWithOptions(configData, yaml.IndentSequence(true), yaml.UseJSONMarshaler())
 		if err != nil {
 			return fmt.Errorf("marshal config list: %w", err)
@@ -102,10 +137,13 @@ func outputResult(p *print.Printer, outputFormat string, configData map[string]a
 		sort.Strings(configKeys)
 
 		table := tables.NewTable()
-		if activeProfile != "" {
+		switch {
+		case activeProfile != "" && localConfigFilePath != "":
+			table.SetTitle(fmt.Sprintf("Profile: %q, local config file: %q", activeProfile, localConfigFilePath))
+		case activeProfile != "":
 			table.SetTitle(fmt.Sprintf("Profile: %q", activeProfile))
 		}
-		table.SetHeader("NAME", "VALUE")
+		table.SetHeader("NAME", "VALUE", "SOURCE")
 		for _, key := range configKeys {
 			value := configData[key]
 
@@ -131,13 +169,15 @@ func outputResult(p *print.Printer, outputFormat string, configData map[string]a
 				continue
 			}
 
+			source := sources[key]
+
 			// Replace "_" with "-" to match the flags
 			key = strings.ReplaceAll(key, "_", "-")
 
-			table.AddRow(key, valueString)
+			table.AddRow(key, valueString, source)
 			table.AddSeparator()
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
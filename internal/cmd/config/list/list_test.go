@@ -10,9 +10,11 @@ import (
 
 func TestOutputResult(t *testing.T) {
 	type args struct {
-		outputFormat  string
-		configData    map[string]any
-		activeProfile string
+		outputFormat        string
+		configData          map[string]any
+		sources             map[string]string
+		activeProfile       string
+		localConfigFilePath string
 	}
 	tests := []struct {
 		name    string
@@ -24,12 +26,32 @@ func TestOutputResult(t *testing.T) {
 			args:    args{},
 			wantErr: false,
 		},
+		{
+			name: "with sources",
+			args: args{
+				configData:          map[string]any{"project_id": "xxx", "region": "eu01", "async": true},
+				sources:             map[string]string{"project_id": "local config file", "region": "default", "async": "flag"},
+				activeProfile:       "default",
+				localConfigFilePath: "/repo/.stackit.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name: "with sources in JSON format",
+			args: args{
+				outputFormat:  print.JSONOutputFormat,
+				configData:    map[string]any{"project_id": "xxx"},
+				sources:       map[string]string{"project_id": "environment variable"},
+				activeProfile: "default",
+			},
+			wantErr: false,
+		},
 	}
 	p := print.NewPrinter()
 	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := outputResult(p, tt.args.outputFormat, tt.args.configData, tt.args.activeProfile); (err != nil) != tt.wantErr {
+			if err := outputResult(p, tt.args.outputFormat, tt.args.configData, tt.args.sources, tt.args.activeProfile, tt.args.localConfigFilePath); (err != nil) != tt.wantErr {
 				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
@@ -46,11 +47,16 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get profile: %w", err)
 			}
 
-			outputProfiles := buildOutput(profiles, activeProfile)
+			outputProfiles, err := filter.Items(model.Filter, buildOutput(profiles, activeProfile))
+			if err != nil {
+				return err
+			}
 
 			return outputResult(params.Printer, model.OutputFormat, outputProfiles)
 		},
 	}
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 	return cmd
 }
 
@@ -89,24 +95,27 @@ func buildOutput(profiles []string, activeProfile string) []profileInfo {
 	return configData
 }
 
+var profileFields = []tables.Field[profileInfo]{
+	{Header: "NAME", Value: func(pi *profileInfo) any { return pi.Name }},
+	{Header: "ACTIVE", Value: func(pi *profileInfo) any {
+		if pi.Active {
+			return "*"
+		}
+		return ""
+	}},
+	{Header: "EMAIL", Value: func(pi *profileInfo) any {
+		if pi.Email == "" {
+			return "Not authenticated"
+		}
+		return pi.Email
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, profiles []profileInfo) error {
 	return p.OutputResult(outputFormat, profiles, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NAME", "ACTIVE", "EMAIL")
-		for _, profile := range profiles {
-			// Prettify the output
-			email := profile.Email
-			active := ""
-			if profile.Email == "" {
-				email = "Not authenticated"
-			}
-			if profile.Active {
-				active = "*"
-			}
-			table.AddRow(profile.Name, active, email)
-			table.AddSeparator()
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(profileFields, profiles)
+		table.EnableRowSeparators()
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -59,11 +59,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set",
 		Short: "Sets CLI configuration options",
-		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s",
 			"Sets CLI configuration options.",
 			"All of the configuration options can be set using an environment variable, which takes precedence over what is configured using this command.",
 			`The environment variable is the name of the flag, with underscores ("_") instead of dashes ("-") and the "STACKIT" prefix.`,
 			"Example: to set the project ID you can set the environment variable STACKIT_PROJECT_ID.",
+			fmt.Sprintf(`The values set in a local %q file, searched from the current directory upwards, take precedence over what is configured using this command as well.`, config.LocalConfigFileName),
 		),
 		Args: args.NoArgs,
 		Example: examples.Build(
@@ -17,9 +17,14 @@ import (
 
 const (
 	asyncFlag        = globalflags.AsyncFlag
+	logFileFlag      = globalflags.LogFileFlag
+	logFormatFlag    = globalflags.LogFormatFlag
 	outputFormatFlag = globalflags.OutputFormatFlag
 	projectIdFlag    = globalflags.ProjectIdFlag
 	regionFlag       = globalflags.RegionFlag
+	retriesFlag      = globalflags.RetriesFlag
+	retryBackoffFlag = globalflags.RetryBackoffFlag
+	timeoutFlag      = globalflags.TimeoutFlag
 	verbosityFlag    = globalflags.VerbosityFlag
 
 	sessionTimeLimitFlag                             = "session-time-limit"
@@ -55,9 +60,14 @@ const (
 
 type inputModel struct {
 	Async        bool
+	LogFile      bool
+	LogFormat    bool
 	OutputFormat bool
 	ProjectId    bool
 	Region       bool
+	Retries      bool
+	RetryBackoff bool
+	Timeout      bool
 	Verbosity    bool
 
 	SessionTimeLimit               bool
@@ -114,6 +124,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			if model.Async {
 				viper.Set(config.AsyncKey, config.AsyncDefault)
 			}
+			if model.LogFile {
+				viper.Set(config.LogFileKey, "")
+			}
+			if model.LogFormat {
+				viper.Set(config.LogFormatKey, config.LogFormatDefault)
+			}
 			if model.OutputFormat {
 				viper.Set(config.OutputFormatKey, "")
 			}
@@ -123,6 +139,15 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			if model.Region {
 				viper.Set(config.RegionKey, config.RegionDefault)
 			}
+			if model.Retries {
+				viper.Set(config.RetriesKey, config.RetriesDefault)
+			}
+			if model.RetryBackoff {
+				viper.Set(config.RetryBackoffKey, config.RetryBackoffDefault)
+			}
+			if model.Timeout {
+				viper.Set(config.TimeoutKey, config.TimeoutDefault)
+			}
 			if model.Verbosity {
 				viper.Set(config.VerbosityKey, globalflags.VerbosityDefault)
 			}
@@ -229,6 +254,11 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(projectIdFlag, false, "Project ID")
 	cmd.Flags().Bool(regionFlag, false, "Region")
 	cmd.Flags().Bool(outputFormatFlag, false, "Output format")
+	cmd.Flags().Bool(logFileFlag, false, "Log file. If unset, debug logs are written to stderr")
+	cmd.Flags().Bool(logFormatFlag, false, fmt.Sprintf("Format of the debug logs. If unset, defaults to %q", config.LogFormatDefault))
+	cmd.Flags().Bool(retriesFlag, false, fmt.Sprintf("Maximum number of retries of API requests. If unset, defaults to %d", config.RetriesDefault))
+	cmd.Flags().Bool(retryBackoffFlag, false, fmt.Sprintf("Time waited before the first retry of API requests. If unset, defaults to %s", config.RetryBackoffDefault))
+	cmd.Flags().Bool(timeoutFlag, false, "Maximum duration of commands. If unset, commands have no time limit")
 	cmd.Flags().Bool(verbosityFlag, false, "Verbosity of the CLI")
 
 	cmd.Flags().Bool(sessionTimeLimitFlag, false, fmt.Sprintf("Maximum time before authentication is required again. If unset, defaults to %s", config.SessionTimeLimitDefault))
@@ -265,9 +295,14 @@ func configureFlags(cmd *cobra.Command) {
 func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
 	model := inputModel{
 		Async:        flags.FlagToBoolValue(p, cmd, asyncFlag),
+		LogFile:      flags.FlagToBoolValue(p, cmd, logFileFlag),
+		LogFormat:    flags.FlagToBoolValue(p, cmd, logFormatFlag),
 		OutputFormat: flags.FlagToBoolValue(p, cmd, outputFormatFlag),
 		ProjectId:    flags.FlagToBoolValue(p, cmd, projectIdFlag),
 		Region:       flags.FlagToBoolValue(p, cmd, regionFlag),
+		Retries:      flags.FlagToBoolValue(p, cmd, retriesFlag),
+		RetryBackoff: flags.FlagToBoolValue(p, cmd, retryBackoffFlag),
+		Timeout:      flags.FlagToBoolValue(p, cmd, timeoutFlag),
 		Verbosity:    flags.FlagToBoolValue(p, cmd, verbosityFlag),
 
 		SessionTimeLimit:               flags.FlagToBoolValue(p, cmd, sessionTimeLimitFlag),
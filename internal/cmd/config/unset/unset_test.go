@@ -14,8 +14,13 @@ import (
 func fixtureFlagValues(mods ...func(flagValues map[string]bool)) map[string]bool {
 	flagValues := map[string]bool{
 		asyncFlag:        true,
+		logFileFlag:      true,
+		logFormatFlag:    true,
 		outputFormatFlag: true,
 		projectIdFlag:    true,
+		retriesFlag:      true,
+		retryBackoffFlag: true,
+		timeoutFlag:      true,
 		verbosityFlag:    true,
 
 		sessionTimeLimitFlag:                             true,
@@ -54,8 +59,13 @@ func fixtureFlagValues(mods ...func(flagValues map[string]bool)) map[string]bool
 func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 	model := &inputModel{
 		Async:        true,
+		LogFile:      true,
+		LogFormat:    true,
 		OutputFormat: true,
 		ProjectId:    true,
+		Retries:      true,
+		RetryBackoff: true,
+		Timeout:      true,
 		Verbosity:    true,
 
 		SessionTimeLimit:               true,
@@ -110,8 +120,13 @@ func TestParseInput(t *testing.T) {
 			isValid:     true,
 			expectedModel: fixtureInputModel(func(model *inputModel) {
 				model.Async = false
+				model.LogFile = false
+				model.LogFormat = false
 				model.OutputFormat = false
 				model.ProjectId = false
+				model.Retries = false
+				model.RetryBackoff = false
+				model.Timeout = false
 				model.Verbosity = false
 
 				model.SessionTimeLimit = false
@@ -162,6 +177,26 @@ func TestParseInput(t *testing.T) {
 				model.OutputFormat = false
 			}),
 		},
+		{
+			description: "log file empty",
+			flagValues: fixtureFlagValues(func(flagValues map[string]bool) {
+				flagValues[logFileFlag] = false
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.LogFile = false
+			}),
+		},
+		{
+			description: "timeout empty",
+			flagValues: fixtureFlagValues(func(flagValues map[string]bool) {
+				flagValues[timeoutFlag] = false
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Timeout = false
+			}),
+		},
 		{
 			description: "identity provider custom endpoint empty",
 			flagValues: fixtureFlagValues(func(flagValues map[string]bool) {
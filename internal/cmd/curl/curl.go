@@ -14,9 +14,11 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -76,9 +78,13 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return err
 			}
 
-			bearerToken, err := getBearerToken(params.Printer)
-			if err != nil {
-				return err
+			// When replaying, the response is served from the recording, so no credentials are needed
+			bearerToken := ""
+			if !cassette.IsReplaying() {
+				bearerToken, err = getBearerToken(params.Printer)
+				if err != nil {
+					return err
+				}
 			}
 
 			req, err := buildRequest(model, bearerToken)
@@ -87,7 +93,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 
 			client := http.Client{
-				Timeout: 30 * time.Second,
+				Timeout:   30 * time.Second,
+				Transport: har.Transport(cassette.Transport(http.DefaultTransport)),
 			}
 			resp, err := client.Do(req)
 			if err != nil {
@@ -191,7 +198,9 @@ func buildRequest(model *inputModel, bearerToken string) (*http.Request, error)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", bearerToken))
+	if bearerToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", bearerToken))
+	}
 	for _, header := range model.Headers {
 		headerSplit := strings.SplitN(header, ": ", 2)
 		if len(headerSplit) != 2 {
@@ -47,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit dns record-set describe xxx --zone-id yyy --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -128,7 +128,7 @@ func outputResult(p *print.Printer, outputFormat string, recordSet *dns.RecordSe
 		table.AddRow("TYPE", utils.PtrString(recordSet.Type))
 		table.AddSeparator()
 		table.AddRow("RECORDS DATA", recordsDataJoin)
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -71,9 +72,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`List the deleted DNS record-sets for zone with ID "xxx"`,
 				"$ stackit dns record-set list --zone-id xxx --deleted"),
+			examples.NewExample(
+				`List DNS record-sets for zone with ID "xxx" with all available columns, sorted by TTL`,
+				"$ stackit dns record-set list --zone-id xxx --columns wide --sort-by ttl"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -121,6 +125,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, zoneIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -224,42 +230,50 @@ func fetchRecordSets(ctx context.Context, model *inputModel, apiClient dnsClient
 		if len(respRecordSets) == 0 {
 			break
 		}
-		recordSets = append(recordSets, respRecordSets...)
-		// Stop if no more pages
-		if len(respRecordSets) < int(model.PageSize) {
-			break
+		matchingRecordSets, err := filter.Items(model.Filter, respRecordSets)
+		if err != nil {
+			return nil, err
 		}
+		recordSets = append(recordSets, matchingRecordSets...)
 		// Stop and truncate if limit is reached
 		if model.Limit != nil && len(recordSets) >= int(*model.Limit) {
 			recordSets = recordSets[:*model.Limit]
 			break
 		}
+		// Stop if no more pages
+		if len(respRecordSets) < int(model.PageSize) {
+			break
+		}
 		page++
 	}
 	return recordSets, nil
 }
 
+// Whether a record set is active is only displayed with "--columns wide"
+var recordSetFields = []tables.Field[dns.RecordSet]{
+	{Header: "ID", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Id) }},
+	{Header: "NAME", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Name) }},
+	{Header: "STATUS", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.State) }},
+	{Header: "TTL", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Ttl) }},
+	{Header: "TYPE", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Type) }},
+	{Header: "RECORD DATA", Value: func(rs *dns.RecordSet) any {
+		recordData := make([]string, 0, len(*rs.Records))
+		for _, r := range *rs.Records {
+			recordData = append(recordData, *r.Content)
+		}
+		return strings.Join(recordData, ", ")
+	}},
+	{Header: "ACTIVE", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Active) }, Wide: true},
+	{Header: "COMMENT", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Comment) }, Wide: true},
+	{Header: "ERROR", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.Error) }, Wide: true},
+	{Header: "CREATION FINISHED", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.CreationFinished) }, Wide: true},
+	{Header: "UPDATE FINISHED", Value: func(rs *dns.RecordSet) any { return utils.PtrString(rs.UpdateFinished) }, Wide: true},
+}
+
 func outputResult(p *print.Printer, outputFormat string, recordSets []dns.RecordSet) error {
 	return p.OutputResult(outputFormat, recordSets, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATUS", "TTL", "TYPE", "RECORD DATA")
-		for i := range recordSets {
-			rs := recordSets[i]
-			recordData := make([]string, 0, len(*rs.Records))
-			for _, r := range *rs.Records {
-				recordData = append(recordData, *r.Content)
-			}
-			recordDataJoin := strings.Join(recordData, ", ")
-			table.AddRow(
-				utils.PtrString(rs.Id),
-				utils.PtrString(rs.Name),
-				utils.PtrString(rs.State),
-				utils.PtrString(rs.Ttl),
-				utils.PtrString(rs.Type),
-				recordDataJoin,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(recordSetFields, recordSets)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -299,6 +300,7 @@ func TestFetchRecordSets(t *testing.T) {
 		description         string
 		model               *inputModel
 		totalItems          int
+		nonMatchingItems    []int
 		apiCallFails        bool
 		expectedNumAPICalls int
 		expectedNumItems    int
@@ -367,6 +369,18 @@ func TestFetchRecordSets(t *testing.T) {
 			apiCallFails:        false,
 			expectedNumItems:    50,
 		},
+		{
+			description: "filter and limit across pages",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.Filter = `name=="match"`
+				model.Limit = utils.Ptr(int64(5))
+			}),
+			totalItems:          9,
+			nonMatchingItems:    []int{3, 4},
+			expectedNumAPICalls: 2,
+			apiCallFails:        false,
+			expectedNumItems:    5,
+		},
 		{
 			description:  "request fails",
 			model:        fixtureInputModel(),
@@ -421,6 +435,13 @@ func TestFetchRecordSets(t *testing.T) {
 				}
 
 				recordSets := make([]dns.RecordSet, numItemsToReturn)
+				for i := range recordSets {
+					name := "match"
+					if slices.Contains(tt.nonMatchingItems, offset+i) {
+						name = "other"
+					}
+					recordSets[i].Name = utils.Ptr(name)
+				}
 				mockedResp := dns.ListRecordSetsResponse{
 					RrSets: &recordSets,
 				}
@@ -42,7 +42,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit dns zone describe xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -125,7 +125,7 @@ func outputResult(p *print.Printer, outputFormat string, zone *dns.Zone) error {
 		table.AddRow("EXPIRE TIME", utils.PtrString(zone.ExpireTime))
 		table.AddSeparator()
 		table.AddRow("NEGATIVE CACHE", utils.PtrString(zone.NegativeCache))
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -69,7 +70,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit dns zone list --include-deleted"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -113,6 +114,8 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(includeDeletedFlag, false, "Includes successfully deleted zones (if unset, these are filtered out)")
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().Int64(pageSizeFlag, pageSizeDefault, "Number of items fetched in each API call. Does not affect the number of items in the command output")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -213,36 +216,46 @@ func fetchZones(ctx context.Context, model *inputModel, apiClient dnsClient) ([]
 		if len(respZones) == 0 {
 			break
 		}
-		zones = append(zones, respZones...)
-		// Stop if no more pages
-		if len(respZones) < int(model.PageSize) {
-			break
+		matchingZones, err := filter.Items(model.Filter, respZones)
+		if err != nil {
+			return nil, err
 		}
+		zones = append(zones, matchingZones...)
 		// Stop and truncate if limit is reached
 		if model.Limit != nil && len(zones) >= int(*model.Limit) {
 			zones = zones[:*model.Limit]
 			break
 		}
+		// Stop if no more pages
+		if len(respZones) < int(model.PageSize) {
+			break
+		}
 		page++
 	}
 	return zones, nil
 }
 
+// The zone details after the record count, e.g. the primary name server and serial number, are only displayed with "--columns wide"
+var zoneFields = []tables.Field[dns.Zone]{
+	{Header: "ID", Value: func(z *dns.Zone) any { return utils.PtrString(z.Id) }},
+	{Header: "NAME", Value: func(z *dns.Zone) any { return utils.PtrString(z.Name) }},
+	{Header: "STATE", Value: func(z *dns.Zone) any { return utils.PtrString(z.State) }},
+	{Header: "TYPE", Value: func(z *dns.Zone) any { return utils.PtrString(z.Type) }},
+	{Header: "DNS NAME", Value: func(z *dns.Zone) any { return utils.PtrString(z.DnsName) }},
+	{Header: "RECORD COUNT", Value: func(z *dns.Zone) any { return utils.PtrString(z.RecordCount) }},
+	{Header: "ACTIVE", Value: func(z *dns.Zone) any { return utils.PtrString(z.Active) }, Wide: true},
+	{Header: "DEFAULT TTL", Value: func(z *dns.Zone) any { return utils.PtrString(z.DefaultTTL) }, Wide: true},
+	{Header: "PRIMARY NAME SERVER", Value: func(z *dns.Zone) any { return utils.PtrString(z.PrimaryNameServer) }, Wide: true},
+	{Header: "SERIAL NUMBER", Value: func(z *dns.Zone) any { return utils.PtrString(z.SerialNumber) }, Wide: true},
+	{Header: "VISIBILITY", Value: func(z *dns.Zone) any { return utils.PtrString(z.Visibility) }, Wide: true},
+	{Header: "DESCRIPTION", Value: func(z *dns.Zone) any { return utils.PtrString(z.Description) }, Wide: true},
+	{Header: "CREATION FINISHED", Value: func(z *dns.Zone) any { return utils.PtrString(z.CreationFinished) }, Wide: true},
+}
+
 func outputResult(p *print.Printer, outputFormat string, zones []dns.Zone) error {
 	return p.OutputResult(outputFormat, zones, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATE", "TYPE", "DNS NAME", "RECORD COUNT")
-		for i := range zones {
-			z := zones[i]
-			table.AddRow(utils.PtrString(z.Id),
-				utils.PtrString(z.Name),
-				utils.PtrString(z.State),
-				utils.PtrString(z.Type),
-				utils.PtrString(z.DnsName),
-				utils.PtrString(z.RecordCount),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(zoneFields, zones)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -293,6 +294,7 @@ func TestFetchZones(t *testing.T) {
 		description         string
 		model               *inputModel
 		totalItems          int
+		nonMatchingItems    []int
 		apiCallFails        bool
 		expectedNumAPICalls int
 		expectedNumItems    int
@@ -361,6 +363,18 @@ func TestFetchZones(t *testing.T) {
 			apiCallFails:        false,
 			expectedNumItems:    50,
 		},
+		{
+			description: "filter and limit across pages",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.Filter = `name=="match"`
+				model.Limit = utils.Ptr(int64(5))
+			}),
+			totalItems:          9,
+			nonMatchingItems:    []int{3, 4},
+			expectedNumAPICalls: 2,
+			apiCallFails:        false,
+			expectedNumItems:    5,
+		},
 		{
 			description:  "request fails",
 			model:        fixtureInputModel(),
@@ -415,6 +429,13 @@ func TestFetchZones(t *testing.T) {
 				}
 
 				zones := make([]dns.Zone, numItemsToReturn)
+				for i := range zones {
+					name := "match"
+					if slices.Contains(tt.nonMatchingItems, offset+i) {
+						name = "other"
+					}
+					zones[i].Name = utils.Ptr(name)
+				}
 				mockedResp := dns.ListZonesResponse{
 					Zones: &zones,
 				}
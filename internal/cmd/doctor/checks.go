@@ -0,0 +1,432 @@
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	StatusPassed  = "passed"
+	StatusWarning = "warning"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+
+	// Time a custom endpoint has to respond in
+	endpointTimeout = 10 * time.Second
+	// Certificates of custom endpoints expiring sooner are reported
+	certificateExpiryWarning = 14 * 24 * time.Hour
+	// Difference to the time of the endpoints or the access token that is tolerated, as the clocks of the servers differ as well
+	maxClockSkew = time.Minute
+)
+
+// Result is the outcome of a check of the local setup
+type Result struct {
+	Check   string `json:"check"`
+	Status  string `json:"status"`
+	Details string `json:"details"`
+	// Remediation of a failed check or warning
+	Hint string `json:"hint,omitempty"`
+}
+
+// LookPathFunc finds the path of an executable, e.g. exec.LookPath
+type LookPathFunc func(file string) (string, error)
+
+func checkConfiguration() Result {
+	result := Result{Check: "Configuration"}
+	if err := config.GetInitError(); err != nil {
+		result.Status = StatusFailed
+		result.Details = err.Error()
+		result.Hint = "Fix or remove the file named in the error"
+		return result
+	}
+
+	errs := globalflags.ValidateConfig()
+	if len(errs) > 0 {
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("Invalid values: %v", errors.Join(errs...))
+		result.Hint = `Correct the values with "stackit config set" or remove them with "stackit config unset", they may also be set as environment variables or in a local config file`
+		return result
+	}
+
+	result.Status = StatusPassed
+	result.Details = fmt.Sprintf("The config file %q is valid", viper.ConfigFileUsed())
+	if _, err := os.Stat(viper.ConfigFileUsed()); errors.Is(err, os.ErrNotExist) {
+		result.Details = "No config file exists yet, the defaults are used"
+	}
+	if localConfigFilePath := config.GetLocalConfigFilePath(); localConfigFilePath != "" {
+		result.Details += fmt.Sprintf(", values are also read from the local config file %q", localConfigFilePath)
+	}
+	return result
+}
+
+func checkProfile() Result {
+	result := Result{Check: "Profile"}
+	configuredProfile, activeProfile, configMethod, err := config.GetConfiguredProfile()
+	switch {
+	case err != nil:
+		result.Status = StatusFailed
+		result.Details = err.Error()
+		result.Hint = `Set a valid profile name with "stackit config profile set", or in the STACKIT_CLI_PROFILE environment variable`
+	case configMethod == "":
+		result.Status = StatusPassed
+		result.Details = fmt.Sprintf("No profile is configured, the %q profile is used", activeProfile)
+	case configuredProfile != activeProfile:
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("The profile %q set via %s does not exist, the %q profile is used instead", configuredProfile, configMethod, activeProfile)
+		unset := `unset it with "stackit config profile unset"`
+		if _, ok := config.GetProfileFromEnv(); ok {
+			unset = "unset the STACKIT_CLI_PROFILE environment variable"
+		} else if _, ok := config.GetProfileFromLocalConfig(); ok {
+			unset = fmt.Sprintf("remove it from the local config file %q", config.GetLocalConfigFilePath())
+		}
+		result.Hint = fmt.Sprintf(`Create the profile with "stackit config profile create %s", or %s`, configuredProfile, unset)
+	default:
+		result.Status = StatusPassed
+		result.Details = fmt.Sprintf("The profile %q set via %s is used", activeProfile, configMethod)
+	}
+	return result
+}
+
+// getAccessToken returns the access token used by the commands, and whether it is set in the environment
+func getAccessToken() (token string, fromEnv bool, err error) {
+	if token, ok := auth.GetAccessTokenFromEnv(); ok {
+		return token, true, nil
+	}
+	token, err = auth.GetAccessToken()
+	return token, false, err
+}
+
+func checkAuthentication() Result {
+	result := Result{Check: "Authentication"}
+	if _, ok := auth.GetAccessTokenFromEnv(); ok {
+		result.Status = StatusPassed
+		result.Details = "The access token set in the STACKIT_ACCESS_TOKEN environment variable is used"
+		return result
+	}
+
+	flow, err := auth.GetAuthFlow()
+	if err != nil || flow == "" {
+		result.Status = StatusFailed
+		result.Details = "Not authenticated"
+		result.Hint = `Log in with "stackit auth login", or activate a service account with "stackit auth activate-service-account"`
+		return result
+	}
+
+	switch flow {
+	case auth.AUTH_FLOW_USER_TOKEN:
+		email, err := auth.GetAuthEmail()
+		if err != nil {
+			email = "unknown"
+		}
+		expired, err := auth.UserSessionExpired()
+		if err != nil {
+			result.Status = StatusFailed
+			result.Details = fmt.Sprintf("Logged in as user %q, but the session can't be read: %v", email, err)
+			result.Hint = `Log in again with "stackit auth login"`
+			return result
+		}
+		if expired {
+			result.Status = StatusFailed
+			result.Details = fmt.Sprintf("The session of user %q expired", email)
+			result.Hint = `Log in again with "stackit auth login", the length of sessions is set with "stackit config set --session-time-limit"`
+			return result
+		}
+		result.Status = StatusPassed
+		result.Details = fmt.Sprintf("Logged in as user %q", email)
+	case auth.AUTH_FLOW_SERVICE_ACCOUNT_TOKEN, auth.AUTH_FLOW_SERVICE_ACCOUNT_KEY:
+		email, err := auth.GetAuthEmail()
+		if err != nil {
+			email = "unknown"
+		}
+		method := "token"
+		if flow == auth.AUTH_FLOW_SERVICE_ACCOUNT_KEY {
+			method = "key"
+		}
+		result.Status = StatusPassed
+		result.Details = fmt.Sprintf("Authenticated as service account %q with a service account %s", email, method)
+	default:
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("Unknown authentication flow %q", flow)
+		result.Hint = `Log in again with "stackit auth login", or activate a service account with "stackit auth activate-service-account"`
+	}
+	return result
+}
+
+// checkTokenExpiry checks whether the access token expired, and whether it is refreshed automatically then
+func checkTokenExpiry(token string, fromEnv bool, flow auth.AuthFlow, now time.Time) Result {
+	result := Result{Check: "Access token"}
+	if token == "" {
+		result.Status = StatusSkipped
+		result.Details = "No access token is stored"
+		return result
+	}
+	_, expiresAt, err := auth.GetTokenTimes(token)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Details = err.Error()
+		result.Hint = tokenHint(fromEnv)
+		return result
+	}
+	if expiresAt.IsZero() {
+		result.Status = StatusPassed
+		result.Details = "The access token doesn't expire"
+		return result
+	}
+	if expiresAt.After(now) {
+		result.Status = StatusPassed
+		result.Details = fmt.Sprintf("The access token expires at %s", expiresAt.Local().Format(time.DateTime))
+		return result
+	}
+
+	expired := fmt.Sprintf("The access token expired at %s", expiresAt.Local().Format(time.DateTime))
+	if !fromEnv && (flow == auth.AUTH_FLOW_USER_TOKEN || flow == auth.AUTH_FLOW_SERVICE_ACCOUNT_KEY) {
+		result.Status = StatusPassed
+		result.Details = expired + ", it is refreshed by the next command"
+		return result
+	}
+	result.Status = StatusFailed
+	result.Details = expired
+	result.Hint = tokenHint(fromEnv)
+	return result
+}
+
+func tokenHint(fromEnv bool) string {
+	if fromEnv {
+		return "Set the STACKIT_ACCESS_TOKEN environment variable to a new access token"
+	}
+	return `Activate the service account again with a new token with "stackit auth activate-service-account", or use a service account key instead`
+}
+
+// checkClockSkew compares the clock of the workstation to the time reported by the custom endpoints.
+// Without a response of an endpoint, it falls back to the time the access token was issued at,
+// which only reveals a clock that is behind, as a token issued in the past may simply be old.
+func checkClockSkew(token string, serverTime, now time.Time) Result {
+	result := Result{Check: "Clock"}
+	hint := "Synchronize the clock of the system, e.g. enable NTP"
+	if !serverTime.IsZero() {
+		skew := now.Sub(serverTime)
+		switch {
+		case skew > maxClockSkew:
+			result.Status = StatusFailed
+			result.Details = fmt.Sprintf("The clock of this workstation is %s ahead of the clock of the endpoints", skew.Round(time.Second))
+			result.Hint = hint
+		case -skew > maxClockSkew:
+			result.Status = StatusFailed
+			result.Details = fmt.Sprintf("The clock of this workstation is %s behind the clock of the endpoints", (-skew).Round(time.Second))
+			result.Hint = hint
+		default:
+			result.Status = StatusPassed
+			result.Details = "The clock of this workstation is consistent with the clock of the endpoints"
+		}
+		return result
+	}
+
+	if token == "" {
+		result.Status = StatusSkipped
+		result.Details = "No access token or endpoint response to compare the clock with"
+		return result
+	}
+	issuedAt, _, err := auth.GetTokenTimes(token)
+	if err != nil || issuedAt.IsZero() {
+		result.Status = StatusSkipped
+		result.Details = "The access token doesn't contain the time it was issued at"
+		return result
+	}
+	skew := issuedAt.Sub(now)
+	if skew > maxClockSkew {
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("The access token was issued %s in the future, the clock of this workstation is behind", skew.Round(time.Second))
+		result.Hint = hint
+		return result
+	}
+	result.Status = StatusPassed
+	result.Details = fmt.Sprintf("The access token was issued at %s, which is consistent with the clock of this workstation", issuedAt.Local().Format(time.DateTime))
+	return result
+}
+
+func checkCredentialsStorage() Result {
+	result := Result{Check: "Credentials storage"}
+	profile, err := config.GetProfile()
+	if err != nil {
+		result.Status = StatusSkipped
+		result.Details = fmt.Sprintf("The profile can't be determined: %v", err)
+		return result
+	}
+	status, err := auth.GetStorageStatus(profile)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Details = err.Error()
+		return result
+	}
+
+	keyringHint := "Install and unlock a keyring supported by the system, e.g. GNOME Keyring or KWallet on Linux, and log in again. The text file is only encoded, not encrypted"
+	switch {
+	case status.KeyringErr != nil && status.Storage == auth.StorageTextFile:
+		result.Status = StatusWarning
+		result.Details = fmt.Sprintf("The keyring is not available (%v), the credentials are stored in the text file %q", status.KeyringErr, status.TextFilePath)
+		result.Hint = keyringHint
+	case status.KeyringErr != nil:
+		result.Status = StatusWarning
+		result.Details = fmt.Sprintf("The keyring is not available (%v), credentials will be stored in the text file %q", status.KeyringErr, status.TextFilePath)
+		result.Hint = keyringHint
+	case status.Storage == auth.StorageTextFile:
+		result.Status = StatusWarning
+		result.Details = fmt.Sprintf("The keyring is available, but the credentials are stored in the text file %q", status.TextFilePath)
+		result.Hint = "Log in again to store the credentials in the keyring"
+	case status.Storage == auth.StorageKeyring:
+		result.Status = StatusPassed
+		result.Details = "The credentials are stored in the keyring"
+	default:
+		result.Status = StatusPassed
+		result.Details = "The keyring is available, no credentials are stored yet"
+	}
+	return result
+}
+
+// getCustomEndpoints returns the custom endpoint config keys that are set, with their values
+func getCustomEndpoints() (keys []string, endpoints map[string]string) {
+	endpoints = map[string]string{}
+	for _, key := range config.ConfigKeys {
+		if !strings.HasSuffix(key, "_custom_endpoint") || slices.Contains(keys, key) {
+			continue
+		}
+		endpoint := viper.GetString(key)
+		if endpoint == "" {
+			continue
+		}
+		keys = append(keys, key)
+		endpoints[key] = endpoint
+	}
+	return keys, endpoints
+}
+
+// checkEndpoint checks that the custom endpoint responds, and that its TLS certificate is trusted and not about to expire.
+// Any response counts, as the endpoints require authentication.
+// It also returns the time reported by the endpoint, which is zero if it didn't respond with one.
+func checkEndpoint(ctx context.Context, client *http.Client, key, endpoint string, now time.Time) (Result, time.Time) {
+	result := Result{Check: key}
+	var serverTime time.Time
+	hint := `Correct the endpoint with "stackit config set", or remove it with "stackit config unset"`
+
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("%q is not a valid HTTP(S) URL", endpoint)
+		result.Hint = hint
+		return result, serverTime
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, endpointTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Details = fmt.Sprintf("Build request: %v", err)
+		result.Hint = hint
+		return result, serverTime
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Status = StatusFailed
+		var certErr *tls.CertificateVerificationError
+		var recordErr tls.RecordHeaderError
+		switch {
+		case errors.As(err, &certErr):
+			result.Details = fmt.Sprintf("The TLS certificate of %q is not trusted: %v", endpoint, certErr.Err)
+			result.Hint = "Add the certificate authority of the endpoint to the trust store of the system"
+		case errors.As(err, &recordErr):
+			result.Details = fmt.Sprintf("The TLS handshake with %q failed, the endpoint may not support HTTPS: %v", endpoint, err)
+			result.Hint = hint
+		default:
+			result.Details = fmt.Sprintf("%q is not reachable: %v", endpoint, err)
+			result.Hint = "Check the network connection, proxy settings (HTTPS_PROXY) and the endpoint. " + hint
+		}
+		return result, serverTime
+	}
+	_ = resp.Body.Close()
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		serverTime = date
+	}
+
+	if resp.TLS == nil {
+		result.Status = StatusWarning
+		result.Details = fmt.Sprintf("%q is reachable (HTTP %d), but doesn't use TLS, credentials are sent unencrypted", endpoint, resp.StatusCode)
+		result.Hint = "Use an HTTPS endpoint"
+		return result, serverTime
+	}
+	if len(resp.TLS.PeerCertificates) > 0 {
+		expiresAt := resp.TLS.PeerCertificates[0].NotAfter
+		if expiresAt.Sub(now) < certificateExpiryWarning {
+			result.Status = StatusWarning
+			result.Details = fmt.Sprintf("%q is reachable (HTTP %d), but its TLS certificate expires at %s", endpoint, resp.StatusCode, expiresAt.Local().Format(time.DateTime))
+			result.Hint = "Ask the operator of the endpoint to renew the certificate"
+			return result, serverTime
+		}
+	}
+	result.Status = StatusPassed
+	result.Details = fmt.Sprintf("%q is reachable (HTTP %d) with a trusted TLS certificate", endpoint, resp.StatusCode)
+	return result, serverTime
+}
+
+// Binaries other commands rely on, which are optional
+func checkBinaries(lookPath LookPathFunc) []Result {
+	results := []Result{
+		checkBinary(lookPath, "kubectl", "kubectl",
+			`The kubeconfigs created with "stackit ske kubeconfig create" can't be used`,
+			"Install kubectl, see https://kubernetes.io/docs/tasks/tools/"),
+		checkBinary(lookPath, "Pager", print.Pager(),
+			"Long outputs, e.g. of describe commands, are printed without pager",
+			`Install "less", or set the PAGER environment variable to another pager`),
+	}
+
+	browser := auth.BrowserCommand()
+	if browser == "" {
+		results = append(results, Result{
+			Check:   "Browser",
+			Status:  StatusWarning,
+			Details: "Opening the browser is not supported on this platform",
+			Hint:    `Open the URL printed by "stackit auth login" manually, or authenticate with a service account`,
+		})
+		return results
+	}
+	return append(results, checkBinary(lookPath, "Browser", browser,
+		`"stackit auth login" can't open the browser`,
+		`Install a browser opener, e.g. xdg-utils on Linux, or open the URL printed by "stackit auth login" manually`))
+}
+
+func checkBinary(lookPath LookPathFunc, check, command, impact, hint string) Result {
+	// The command may contain arguments, e.g. PAGER="less -R"
+	binary := command
+	if fields := strings.Fields(command); len(fields) > 0 {
+		binary = fields[0]
+	}
+	path, err := lookPath(binary)
+	if err != nil {
+		return Result{
+			Check:   check,
+			Status:  StatusWarning,
+			Details: fmt.Sprintf("%q was not found. %s", binary, impact),
+			Hint:    hint,
+		}
+	}
+	return Result{
+		Check:   check,
+		Status:  StatusPassed,
+		Details: fmt.Sprintf("%q was found at %q", binary, path),
+	}
+}
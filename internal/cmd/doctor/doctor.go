@@ -0,0 +1,140 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+
+	"github.com/spf13/cobra"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Checks the local setup of the CLI",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Checks the local setup of the CLI and reports the outcome of each check, with a hint on how to fix failed checks and warnings.",
+			"The configuration, the active profile, the authentication and expiry of the access token, the storage of the credentials, the reachability and TLS certificates of the custom endpoints, the clock and the binaries used by other commands (kubectl, the pager and the browser) are checked.",
+			"The command fails if any check failed, warnings don't make it fail.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Check the local setup`,
+				"$ stackit doctor"),
+			examples.NewExample(
+				`Check the local setup of the profile "my-profile"`,
+				"$ STACKIT_CLI_PROFILE=my-profile stackit doctor"),
+			examples.NewExample(
+				`Check the local setup and output the results in JSON format`,
+				"$ stackit doctor --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			results := runChecks(cmd.Context(), http.DefaultClient, exec.LookPath, time.Now())
+
+			err = outputResult(params.Printer, model.OutputFormat, results)
+			if err != nil {
+				return err
+			}
+			return resultsErr(results)
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// runChecks runs all checks of the local setup, in the order they depend on each other
+func runChecks(ctx context.Context, client *http.Client, lookPath LookPathFunc, now time.Time) []Result {
+	results := []Result{
+		checkConfiguration(),
+		checkProfile(),
+		checkAuthentication(),
+	}
+
+	token, fromEnv, err := getAccessToken()
+	if err != nil {
+		token = ""
+	}
+	flow, err := auth.GetAuthFlow()
+	if err != nil {
+		flow = ""
+	}
+	results = append(results,
+		checkTokenExpiry(token, fromEnv, flow, now),
+		checkCredentialsStorage(),
+	)
+
+	var serverTime time.Time
+	keys, endpoints := getCustomEndpoints()
+	for _, key := range keys {
+		result, date := checkEndpoint(ctx, client, key, endpoints[key], now)
+		results = append(results, result)
+		if serverTime.IsZero() {
+			serverTime = date
+		}
+	}
+
+	results = append(results, checkClockSkew(token, serverTime, now))
+	return append(results, checkBinaries(lookPath)...)
+}
+
+// resultsErr returns an error if any check failed, so that the command exits with an error
+func resultsErr(results []Result) error {
+	failed := 0
+	for _, r := range results {
+		if r.Status == StatusFailed {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d checks failed", failed, len(results))
+}
+
+var resultFields = []tables.Field[Result]{
+	{Header: "Check", Value: func(r *Result) any { return r.Check }},
+	{Header: "Status", Value: func(r *Result) any { return r.Status }},
+	{Header: "Details", Value: func(r *Result) any { return r.Details }},
+	{Header: "Hint", Value: func(r *Result) any { return r.Hint }},
+}
+
+func outputResult(p *print.Printer, outputFormat string, results []Result) error {
+	return p.OutputResult(outputFormat, results, func() error {
+		table := tables.NewTableFromFields(resultFields, results)
+		err := table.Display(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
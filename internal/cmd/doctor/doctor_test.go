@@ -0,0 +1,336 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+)
+
+var testNow = time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			Verbosity: globalflags.VerbosityDefault,
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    map[string]string{},
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "arguments not allowed",
+			argValues:   []string{"auth"},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func fixtureToken(t *testing.T, issuedAt, expiresAt time.Time) string {
+	t.Helper()
+	claims := jwt.RegisteredClaims{}
+	if !issuedAt.IsZero() {
+		claims.IssuedAt = jwt.NewNumericDate(issuedAt)
+	}
+	if !expiresAt.IsZero() {
+		claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func TestCheckTokenExpiry(t *testing.T) {
+	valid := fixtureToken(t, testNow.Add(-time.Minute), testNow.Add(time.Hour))
+	expired := fixtureToken(t, testNow.Add(-2*time.Hour), testNow.Add(-time.Hour))
+
+	tests := []struct {
+		description    string
+		token          string
+		fromEnv        bool
+		flow           auth.AuthFlow
+		expectedStatus string
+	}{
+		{
+			description:    "no token",
+			expectedStatus: StatusSkipped,
+		},
+		{
+			description:    "valid token",
+			token:          valid,
+			flow:           auth.AUTH_FLOW_SERVICE_ACCOUNT_TOKEN,
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "token without expiry",
+			token:          fixtureToken(t, testNow, time.Time{}),
+			flow:           auth.AUTH_FLOW_SERVICE_ACCOUNT_TOKEN,
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "expired user token is refreshed",
+			token:          expired,
+			flow:           auth.AUTH_FLOW_USER_TOKEN,
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "expired service account key token is refreshed",
+			token:          expired,
+			flow:           auth.AUTH_FLOW_SERVICE_ACCOUNT_KEY,
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "expired service account token",
+			token:          expired,
+			flow:           auth.AUTH_FLOW_SERVICE_ACCOUNT_TOKEN,
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "expired token from environment",
+			token:          expired,
+			fromEnv:        true,
+			flow:           auth.AUTH_FLOW_USER_TOKEN,
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "invalid token",
+			token:          "invalid",
+			expectedStatus: StatusFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result := checkTokenExpiry(tt.token, tt.fromEnv, tt.flow, testNow)
+			if result.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q: %s", tt.expectedStatus, result.Status, result.Details)
+			}
+			if result.Status == StatusFailed && result.Hint == "" {
+				t.Fatalf("expected a hint for a failed check")
+			}
+		})
+	}
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	tests := []struct {
+		description    string
+		token          string
+		serverTime     time.Time
+		expectedStatus string
+	}{
+		{
+			description:    "no token",
+			expectedStatus: StatusSkipped,
+		},
+		{
+			description:    "consistent with the endpoints",
+			serverTime:     testNow.Add(-30 * time.Second),
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "ahead of the endpoints",
+			token:          fixtureToken(t, testNow.Add(-time.Hour), time.Time{}),
+			serverTime:     testNow.Add(-10 * time.Minute),
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "behind the endpoints",
+			serverTime:     testNow.Add(10 * time.Minute),
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "issued in the past",
+			token:          fixtureToken(t, testNow.Add(-time.Hour), time.Time{}),
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "issued slightly in the future",
+			token:          fixtureToken(t, testNow.Add(30*time.Second), time.Time{}),
+			expectedStatus: StatusPassed,
+		},
+		{
+			description:    "issued in the future",
+			token:          fixtureToken(t, testNow.Add(10*time.Minute), time.Time{}),
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "no issued at",
+			token:          fixtureToken(t, time.Time{}, testNow.Add(time.Hour)),
+			expectedStatus: StatusSkipped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result := checkClockSkew(tt.token, tt.serverTime, testNow)
+			if result.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q: %s", tt.expectedStatus, result.Status, result.Details)
+			}
+		})
+	}
+}
+
+func TestCheckEndpoint(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+	tlsServer := httptest.NewTLSServer(handler)
+	t.Cleanup(tlsServer.Close)
+	closedServer := httptest.NewServer(handler)
+	closedServer.Close()
+	certificateExpiresAt := tlsServer.Certificate().NotAfter
+
+	tests := []struct {
+		description      string
+		client           *http.Client
+		endpoint         string
+		now              time.Time
+		expectedStatus   string
+		expectServerTime bool
+	}{
+		{
+			description:      "trusted TLS certificate",
+			client:           tlsServer.Client(),
+			endpoint:         tlsServer.URL,
+			now:              testNow,
+			expectedStatus:   StatusPassed,
+			expectServerTime: true,
+		},
+		{
+			description:      "TLS certificate expires soon",
+			client:           tlsServer.Client(),
+			endpoint:         tlsServer.URL,
+			now:              certificateExpiresAt.Add(-24 * time.Hour),
+			expectedStatus:   StatusWarning,
+			expectServerTime: true,
+		},
+		{
+			description:    "untrusted TLS certificate",
+			client:         &http.Client{},
+			endpoint:       tlsServer.URL,
+			now:            testNow,
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:      "without TLS",
+			client:           &http.Client{},
+			endpoint:         httpServer.URL,
+			now:              testNow,
+			expectedStatus:   StatusWarning,
+			expectServerTime: true,
+		},
+		{
+			description:    "not reachable",
+			client:         &http.Client{},
+			endpoint:       closedServer.URL,
+			now:            testNow,
+			expectedStatus: StatusFailed,
+		},
+		{
+			description:    "invalid URL",
+			client:         &http.Client{},
+			endpoint:       "iaas.example.com",
+			now:            testNow,
+			expectedStatus: StatusFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result, serverTime := checkEndpoint(context.Background(), tt.client, "iaas_custom_endpoint", tt.endpoint, tt.now)
+			if result.Check != "iaas_custom_endpoint" {
+				t.Fatalf("expected the check to be named after the config key, got %q", result.Check)
+			}
+			if result.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q: %s", tt.expectedStatus, result.Status, result.Details)
+			}
+			if result.Status != StatusPassed && result.Hint == "" {
+				t.Fatalf("expected a hint")
+			}
+			if tt.expectServerTime == serverTime.IsZero() {
+				t.Fatalf("expected server time %t, got %v", tt.expectServerTime, serverTime)
+			}
+		})
+	}
+}
+
+func TestCheckBinaries(t *testing.T) {
+	lookPath := func(file string) (string, error) {
+		if file == "kubectl" || strings.Contains(file, " ") {
+			return "", fmt.Errorf("executable file not found in $PATH")
+		}
+		return "/usr/bin/" + file, nil
+	}
+	t.Setenv("PAGER", "less -R")
+	results := checkBinaries(lookPath)
+
+	statuses := map[string]string{}
+	for _, r := range results {
+		statuses[r.Check] = r.Status
+	}
+	if statuses["kubectl"] != StatusWarning {
+		t.Errorf("expected a warning for the missing kubectl, got %q", statuses["kubectl"])
+	}
+	if statuses["Pager"] != StatusPassed {
+		t.Errorf("expected the pager to be found without its arguments, got %q", statuses["Pager"])
+	}
+	if auth.BrowserCommand() != "" && statuses["Browser"] != StatusPassed {
+		t.Errorf("expected the browser to be found, got %q", statuses["Browser"])
+	}
+}
+
+func TestResultsErr(t *testing.T) {
+	results := []Result{
+		{Check: "a", Status: StatusPassed},
+		{Check: "b", Status: StatusWarning},
+		{Check: "c", Status: StatusSkipped},
+	}
+	if err := resultsErr(results); err != nil {
+		t.Fatalf("expected no error without failed checks, got %v", err)
+	}
+
+	results = append(results, Result{Check: "d", Status: StatusFailed})
+	err := resultsErr(results)
+	if err == nil {
+		t.Fatalf("expected an error with a failed check")
+	}
+	if diff := cmp.Diff(err.Error(), "1 of 4 checks failed"); diff != "" {
+		t.Fatalf("Error does not match: %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -42,14 +43,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
 			}
 
 			// Configure API client
-			apiClient, err := client.ConfigureClient(params.Printer)
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
 			if err != nil {
 				return err
 			}
@@ -68,6 +69,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			flavors, err = filter.Items(model.Filter, flavors)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(flavors) > int(*model.Limit) {
 				flavors = (flavors)[:*model.Limit]
@@ -82,6 +87,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -111,6 +118,14 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *git.APIClie
 	return apiClient.ListFlavors(ctx, model.ProjectId)
 }
 
+var flavorFields = []tables.Field[git.Flavor]{
+	{Header: "ID", Value: func(f *git.Flavor) any { return utils.PtrString(f.Id) }},
+	{Header: "DESCRIPTION", Value: func(f *git.Flavor) any { return utils.PtrString(f.Description) }},
+	{Header: "DISPLAY_NAME", Value: func(f *git.Flavor) any { return utils.PtrString(f.DisplayName) }},
+	{Header: "AVAILABLE", Value: func(f *git.Flavor) any { return utils.PtrString(f.Availability) }},
+	{Header: "SKU", Value: func(f *git.Flavor) any { return utils.PtrString(f.Sku) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, flavors []git.Flavor) error {
 	return p.OutputResult(outputFormat, flavors, func() error {
 		if len(flavors) == 0 {
@@ -118,19 +133,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, flavors [
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "DESCRIPTION", "DISPLAY_NAME", "AVAILABLE", "SKU")
-		for i := range flavors {
-			flavor := (flavors)[i]
-			table.AddRow(
-				utils.PtrString(flavor.Id),
-				utils.PtrString(flavor.Description),
-				utils.PtrString(flavor.DisplayName),
-				utils.PtrString(flavor.Availability),
-				utils.PtrString(flavor.Sku),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(flavorFields, flavors)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -35,14 +35,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			examples.NewExample(`Describe instance "xxx"`, `$ stackit git describe xxx`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
 			}
 
 			// Configure API client
-			apiClient, err := client.ConfigureClient(params.Printer)
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
 			if err != nil {
 				return err
 			}
@@ -117,7 +117,7 @@ func outputResult(p *print.Printer, outputFormat string, resp *git.Instance) err
 			table.AddSeparator()
 		}
 
-		if err := table.Display(p); err != nil {
+		if err := table.Display(p, outputFormat); err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -43,14 +44,14 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
 			}
 
 			// Configure API client
-			apiClient, err := client.ConfigureClient(params.Printer)
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
 			if err != nil {
 				return err
 			}
@@ -69,6 +70,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			instances, err = filter.Items(model.Filter, instances)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(instances) > int(*model.Limit) {
 				instances = (instances)[:*model.Limit]
@@ -83,6 +88,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -112,6 +119,15 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *git.APIClie
 	return apiClient.ListInstances(ctx, model.ProjectId)
 }
 
+var instanceFields = []tables.Field[git.Instance]{
+	{Header: "ID", Value: func(i *git.Instance) any { return utils.PtrString(i.Id) }},
+	{Header: "NAME", Value: func(i *git.Instance) any { return utils.PtrString(i.Name) }},
+	{Header: "URL", Value: func(i *git.Instance) any { return utils.PtrString(i.Url) }},
+	{Header: "VERSION", Value: func(i *git.Instance) any { return utils.PtrString(i.Version) }},
+	{Header: "STATE", Value: func(i *git.Instance) any { return utils.PtrString(i.State) }},
+	{Header: "CREATED", Value: func(i *git.Instance) any { return utils.PtrString(i.Created) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, instances []git.Instance) error {
 	return p.OutputResult(outputFormat, instances, func() error {
 		if len(instances) == 0 {
@@ -119,20 +135,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, instances
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "URL", "VERSION", "STATE", "CREATED")
-		for i := range instances {
-			instance := (instances)[i]
-			table.AddRow(
-				utils.PtrString(instance.Id),
-				utils.PtrString(instance.Name),
-				utils.PtrString(instance.Url),
-				utils.PtrString(instance.Version),
-				utils.PtrString(instance.State),
-				utils.PtrString(instance.Created),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(instanceFields, instances)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -0,0 +1,158 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	sinceFlag   = "since"
+	projectFlag = "project"
+	limitFlag   = "limit"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Since          time.Duration
+	HistoryProject *string
+	Limit          *int64
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the commands run that changed resources",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Lists the commands run on this workstation that changed resources, e.g. to review an incident.",
+			"For each run, the time, profile, authenticated identity, workstation, project, command line, IDs of the changed resources and outcome are shown.",
+			`The values of flags holding secrets are redacted. The runs are recorded in the file "history.jsonl" of the config folder, shared by all profiles.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List all recorded commands`,
+				"$ stackit history"),
+			examples.NewExample(
+				`List the commands run in the last 24 hours`,
+				"$ stackit history --since 24h"),
+			examples.NewExample(
+				`List the commands run for the project with ID "xxx" in JSON format`,
+				"$ stackit history --project xxx --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			entries, err := journal.Read(buildFilter(model, time.Now()))
+			if err != nil {
+				return fmt.Errorf("read history: %w", err)
+			}
+			if len(entries) == 0 {
+				params.Printer.Info("No commands found in the history\n")
+				return nil
+			}
+
+			entries, err = filter.Items(model.Filter, entries)
+			if err != nil {
+				return err
+			}
+			// Keep the most recent entries
+			if model.Limit != nil && len(entries) > int(*model.Limit) {
+				entries = entries[len(entries)-int(*model.Limit):]
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, entries)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration(sinceFlag, 0, `Only list the commands run within this duration, e.g. "24h"`)
+	cmd.Flags().Var(flags.UUIDFlag(), projectFlag, "Only list the commands run for the project with this ID")
+	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list, the most recent ones are kept")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	since := flags.FlagWithDefaultToDurationValue(p, cmd, sinceFlag)
+	if since < 0 {
+		return nil, &errors.FlagValidationError{
+			Flag:    sinceFlag,
+			Details: "must not be negative",
+		}
+	}
+	limit := flags.FlagToInt64Pointer(p, cmd, limitFlag)
+	if limit != nil && *limit < 1 {
+		return nil, &errors.FlagValidationError{
+			Flag:    limitFlag,
+			Details: "must be greater than 0",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Since:           since,
+		HistoryProject:  flags.FlagToStringPointer(p, cmd, projectFlag),
+		Limit:           limit,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildFilter(model *inputModel, now time.Time) journal.Filter {
+	f := journal.Filter{}
+	if model.Since > 0 {
+		f.Since = now.Add(-model.Since)
+	}
+	if model.HistoryProject != nil {
+		f.ProjectId = *model.HistoryProject
+	}
+	return f
+}
+
+// The exit code and error of the commands are only displayed with "--columns wide"
+var entryFields = []tables.Field[journal.Entry]{
+	{Header: "Time", Value: func(e *journal.Entry) any { return e.Time.Local().Format(time.DateTime) }},
+	{Header: "Profile", Value: func(e *journal.Entry) any { return e.Profile }},
+	{Header: "Identity", Value: func(e *journal.Entry) any { return e.Identity }},
+	{Header: "Host", Value: func(e *journal.Entry) any { return e.Host }},
+	{Header: "Project ID", Value: func(e *journal.Entry) any { return e.ProjectId }},
+	{Header: "Command", Value: func(e *journal.Entry) any { return e.Command }},
+	{Header: "Resource IDs", Value: func(e *journal.Entry) any { return strings.Join(e.ResourceIds, "\n") }},
+	{Header: "Outcome", Value: func(e *journal.Entry) any { return e.Outcome }},
+	{Header: "Exit Code", Value: func(e *journal.Entry) any { return e.ExitCode }, Wide: true},
+	{Header: "Error", Value: func(e *journal.Entry) any { return e.Error }, Wide: true},
+}
+
+func outputResult(p *print.Printer, outputFormat string, entries []journal.Entry) error {
+	return p.OutputResult(outputFormat, entries, func() error {
+		table := tables.NewTableFromFields(entryFields, entries)
+		err := table.Display(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
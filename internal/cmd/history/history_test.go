@@ -0,0 +1,153 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var testProjectId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		sinceFlag:   "24h",
+		projectFlag: testProjectId,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Since:          24 * time.Hour,
+		HistoryProject: utils.Ptr(testProjectId),
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Since = 0
+				model.HistoryProject = nil
+			}),
+		},
+		{
+			description: "with limit",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[limitFlag] = "10"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Limit = utils.Ptr(int64(10))
+			}),
+		},
+		{
+			description: "since invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[sinceFlag] = "yesterday"
+			}),
+			isValid: false,
+		},
+		{
+			description: "since negative",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[sinceFlag] = "-1h"
+			}),
+			isValid: false,
+		},
+		{
+			description: "project invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "limit invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[limitFlag] = "0"
+			}),
+			isValid: false,
+		},
+		{
+			description: "arguments not allowed",
+			argValues:   []string{"server"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildFilter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		model       *inputModel
+		expected    journal.Filter
+	}{
+		{
+			description: "base",
+			model:       fixtureInputModel(),
+			expected: journal.Filter{
+				Since:     time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+				ProjectId: testProjectId,
+			},
+		},
+		{
+			description: "no filter",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.Since = 0
+				model.HistoryProject = nil
+			}),
+			expected: journal.Filter{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			f := buildFilter(tt.model, now)
+			diff := cmp.Diff(f, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
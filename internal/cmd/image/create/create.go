@@ -13,10 +13,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -103,7 +106,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -159,6 +162,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 	}
 
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
@@ -241,7 +245,8 @@ func uploadFile(ctx context.Context, p *print.Printer, reader io.Reader, filesiz
 	uploadRequest.Header.Add("Content-Type", "application/octet-stream")
 	uploadRequest.ContentLength = filesize
 
-	uploadResponse, err := http.DefaultClient.Do(uploadRequest)
+	client := &http.Client{Transport: har.Transport(cassette.Transport(http.DefaultTransport))}
+	uploadResponse, err := client.Do(uploadRequest)
 	if err != nil {
 		return fmt.Errorf("create image: error contacting server for upload: %w", err)
 	}
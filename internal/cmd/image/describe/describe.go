@@ -35,7 +35,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			examples.NewExample(`Describe image "xxx"`, `$ stackit image describe xxx`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -146,7 +146,7 @@ func outputResult(p *print.Printer, outputFormat string, resp *iaas.Image) error
 			table.AddSeparator()
 		}
 
-		if err := table.Display(p); err != nil {
+		if err := table.Display(p, outputFormat); err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -51,7 +52,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,6 +83,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			if items := response.GetItems(); len(items) == 0 {
 				params.Printer.Info("No images found for project %q", projectLabel)
 			} else {
+				items, err = filter.Items(model.Filter, items)
+				if err != nil {
+					return err
+				}
 				if model.Limit != nil && len(items) > int(*model.Limit) {
 					items = (items)[:*model.Limit]
 				}
@@ -101,6 +106,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -135,41 +142,41 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 
 	return request
 }
+
+var imageFields = []tables.Field[iaas.Image]{
+	{Header: "ID", Value: func(i *iaas.Image) any { return utils.PtrString(i.Id) }},
+	{Header: "NAME", Value: func(i *iaas.Image) any { return utils.PtrString(i.Name) }},
+	{Header: "OS", Value: func(i *iaas.Image) any {
+		if i.Config == nil || i.Config.OperatingSystem == nil {
+			return "n/a"
+		}
+		return *i.Config.OperatingSystem
+	}},
+	{Header: "ARCHITECTURE", Value: func(i *iaas.Image) any {
+		if i.Config == nil || i.Config.Architecture == nil {
+			return "n/a"
+		}
+		return *i.Config.Architecture
+	}},
+	{Header: "DISTRIBUTION", Value: func(i *iaas.Image) any {
+		if i.Config == nil || i.Config.OperatingSystemDistro == nil || !i.Config.OperatingSystemDistro.IsSet() {
+			return "n/a"
+		}
+		return *i.Config.OperatingSystemDistro.Get()
+	}},
+	{Header: "VERSION", Value: func(i *iaas.Image) any {
+		if i.Config == nil || i.Config.OperatingSystemVersion == nil || !i.Config.OperatingSystemVersion.IsSet() {
+			return "n/a"
+		}
+		return *i.Config.OperatingSystemVersion.Get()
+	}},
+	{Header: "LABELS", Value: func(i *iaas.Image) any { return utils.JoinStringKeysPtr(*i.Labels, ",") }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, items []iaas.Image) error {
 	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "OS", "ARCHITECTURE", "DISTRIBUTION", "VERSION", "LABELS")
-		for i := range items {
-			item := items[i]
-			var (
-				architecture string = "n/a"
-				os           string = "n/a"
-				distro       string = "n/a"
-				version      string = "n/a"
-			)
-			if cfg := item.Config; cfg != nil {
-				if v := cfg.Architecture; v != nil {
-					architecture = *v
-				}
-				if v := cfg.OperatingSystem; v != nil {
-					os = *v
-				}
-				if v := cfg.OperatingSystemDistro; v != nil && v.IsSet() {
-					distro = *v.Get()
-				}
-				if v := cfg.OperatingSystemVersion; v != nil && v.IsSet() {
-					version = *v.Get()
-				}
-			}
-			table.AddRow(utils.PtrString(item.Id),
-				utils.PtrString(item.Name),
-				os,
-				architecture,
-				distro,
-				version,
-				utils.JoinStringKeysPtr(*item.Labels, ","))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(imageFields, items)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
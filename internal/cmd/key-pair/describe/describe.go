@@ -53,7 +53,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -107,6 +107,13 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 }
 
 func outputResult(p *print.Printer, outputFormat string, showOnlyPublicKey bool, keyPair iaas.Keypair) error {
+	if print.IsTemplateOutputFormat(outputFormat) {
+		if showOnlyPublicKey {
+			return p.OutputResult(outputFormat, map[string]string{"publicKey": utils.PtrString(keyPair.PublicKey)}, nil)
+		}
+		return p.OutputResult(outputFormat, keyPair, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(keyPair, "", "  ")
@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -57,7 +58,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,6 +83,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -96,6 +101,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Number of key pairs to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -127,31 +134,30 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var keyPairFields = []tables.Field[iaas.Keypair]{
+	{Header: "KEY PAIR NAME", Value: func(kp *iaas.Keypair) any { return utils.PtrString(kp.Name) }},
+	{Header: "LABELS", Value: func(kp *iaas.Keypair) any {
+		var labels []string
+		if kp.Labels != nil {
+			for key, value := range *kp.Labels {
+				labels = append(labels, fmt.Sprintf("%s: %s", key, value))
+			}
+		}
+		return strings.Join(labels, ", ")
+	}},
+	{Header: "FINGERPRINT", Value: func(kp *iaas.Keypair) any { return utils.PtrString(kp.Fingerprint) }},
+	{Header: "CREATED AT", Value: func(kp *iaas.Keypair) any { return utils.PtrString(kp.CreatedAt) }},
+	{Header: "UPDATED AT", Value: func(kp *iaas.Keypair) any { return utils.PtrString(kp.UpdatedAt) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, keyPairs []iaas.Keypair) error {
 	return p.OutputResult(outputFormat, keyPairs, func() error {
-		table := tables.NewTable()
-		table.SetHeader("KEY PAIR NAME", "LABELS", "FINGERPRINT", "CREATED AT", "UPDATED AT")
-
-		for idx := range keyPairs {
-			keyPair := keyPairs[idx]
+		table := tables.NewTableFromFields(keyPairFields, keyPairs)
 
-			var labels []string
-			if keyPair.Labels != nil {
-				for key, value := range *keyPair.Labels {
-					labels = append(labels, fmt.Sprintf("%s: %s", key, value))
-				}
-			}
-
-			table.AddRow(
-				utils.PtrString(keyPair.Name),
-				strings.Join(labels, ", "),
-				utils.PtrString(keyPair.Fingerprint),
-				utils.PtrString(keyPair.CreatedAt),
-				utils.PtrString(keyPair.UpdatedAt),
-			)
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
@@ -43,7 +43,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit load-balancer describe my-load-balancer --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -105,7 +105,7 @@ func outputResult(p *print.Printer, outputFormat string, loadBalancer *loadbalan
 			content = append(content, buildTargetPoolsTable(*loadBalancer.TargetPools))
 		}
 
-		err := tables.DisplayTables(p, content)
+		err := tables.DisplayTables(p, content, outputFormat)
 		if err != nil {
 			return fmt.Errorf("display output: %w", err)
 		}
@@ -135,7 +135,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit load-balancer generate-payload --lb-name xxx`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
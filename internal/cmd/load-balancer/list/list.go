@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit load-balancer list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -77,6 +78,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 
 			loadBalancers := *resp.LoadBalancers
+			loadBalancers, err = filter.Items(model.Filter, loadBalancers)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(loadBalancers) > int(*model.Limit) {
 				loadBalancers = loadBalancers[:*model.Limit]
@@ -92,6 +97,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -122,30 +129,30 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *loadbalance
 	return req
 }
 
+var loadBalancerFields = []tables.Field[loadbalancer.LoadBalancer]{
+	{Header: "NAME", Value: func(lb *loadbalancer.LoadBalancer) any { return utils.PtrString(lb.Name) }},
+	{Header: "STATE", Value: func(lb *loadbalancer.LoadBalancer) any { return utils.PtrString(lb.Status) }},
+	{Header: "IP ADDRESS", Value: func(lb *loadbalancer.LoadBalancer) any { return utils.PtrStringDefault(lb.ExternalAddress, "-") }},
+	{Header: "LISTENERS", Value: func(lb *loadbalancer.LoadBalancer) any {
+		var numListeners int
+		if lb.Listeners != nil {
+			numListeners = len(*lb.Listeners)
+		}
+		return numListeners
+	}},
+	{Header: "TARGET POOLS", Value: func(lb *loadbalancer.LoadBalancer) any {
+		var numTargetPools int
+		if lb.TargetPools != nil {
+			numTargetPools = len(*lb.TargetPools)
+		}
+		return numTargetPools
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, loadBalancers []loadbalancer.LoadBalancer) error {
 	return p.OutputResult(outputFormat, loadBalancers, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NAME", "STATE", "IP ADDRESS", "LISTENERS", "TARGET POOLS")
-		for i := range loadBalancers {
-			l := loadBalancers[i]
-			var numListeners, numTargetPools int
-			if l.Listeners != nil {
-				numListeners = len(*l.Listeners)
-			}
-			if l.TargetPools != nil {
-				numTargetPools = len(*l.TargetPools)
-			}
-
-			externalAddress := utils.PtrStringDefault(l.ExternalAddress, "-")
-			table.AddRow(
-				utils.PtrString(l.Name),
-				utils.PtrString(l.Status),
-				externalAddress,
-				numListeners,
-				numTargetPools,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(loadBalancerFields, loadBalancers)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
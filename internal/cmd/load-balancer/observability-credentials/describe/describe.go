@@ -39,7 +39,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit load-balancer observability-credentials describe credentials-xxx"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -99,7 +99,7 @@ func outputResult(p *print.Printer, outputFormat string, credentials *loadbalanc
 		table.AddSeparator()
 		table.AddRow("USERNAME", utils.PtrString(credentials.Credential.Username))
 		table.AddSeparator()
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
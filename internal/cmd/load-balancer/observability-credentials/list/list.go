@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -58,7 +59,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit load-balancer observability-credentials list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -108,6 +109,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			credentials, err = filter.Items(model.Filter, credentials)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentials) > int(*model.Limit) {
 				credentials = credentials[:*model.Limit]
@@ -125,6 +130,8 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(unusedFlag, false, "List only credentials not being used by a Load Balancer")
 
 	cmd.MarkFlagsMutuallyExclusive(usedFlag, unusedFlag)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -157,15 +164,16 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *loadbalance
 	return req
 }
 
+var credentialsFields = []tables.Field[loadbalancer.CredentialsResponse]{
+	{Header: "REFERENCE", Value: func(c *loadbalancer.CredentialsResponse) any { return utils.PtrString(c.CredentialsRef) }},
+	{Header: "DISPLAY NAME", Value: func(c *loadbalancer.CredentialsResponse) any { return utils.PtrString(c.DisplayName) }},
+	{Header: "USERNAME", Value: func(c *loadbalancer.CredentialsResponse) any { return utils.PtrString(c.Username) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, credentials []loadbalancer.CredentialsResponse) error {
 	return p.OutputResult(outputFormat, credentials, func() error {
-		table := tables.NewTable()
-		table.SetHeader("REFERENCE", "DISPLAY NAME", "USERNAME")
-		for i := range credentials {
-			c := credentials[i]
-			table.AddRow(utils.PtrString(c.CredentialsRef), utils.PtrString(c.DisplayName), utils.PtrString(c.Username))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsFields, credentials)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -46,7 +46,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit logme credentials describe xxx --instance-id yyy --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -123,7 +123,7 @@ func outputResult(p *print.Printer, outputFormat string, credentials *logme.Cred
 				table.AddRow("URI", utils.PtrString(cred.Uri))
 			}
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -49,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit logme credentials list --instance-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -75,6 +76,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				instanceLabel = model.InstanceId
 			}
 
+			credentials, err = filter.Items(model.Filter, credentials)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentials) > int(*model.Limit) {
 				credentials = credentials[:*model.Limit]
@@ -92,6 +97,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -123,6 +130,10 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *logme.APICl
 	return req
 }
 
+var credentialsFields = []tables.Field[logme.CredentialsListItem]{
+	{Header: "ID", Value: func(c *logme.CredentialsListItem) any { return utils.PtrString(c.Id) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, instanceLabel string, credentials []logme.CredentialsListItem) error {
 	return p.OutputResult(outputFormat, credentials, func() error {
 		if len(credentials) == 0 {
@@ -130,13 +141,8 @@ func outputResult(p *print.Printer, outputFormat, instanceLabel string, credenti
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID")
-		for i := range credentials {
-			c := credentials[i]
-			table.AddRow(utils.PtrString(c.Id))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsFields, credentials)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
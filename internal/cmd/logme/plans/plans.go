@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit logme plans --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -72,6 +73,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			plans, err = filter.Items(model.Filter, plans)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(plans) > int(*model.Limit) {
 				plans = plans[:*model.Limit]
@@ -87,6 +92,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -117,6 +124,20 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *logme.APICl
 	return req
 }
 
+// A row of the pretty output, the plans are listed with their offering
+type planRow struct {
+	offering *logme.Offering
+	plan     *logme.Plan
+}
+
+var planFields = []tables.Field[planRow]{
+	{Header: "OFFERING NAME", Value: func(r *planRow) any { return utils.PtrString(r.offering.Name) }},
+	{Header: "VERSION", Value: func(r *planRow) any { return utils.PtrString(r.offering.Version) }},
+	{Header: "ID", Value: func(r *planRow) any { return utils.PtrString(r.plan.Id) }},
+	{Header: "NAME", Value: func(r *planRow) any { return utils.PtrString(r.plan.Name) }},
+	{Header: "DESCRIPTION", Value: func(r *planRow) any { return utils.PtrString(r.plan.Description) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, plans []logme.Offering) error {
 	return p.OutputResult(outputFormat, plans, func() error {
 		if len(plans) == 0 {
@@ -124,26 +145,19 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, plans []l
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("OFFERING NAME", "VERSION", "ID", "NAME", "DESCRIPTION")
+		rows := []planRow{}
 		for i := range plans {
-			o := plans[i]
+			o := &plans[i]
 			if o.Plans != nil {
 				for j := range *o.Plans {
-					p := (*o.Plans)[j]
-					table.AddRow(
-						utils.PtrString(o.Name),
-						utils.PtrString(o.Version),
-						utils.PtrString(p.Id),
-						utils.PtrString(p.Name),
-						utils.PtrString(p.Description),
-					)
+					rows = append(rows, planRow{offering: o, plan: &(*o.Plans)[j]})
 				}
-				table.AddSeparator()
 			}
 		}
+		// The plans of an offering are separated from the plans of the next offering
+		table := tables.NewGroupedTableFromFields(planFields, rows, func(r *planRow) *logme.Offering { return r.offering })
 		table.EnableAutoMergeOnColumns(1, 2)
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -48,7 +49,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit mariadb credentials list --instance-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -74,6 +75,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				instanceLabel = model.InstanceId
 			}
 
+			credentials, err = filter.Items(model.Filter, credentials)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentials) > int(*model.Limit) {
 				credentials = credentials[:*model.Limit]
@@ -91,6 +96,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -122,6 +129,10 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *mariadb.API
 	return req
 }
 
+var credentialsFields = []tables.Field[mariadb.CredentialsListItem]{
+	{Header: "ID", Value: func(c *mariadb.CredentialsListItem) any { return utils.PtrString(c.Id) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, instanceLabel string, credentials []mariadb.CredentialsListItem) error {
 	return p.OutputResult(outputFormat, credentials, func() error {
 		if len(credentials) == 0 {
@@ -129,13 +140,8 @@ func outputResult(p *print.Printer, outputFormat, instanceLabel string, credenti
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID")
-		for i := range credentials {
-			c := credentials[i]
-			table.AddRow(utils.PtrString(c.Id))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsFields, credentials)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit mariadb instance list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -72,6 +73,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			instances, err = filter.Items(model.Filter, instances)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(instances) > int(*model.Limit) {
 				instances = instances[:*model.Limit]
@@ -87,6 +92,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -117,6 +124,23 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *mariadb.API
 	return req
 }
 
+var instanceFields = []tables.Field[mariadb.Instance]{
+	{Header: "ID", Value: func(i *mariadb.Instance) any { return utils.PtrString(i.InstanceId) }},
+	{Header: "NAME", Value: func(i *mariadb.Instance) any { return utils.PtrString(i.Name) }},
+	{Header: "LAST OPERATION TYPE", Value: func(i *mariadb.Instance) any {
+		if i.LastOperation == nil {
+			return ""
+		}
+		return utils.PtrString(i.LastOperation.Type)
+	}},
+	{Header: "LAST OPERATION STATE", Value: func(i *mariadb.Instance) any {
+		if i.LastOperation == nil {
+			return ""
+		}
+		return utils.PtrString(i.LastOperation.State)
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, instances []mariadb.Instance) error {
 	return p.OutputResult(outputFormat, instances, func() error {
 		if len(instances) == 0 {
@@ -124,25 +148,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, instances
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "LAST OPERATION TYPE", "LAST OPERATION STATE")
-		for i := range instances {
-			instance := instances[i]
-
-			lastOperationType, lastOperationState := "", ""
-			if instance.LastOperation != nil {
-				lastOperationType = utils.PtrString(instance.LastOperation.Type)
-				lastOperationState = utils.PtrString(instance.LastOperation.State)
-			}
-
-			table.AddRow(
-				utils.PtrString(instance.InstanceId),
-				utils.PtrString(instance.Name),
-				lastOperationType,
-				lastOperationState,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(instanceFields, instances)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
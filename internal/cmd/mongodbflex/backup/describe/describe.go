@@ -47,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.SingleArg(backupIdArg, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -130,7 +130,7 @@ func outputResult(p *print.Printer, outputFormat, restoreStatus string, backup m
 		table.AddSeparator()
 		table.AddRow("RESTORE STATUS", restoreStatus)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
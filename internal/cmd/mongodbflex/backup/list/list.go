@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -81,6 +82,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("get restore jobs for MongoDB Flex instance %q: %w", instanceLabel, err)
 			}
 
+			backups, err = filter.Items(model.Filter, backups)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(backups) > int(*model.Limit) {
 				backups = backups[:*model.Limit]
@@ -100,6 +105,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -131,6 +138,17 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex
 	return req
 }
 
+// The restore status of the backups is looked up in the restore jobs
+func backupFields(restoreJobs *mongodbflex.ListRestoreJobsResponse) []tables.Field[mongodbflex.Backup] {
+	return []tables.Field[mongodbflex.Backup]{
+		{Header: "ID", Value: func(b *mongodbflex.Backup) any { return utils.PtrString(b.Id) }},
+		{Header: "CREATED AT", Value: func(b *mongodbflex.Backup) any { return utils.PtrString(b.StartTime) }},
+		{Header: "EXPIRES AT", Value: func(b *mongodbflex.Backup) any { return utils.PtrString(b.EndTime) }},
+		{Header: "BACKUP SIZE", Value: func(b *mongodbflex.Backup) any { return utils.PtrByteSizeDefault(b.Size, "n/a") }},
+		{Header: "RESTORE STATUS", Value: func(b *mongodbflex.Backup) any { return mongodbflexUtils.GetRestoreStatus(*b.Id, restoreJobs) }},
+	}
+}
+
 func outputResult(p *print.Printer, outputFormat, instanceLabel string, backups []mongodbflex.Backup, restoreJobs *mongodbflex.ListRestoreJobsResponse) error {
 	if restoreJobs == nil {
 		return fmt.Errorf("restore jobs is empty")
@@ -142,20 +160,8 @@ func outputResult(p *print.Printer, outputFormat, instanceLabel string, backups
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "CREATED AT", "EXPIRES AT", "BACKUP SIZE", "RESTORE STATUS")
-		for i := range backups {
-			backup := backups[i]
-			restoreStatus := mongodbflexUtils.GetRestoreStatus(*backup.Id, restoreJobs)
-			backupSize := utils.PtrByteSizeDefault(backup.Size, "n/a")
-			table.AddRow(
-				utils.PtrString(backup.Id),
-				utils.PtrString(backup.StartTime),
-				utils.PtrString(backup.EndTime),
-				backupSize,
-				restoreStatus)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(backupFields(restoreJobs), backups)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
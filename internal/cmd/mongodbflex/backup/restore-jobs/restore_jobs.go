@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -49,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -79,6 +80,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			restoreJobs := *resp.Items
 
+			restoreJobs, err = filter.Items(model.Filter, restoreJobs)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(restoreJobs) > int(*model.Limit) {
 				restoreJobs = restoreJobs[:*model.Limit]
@@ -98,6 +103,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -129,22 +136,18 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex
 	return req
 }
 
+var restoreJobFields = []tables.Field[mongodbflex.RestoreInstanceStatus]{
+	{Header: "ID", Value: func(rj *mongodbflex.RestoreInstanceStatus) any { return utils.PtrString(rj.Id) }},
+	{Header: "BACKUP ID", Value: func(rj *mongodbflex.RestoreInstanceStatus) any { return utils.PtrString(rj.BackupID) }},
+	{Header: "BACKUP INSTANCE ID", Value: func(rj *mongodbflex.RestoreInstanceStatus) any { return utils.PtrString(rj.InstanceId) }},
+	{Header: "DATE", Value: func(rj *mongodbflex.RestoreInstanceStatus) any { return utils.PtrString(rj.Date) }},
+	{Header: "STATUS", Value: func(rj *mongodbflex.RestoreInstanceStatus) any { return utils.PtrString(rj.Status) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, restoreJobs []mongodbflex.RestoreInstanceStatus) error {
 	return p.OutputResult(outputFormat, restoreJobs, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "BACKUP ID", "BACKUP INSTANCE ID", "DATE", "STATUS")
-		for i := range restoreJobs {
-			restoreJob := restoreJobs[i]
-
-			table.AddRow(
-				utils.PtrString(restoreJob.Id),
-				utils.PtrString(restoreJob.BackupID),
-				utils.PtrString(restoreJob.InstanceId),
-				utils.PtrString(restoreJob.Date),
-				utils.PtrString(restoreJob.Status),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(restoreJobFields, restoreJobs)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit mongodbflex instance list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -73,6 +74,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			instances, err = filter.Items(model.Filter, instances)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(instances) > int(*model.Limit) {
 				instances = instances[:*model.Limit]
@@ -88,6 +93,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -118,6 +125,12 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex
 	return req
 }
 
+var instanceFields = []tables.Field[mongodbflex.InstanceListInstance]{
+	{Header: "ID", Value: func(i *mongodbflex.InstanceListInstance) any { return utils.PtrString(i.Id) }},
+	{Header: "NAME", Value: func(i *mongodbflex.InstanceListInstance) any { return utils.PtrString(i.Name) }},
+	{Header: "STATUS", Value: func(i *mongodbflex.InstanceListInstance) any { return utils.PtrString(i.Status) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, instances []mongodbflex.InstanceListInstance) error {
 	return p.OutputResult(outputFormat, instances, func() error {
 		if len(instances) == 0 {
@@ -125,17 +138,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, instances
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATUS")
-		for i := range instances {
-			instance := instances[i]
-			table.AddRow(
-				utils.PtrString(instance.Id),
-				utils.PtrString(instance.Name),
-				utils.PtrString(instance.Status),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(instanceFields, instances)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
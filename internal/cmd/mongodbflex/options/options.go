@@ -63,7 +63,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit mongodbflex options --storages --flavor-id <FLAVOR_ID>"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -181,11 +181,11 @@ func outputResult(p *print.Printer, model *inputModel, flavors *mongodbflex.List
 	}
 
 	return p.OutputResult(model.OutputFormat, options, func() error {
-		return outputResultAsTable(p, model, options)
+		return outputResultAsTable(p, model.OutputFormat, model, options)
 	})
 }
 
-func outputResultAsTable(p *print.Printer, model *inputModel, options *options) error {
+func outputResultAsTable(p *print.Printer, outputFormat string, model *inputModel, options *options) error {
 	if model == nil {
 		return fmt.Errorf("model is nil")
 	} else if options == nil {
@@ -203,7 +203,7 @@ func outputResultAsTable(p *print.Printer, model *inputModel, options *options)
 		content = append(content, buildStoragesTable(*options.Storages.Storages))
 	}
 
-	err := tables.DisplayTables(p, content)
+	err := tables.DisplayTables(p, content, outputFormat)
 	if err != nil {
 		return fmt.Errorf("display output: %w", err)
 	}
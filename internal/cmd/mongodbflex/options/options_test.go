@@ -421,7 +421,7 @@ func TestOutputResultAsTable(t *testing.T) {
 	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := outputResultAsTable(p, tt.args.model, tt.args.options); (err != nil) != tt.wantErr {
+			if err := outputResultAsTable(p, print.PrettyOutputFormat, tt.args.model, tt.args.options); (err != nil) != tt.wantErr {
 				t.Errorf("outputResultAsTable() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
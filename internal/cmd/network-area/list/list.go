@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -59,7 +60,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -96,8 +97,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -116,6 +121,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, organizationIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -147,30 +154,28 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var networkAreaFields = []tables.Field[iaas.NetworkArea]{
+	{Header: "ID", Value: func(na *iaas.NetworkArea) any { return utils.PtrString(na.AreaId) }},
+	{Header: "Name", Value: func(na *iaas.NetworkArea) any { return utils.PtrString(na.Name) }},
+	{Header: "Status", Value: func(na *iaas.NetworkArea) any { return utils.PtrString(na.State) }},
+	{Header: "Network Ranges", Value: func(na *iaas.NetworkArea) any {
+		if na.Ipv4 == nil || na.Ipv4.NetworkRanges == nil {
+			return "n/a"
+		}
+		return fmt.Sprint(len(*na.Ipv4.NetworkRanges))
+	}},
+	{Header: "# Attached Projects", Value: func(na *iaas.NetworkArea) any { return utils.PtrString(na.ProjectCount) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, networkAreas []iaas.NetworkArea) error {
 	return p.OutputResult(outputFormat, networkAreas, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "Name", "Status", "Network Ranges", "# Attached Projects")
-
-		for _, networkArea := range networkAreas {
-			networkRanges := "n/a"
-			if ipv4 := networkArea.Ipv4; ipv4 != nil {
-				if netRange := ipv4.NetworkRanges; netRange != nil {
-					networkRanges = fmt.Sprint(len(*netRange))
-				}
-			}
+		table := tables.NewTableFromFields(networkAreaFields, networkAreas)
+		table.EnableRowSeparators()
 
-			table.AddRow(
-				utils.PtrString(networkArea.AreaId),
-				utils.PtrString(networkArea.Name),
-				utils.PtrString(networkArea.State),
-				networkRanges,
-				utils.PtrString(networkArea.ProjectCount),
-			)
-			table.AddSeparator()
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
@@ -45,7 +45,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -110,7 +110,7 @@ func outputResult(p *print.Printer, outputFormat string, networkRange *iaas.Netw
 		table.AddSeparator()
 		table.AddRow("Network range", utils.PtrString(networkRange.Prefix))
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -54,7 +55,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -84,8 +85,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -104,6 +109,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, organizationIdFlag, networkAreaIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -131,16 +138,19 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return apiClient.ListNetworkAreaRanges(ctx, *model.OrganizationId, *model.NetworkAreaId)
 }
 
+var networkRangeFields = []tables.Field[iaas.NetworkRange]{
+	{Header: "ID", Value: func(nr *iaas.NetworkRange) any { return utils.PtrString(nr.NetworkRangeId) }},
+	{Header: "Network Range", Value: func(nr *iaas.NetworkRange) any { return utils.PtrString(nr.Prefix) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, networkRanges []iaas.NetworkRange) error {
 	return p.OutputResult(outputFormat, networkRanges, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "Network Range")
+		table := tables.NewTableFromFields(networkRangeFields, networkRanges)
 
-		for _, networkRange := range networkRanges {
-			table.AddRow(utils.PtrString(networkRange.NetworkRangeId), utils.PtrString(networkRange.Prefix))
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
@@ -50,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -121,7 +121,7 @@ func outputResult(p *print.Printer, outputFormat string, route iaas.Route) error
 			table.AddRow("LABELS", strings.Join(labels, "\n"))
 		}
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
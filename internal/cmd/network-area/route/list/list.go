@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -53,7 +54,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -83,8 +84,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -103,6 +108,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, organizationIdFlag, networkAreaIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -130,20 +137,20 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return apiClient.ListNetworkAreaRoutes(ctx, *model.OrganizationId, *model.NetworkAreaId)
 }
 
+var routeFields = []tables.Field[iaas.Route]{
+	{Header: "Static Route ID", Value: func(r *iaas.Route) any { return utils.PtrString(r.RouteId) }},
+	{Header: "Next Hop", Value: func(r *iaas.Route) any { return utils.PtrString(r.Nexthop) }},
+	{Header: "Prefix", Value: func(r *iaas.Route) any { return utils.PtrString(r.Prefix) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, routes []iaas.Route) error {
 	return p.OutputResult(outputFormat, routes, func() error {
-		table := tables.NewTable()
-		table.SetHeader("Static Route ID", "Next Hop", "Prefix")
-
-		for _, route := range routes {
-			table.AddRow(
-				utils.PtrString(route.RouteId),
-				utils.PtrString(route.Nexthop),
-				utils.PtrString(route.Prefix),
-			)
-		}
+		table := tables.NewTableFromFields(routeFields, routes)
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
@@ -52,7 +52,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -157,7 +157,7 @@ func outputResult(p *print.Printer, outputFormat string, nic *iaas.NIC) error {
 			table.AddRow("SECURITY GROUPS", strings.Join(*nic.SecurityGroups, "\n"))
 		}
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -57,7 +58,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -88,8 +89,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -108,6 +113,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, networkIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -144,25 +151,25 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var nicFields = []tables.Field[iaas.NIC]{
+	{Header: "ID", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Id) }},
+	{Header: "NAME", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Name) }},
+	{Header: "NIC SECURITY", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.NicSecurity) }},
+	{Header: "DEVICE ID", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Device) }},
+	{Header: "IPv4 ADDRESS", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Ipv4) }},
+	{Header: "STATUS", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Status) }},
+	{Header: "TYPE", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Type) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, nics []iaas.NIC) error {
 	return p.OutputResult(outputFormat, nics, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "NIC SECURITY", "DEVICE ID", "IPv4 ADDRESS", "STATUS", "TYPE")
-
-		for _, nic := range nics {
-			table.AddRow(
-				utils.PtrString(nic.Id),
-				utils.PtrString(nic.Name),
-				utils.PtrString(nic.NicSecurity),
-				utils.PtrString(nic.Device),
-				utils.PtrString(nic.Ipv4),
-				utils.PtrString(nic.Status),
-				utils.PtrString(nic.Type),
-			)
-			table.AddSeparator()
-		}
+		table := tables.NewTableFromFields(nicFields, nics)
+		table.EnableRowSeparators()
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
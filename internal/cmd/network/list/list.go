@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -56,7 +57,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -87,8 +88,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -103,6 +108,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -137,32 +144,24 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var networkFields = []tables.Field[iaas.Network]{
+	{Header: "ID", Value: func(n *iaas.Network) any { return utils.PtrString(n.NetworkId) }},
+	{Header: "NAME", Value: func(n *iaas.Network) any { return utils.PtrString(n.Name) }},
+	{Header: "STATUS", Value: func(n *iaas.Network) any { return utils.PtrString(n.State) }},
+	{Header: "PUBLIC IP", Value: func(n *iaas.Network) any { return utils.PtrString(n.PublicIp) }},
+	{Header: "PREFIXES", Value: func(n *iaas.Network) any { return utils.JoinStringPtr(n.Prefixes, ", ") }},
+	{Header: "ROUTED", Value: func(n *iaas.Network) any { return n.Routed != nil && *n.Routed }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, networks []iaas.Network) error {
 	return p.OutputResult(outputFormat, networks, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATUS", "PUBLIC IP", "PREFIXES", "ROUTED")
-
-		for _, network := range networks {
-			publicIp := utils.PtrString(network.PublicIp)
+		table := tables.NewTableFromFields(networkFields, networks)
+		table.EnableRowSeparators()
 
-			routed := false
-			if network.Routed != nil {
-				routed = *network.Routed
-			}
-			prefixes := utils.JoinStringPtr(network.Prefixes, ", ")
-
-			table.AddRow(
-				utils.PtrString(network.NetworkId),
-				utils.PtrString(network.Name),
-				utils.PtrString(network.State),
-				publicIp,
-				prefixes,
-				routed,
-			)
-			table.AddSeparator()
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/object-storage/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/object-storage/utils"
@@ -40,7 +41,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit object-storage bucket create my-bucket"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -92,6 +93,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			return outputResult(params.Printer, model.OutputFormat, model.Async, model.BucketName, resp)
 		},
 	}
+	journal.Enable(cmd)
 	return cmd
 }
 
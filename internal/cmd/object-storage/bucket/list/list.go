@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -46,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit object-storage bucket list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -72,6 +73,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			buckets, err = filter.Items(model.Filter, buckets)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(buckets) > int(*model.Limit) {
 				buckets = buckets[:*model.Limit]
@@ -87,6 +92,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -117,6 +124,13 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *objectstora
 	return req
 }
 
+var bucketFields = []tables.Field[objectstorage.Bucket]{
+	{Header: "NAME", Value: func(b *objectstorage.Bucket) any { return utils.PtrString(b.Name) }},
+	{Header: "REGION", Value: func(b *objectstorage.Bucket) any { return utils.PtrString(b.Region) }},
+	{Header: "URL (PATH STYLE)", Value: func(b *objectstorage.Bucket) any { return utils.PtrString(b.UrlPathStyle) }},
+	{Header: "URL (VIRTUAL HOSTED STYLE)", Value: func(b *objectstorage.Bucket) any { return utils.PtrString(b.UrlVirtualHostedStyle) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, buckets []objectstorage.Bucket) error {
 	if buckets == nil {
 		return fmt.Errorf("buckets is empty")
@@ -128,18 +142,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, buckets [
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("NAME", "REGION", "URL (PATH STYLE)", "URL (VIRTUAL HOSTED STYLE)")
-		for i := range buckets {
-			bucket := buckets[i]
-			table.AddRow(
-				utils.PtrString(bucket.Name),
-				utils.PtrString(bucket.Region),
-				utils.PtrString(bucket.UrlPathStyle),
-				utils.PtrString(bucket.UrlVirtualHostedStyle),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(bucketFields, buckets)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -45,7 +46,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit object-storage credentials-group list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -65,6 +66,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			credentialsGroups := resp.GetCredentialsGroups()
 
+			credentialsGroups, err = filter.Items(model.Filter, credentialsGroups)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentialsGroups) > int(*model.Limit) {
 				credentialsGroups = credentialsGroups[:*model.Limit]
@@ -78,6 +83,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -108,6 +115,12 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *objectstora
 	return req
 }
 
+var credentialsGroupFields = []tables.Field[objectstorage.CredentialsGroup]{
+	{Header: "ID", Value: func(cg *objectstorage.CredentialsGroup) any { return utils.PtrString(cg.CredentialsGroupId) }},
+	{Header: "NAME", Value: func(cg *objectstorage.CredentialsGroup) any { return utils.PtrString(cg.DisplayName) }},
+	{Header: "URN", Value: func(cg *objectstorage.CredentialsGroup) any { return utils.PtrString(cg.Urn) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, credentialsGroups []objectstorage.CredentialsGroup) error {
 	return p.OutputResult(outputFormat, credentialsGroups, func() error {
 		if len(credentialsGroups) == 0 {
@@ -115,17 +128,8 @@ func outputResult(p *print.Printer, outputFormat string, credentialsGroups []obj
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "URN")
-		for i := range credentialsGroups {
-			c := credentialsGroups[i]
-			table.AddRow(
-				utils.PtrString(c.CredentialsGroupId),
-				utils.PtrString(c.DisplayName),
-				utils.PtrString(c.Urn),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsGroupFields, credentialsGroups)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
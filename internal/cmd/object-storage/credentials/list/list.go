@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -48,7 +49,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit object-storage credentials list --credentials-group-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -74,6 +75,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				credentialsGroupLabel = model.CredentialsGroupId
 			}
 
+			credentials, err = filter.Items(model.Filter, credentials)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentials) > int(*model.Limit) {
 				credentials = credentials[:*model.Limit]
@@ -91,6 +96,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, credentialsGroupIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -123,6 +130,12 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *objectstora
 	return req
 }
 
+var credentialsFields = []tables.Field[objectstorage.AccessKey]{
+	{Header: "CREDENTIALS ID", Value: func(c *objectstorage.AccessKey) any { return utils.PtrString(c.KeyId) }},
+	{Header: "ACCESS KEY ID", Value: func(c *objectstorage.AccessKey) any { return utils.PtrString(c.DisplayName) }},
+	{Header: "EXPIRES AT", Value: func(c *objectstorage.AccessKey) any { return utils.PtrStringDefault(c.Expires, "Never") }},
+}
+
 func outputResult(p *print.Printer, outputFormat, credentialsGroupLabel string, credentials []objectstorage.AccessKey) error {
 	return p.OutputResult(outputFormat, credentials, func() error {
 		if len(credentials) == 0 {
@@ -130,15 +143,8 @@ func outputResult(p *print.Printer, outputFormat, credentialsGroupLabel string,
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("CREDENTIALS ID", "ACCESS KEY ID", "EXPIRES AT")
-		for i := range credentials {
-			c := credentials[i]
-
-			expiresAt := utils.PtrStringDefault(c.Expires, "Never")
-			table.AddRow(utils.PtrString(c.KeyId), utils.PtrString(c.DisplayName), expiresAt)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsFields, credentials)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -49,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit observability credentials list --instance-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -78,6 +79,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			credentials, err = filter.Items(model.Filter, credentials)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(credentials) > int(*model.Limit) {
 				credentials = credentials[:*model.Limit]
@@ -95,6 +100,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -123,15 +130,14 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *observabili
 	return req
 }
 
+var credentialsFields = []tables.Field[observability.ServiceKeysList]{
+	{Header: "USERNAME", Value: func(c *observability.ServiceKeysList) any { return utils.PtrString(c.Name) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, credentials []observability.ServiceKeysList) error {
 	return p.OutputResult(outputFormat, credentials, func() error {
-		table := tables.NewTable()
-		table.SetHeader("USERNAME")
-		for i := range credentials {
-			c := credentials[i]
-			table.AddRow(utils.PtrString(c.Name))
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(credentialsFields, credentials)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit observability plans --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -76,6 +77,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			plans, err = filter.Items(model.Filter, plans)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(plans) > int(*model.Limit) {
 				plans = plans[:*model.Limit]
@@ -91,6 +96,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -121,21 +128,18 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *observabili
 	return req
 }
 
+var planFields = []tables.Field[observability.Plan]{
+	{Header: "ID", Value: func(plan *observability.Plan) any { return utils.PtrString(plan.Id) }},
+	{Header: "PLAN NAME", Value: func(plan *observability.Plan) any { return utils.PtrString(plan.Name) }},
+	{Header: "DESCRIPTION", Value: func(plan *observability.Plan) any { return utils.PtrString(plan.Description) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, plans []observability.Plan) error {
 	return p.OutputResult(outputFormat, plans, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "PLAN NAME", "DESCRIPTION")
-		for i := range plans {
-			o := plans[i]
-			table.AddRow(
-				utils.PtrString(o.Id),
-				utils.PtrString(o.Name),
-				utils.PtrString(o.Description),
-			)
-			table.AddSeparator()
-		}
+		// Each plan is separated from the next one
+		table := tables.NewGroupedTableFromFields(planFields, plans, func(plan *observability.Plan) *observability.Plan { return plan })
 		table.EnableAutoMergeOnColumns(1)
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
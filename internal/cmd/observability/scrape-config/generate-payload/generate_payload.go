@@ -61,7 +61,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit observability scrape-config generate-payload --job-name my-config --instance-id xxx`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit observability scrape-config list --instance-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -79,6 +80,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			configs, err = filter.Items(model.Filter, configs)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(configs) > int(*model.Limit) {
 				configs = configs[:*model.Limit]
@@ -98,6 +103,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -126,30 +133,27 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *observabili
 	return req
 }
 
-func outputResult(p *print.Printer, outputFormat string, configs []observability.Job) error {
-	return p.OutputResult(outputFormat, configs, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NAME", "TARGETS", "SCRAPE INTERVAL")
-		for i := range configs {
-			c := configs[i]
-
-			targets := 0
-			if c.StaticConfigs != nil {
-				for _, sc := range *c.StaticConfigs {
-					if sc.Targets == nil {
-						continue
-					}
-					targets += len(*sc.Targets)
+var scrapeConfigFields = []tables.Field[observability.Job]{
+	{Header: "NAME", Value: func(c *observability.Job) any { return utils.PtrString(c.JobName) }},
+	{Header: "TARGETS", Value: func(c *observability.Job) any {
+		targets := 0
+		if c.StaticConfigs != nil {
+			for _, sc := range *c.StaticConfigs {
+				if sc.Targets == nil {
+					continue
 				}
+				targets += len(*sc.Targets)
 			}
-
-			table.AddRow(
-				utils.PtrString(c.JobName),
-				targets,
-				utils.PtrString(c.ScrapeInterval),
-			)
 		}
-		err := table.Display(p)
+		return targets
+	}},
+	{Header: "SCRAPE INTERVAL", Value: func(c *observability.Job) any { return utils.PtrString(c.ScrapeInterval) }},
+}
+
+func outputResult(p *print.Printer, outputFormat string, configs []observability.Job) error {
+	return p.OutputResult(outputFormat, configs, func() error {
+		table := tables.NewTableFromFields(scrapeConfigFields, configs)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
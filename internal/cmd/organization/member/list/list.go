@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -55,7 +56,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit organization member list --organization-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -79,6 +80,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			members, err = filter.Items(model.Filter, members)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(members) > int(*model.Limit) {
 				members = members[:*model.Limit]
@@ -101,6 +106,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, organizationIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -134,6 +141,11 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *authorizati
 	return req
 }
 
+var memberFields = []tables.Field[authorization.Member]{
+	{Header: "SUBJECT", Value: func(m *authorization.Member) any { return utils.PtrString(m.Subject) }},
+	{Header: "ROLE", Value: func(m *authorization.Member) any { return utils.PtrString(m.Role) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, sortBy string, members []authorization.Member) error {
 	sortFn := func(i, j int) bool {
 		switch sortBy {
@@ -148,16 +160,17 @@ func outputResult(p *print.Printer, outputFormat, sortBy string, members []autho
 	sort.SliceStable(members, sortFn)
 
 	return p.OutputResult(outputFormat, members, func() error {
-		table := tables.NewTable()
-		table.SetHeader("SUBJECT", "ROLE")
-		for i := range members {
-			m := members[i]
-			// If the previous item differs from the current item on the element to sort by, add a separator between the rows to help readability
-			if i > 0 && sortFn(i-1, i) {
-				table.AddSeparator()
+		// If the previous item differs from the current item on the element to sort by, a separator is added between the rows to help readability
+		table := tables.NewGroupedTableFromFields(memberFields, members, func(m *authorization.Member) string {
+			switch sortBy {
+			case "subject":
+				return utils.PtrString(m.Subject)
+			case "role":
+				return utils.PtrString(m.Role)
+			default:
+				return ""
 			}
-			table.AddRow(utils.PtrString(m.Subject), utils.PtrString(m.Role))
-		}
+		})
 
 		if sortBy == "subject" {
 			table.EnableAutoMergeOnColumns(1)
@@ -165,7 +178,7 @@ func outputResult(p *print.Printer, outputFormat, sortBy string, members []autho
 			table.EnableAutoMergeOnColumns(2)
 		}
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
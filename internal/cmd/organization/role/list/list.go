@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit organization role list --organization-id xxx --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -74,6 +75,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			roles, err = filter.Items(model.Filter, roles)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(roles) > int(*model.Limit) {
 				roles = roles[:*model.Limit]
@@ -92,6 +97,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, organizationIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -119,27 +126,34 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *authorizati
 	return apiClient.ListRoles(ctx, organizationResourceType, *model.OrganizationId)
 }
 
+// A row of the pretty output, the permissions are listed with their role
+type permissionRow struct {
+	role       *authorization.Role
+	permission *authorization.Permission
+}
+
+var permissionFields = []tables.Field[permissionRow]{
+	{Header: "ROLE NAME", Value: func(r *permissionRow) any { return utils.PtrString(r.role.Name) }},
+	{Header: "ROLE DESCRIPTION", Value: func(r *permissionRow) any { return utils.PtrString(r.role.Description) }},
+	{Header: "PERMISSION NAME", Value: func(r *permissionRow) any { return utils.PtrString(r.permission.Name) }},
+	{Header: "PERMISSION DESCRIPTION", Value: func(r *permissionRow) any { return utils.PtrString(r.permission.Description) }},
+}
+
 func outputRolesResult(p *print.Printer, outputFormat string, roles []authorization.Role) error {
 	return p.OutputResult(outputFormat, roles, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ROLE NAME", "ROLE DESCRIPTION", "PERMISSION NAME", "PERMISSION DESCRIPTION")
+		rows := []permissionRow{}
 		for i := range roles {
-			r := roles[i]
+			r := &roles[i]
 			if r.Permissions != nil {
 				for j := range *r.Permissions {
-					p := (*r.Permissions)[j]
-					table.AddRow(
-						utils.PtrString(r.Name),
-						utils.PtrString(r.Description),
-						utils.PtrString(p.Name),
-						utils.PtrString(p.Description),
-					)
+					rows = append(rows, permissionRow{role: r, permission: &(*r.Permissions)[j]})
 				}
-				table.AddSeparator()
 			}
 		}
+		// The permissions of a role are separated from the permissions of the next role
+		table := tables.NewGroupedTableFromFields(permissionFields, rows, func(r *permissionRow) *authorization.Role { return r.role })
 		table.EnableAutoMergeOnColumns(1, 2)
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -0,0 +1,102 @@
+package plan
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileFlag = "file"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	File string
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Shows the changes needed to make a project match a manifest",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Shows the changes needed to make a project match a manifest, without applying them.",
+			"The manifest is a YAML file declaring networks, security groups, servers, DNS zones and record sets and PostgreSQL Flex instances.",
+			`Run "stackit apply" with the same manifest to apply the changes.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Show the changes needed to make the project match the manifest in "env.yaml"`,
+				"$ stackit plan -f env.yaml"),
+			examples.NewExample(
+				`Show the changes in JSON format`,
+				"$ stackit plan -f env.yaml --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := apply.Load(model.File)
+			if err != nil {
+				return err
+			}
+			clients, err := apply.ConfigureClients(params.Printer, params.CliVersion, manifest.Services())
+			if err != nil {
+				return err
+			}
+			plan, err := apply.NewPlan(ctx, clients, model.ProjectId, model.Region, manifest)
+			if err != nil {
+				return fmt.Errorf("plan changes: %w", err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, plan)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(fileFlag, "f", "", "Path to the manifest file, or to a directory whose YAML files are merged into one manifest")
+
+	err := flags.MarkFlagsRequired(cmd, fileFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		File:            flags.FlagToStringValue(p, cmd, fileFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, plan *apply.Plan) error {
+	if plan == nil {
+		return fmt.Errorf("plan is empty")
+	}
+	return p.OutputResult(outputFormat, plan, func() error {
+		p.Outputf("%s", plan.Diff())
+		return nil
+	})
+}
@@ -0,0 +1,127 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+var testProjectId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		projectIdFlag: testProjectId,
+		fileFlag:      "env.yaml",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		File: "env.yaml",
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "file missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, fileFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "arguments not allowed",
+			argValues:   []string{"env.yaml"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	tests := []struct {
+		description  string
+		outputFormat string
+		plan         *apply.Plan
+		wantErr      bool
+	}{
+		{
+			description: "empty plan",
+			plan:        &apply.Plan{},
+			wantErr:     false,
+		},
+		{
+			description:  "plan as JSON",
+			outputFormat: print.JSONOutputFormat,
+			plan: &apply.Plan{Actions: []*apply.Action{
+				{Operation: apply.OperationCreate, Kind: "network", Name: "net"},
+			}},
+			wantErr: false,
+		},
+		{
+			description: "nil plan",
+			plan:        nil,
+			wantErr:     true,
+		},
+	}
+	p := print.NewPrinter()
+	p.Cmd = &cobra.Command{}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if err := outputResult(p, tt.outputFormat, tt.plan); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
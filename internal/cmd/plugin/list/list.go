@@ -0,0 +1,96 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugin"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all CLI plugins",
+		Long: fmt.Sprintf("%s\n%s",
+			fmt.Sprintf(`Lists all CLI plugins, i.e. the executables named "%s<name>" found on the PATH.`, plugin.ExecutablePrefix),
+			"Plugins named like a built-in command, or like a plugin earlier on the PATH, are shadowed and can't be run.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List the plugins`,
+				"$ stackit plugin list"),
+			examples.NewExample(
+				`List the plugins in a json format`,
+				"$ stackit plugin list --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			plugins := plugin.List(func(name string) bool {
+				return utils.IsBuiltinCommand(cmd.Root(), name)
+			})
+			plugins, err := filter.Items(model.Filter, plugins)
+			if err != nil {
+				return err
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, plugins)
+		},
+	}
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+
+	p.DebugInputModel(model)
+	return &model
+}
+
+var pluginFields = []tables.Field[plugin.Plugin]{
+	{Header: "NAME", Value: func(pl *plugin.Plugin) any { return pl.Name }},
+	{Header: "PATH", Value: func(pl *plugin.Plugin) any { return pl.Path }},
+	{Header: "SHADOWED", Value: func(pl *plugin.Plugin) any {
+		if pl.Shadowed {
+			return "*"
+		}
+		return ""
+	}},
+}
+
+func outputResult(p *print.Printer, outputFormat string, plugins []plugin.Plugin) error {
+	return p.OutputResult(outputFormat, plugins, func() error {
+		if len(plugins) == 0 {
+			p.Info("No plugins found on the PATH\n")
+			return nil
+		}
+
+		table := tables.NewTableFromFields(pluginFields, plugins)
+		table.EnableRowSeparators()
+		err := table.Display(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
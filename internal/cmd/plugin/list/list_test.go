@@ -0,0 +1,56 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugin"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		plugins      []plugin.Plugin
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "plugins",
+			args: args{
+				plugins: []plugin.Plugin{
+					{Name: "cost", Path: "/usr/local/bin/stackit-cost"},
+					{Name: "dns", Path: "/usr/local/bin/stackit-dns", Shadowed: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "json",
+			args: args{
+				outputFormat: print.JSONOutputFormat,
+				plugins: []plugin.Plugin{
+					{Name: "cost", Path: "/usr/local/bin/stackit-cost"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	p := print.NewPrinter()
+	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(p, tt.args.outputFormat, tt.args.plugins); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/plugin/list"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugin"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Provides functionality for CLI plugins",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Provides functionality for CLI plugins.",
+			fmt.Sprintf(`Plugins are executables named "%s<name>" on the PATH, which are run as "stackit <name>" with all further arguments.`, plugin.ExecutablePrefix),
+			fmt.Sprintf("The active profile, project ID, region and an access token are passed to them in the %s, %s, %s and %s environment variables.",
+				plugin.ProfileEnvVar, plugin.ProjectIdEnvVar, plugin.RegionEnvVar, plugin.AccessTokenEnvVar),
+		),
+		Args: args.NoArgs,
+		Run:  utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *params.CmdParams) {
+	cmd.AddCommand(list.NewCmd(params))
+}
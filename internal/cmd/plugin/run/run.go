@@ -0,0 +1,24 @@
+package run
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugin"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCmd returns the command running the plugin. Flags are not parsed, all arguments are passed on to the plugin.
+func NewCmd(params *params.CmdParams, p *plugin.Plugin) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                fmt.Sprintf("%s [args]", p.Name),
+		Short:              fmt.Sprintf("Runs the %q plugin", p.Name),
+		Long:               fmt.Sprintf("Runs the %q plugin, located at %q.", p.Name, p.Path),
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return plugin.Run(cmd.Context(), params.Printer, p, args)
+		},
+	}
+	return cmd
+}
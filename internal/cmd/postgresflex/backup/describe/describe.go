@@ -51,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.SingleArg(backupIdArg, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -127,7 +127,7 @@ func outputResult(p *print.Printer, outputFormat string, backup postgresflex.Bac
 		backupSize := utils.PtrByteSizeDefault(backup.Size, "n/a")
 		table.AddRow("BACKUP SIZE", backupSize)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -55,7 +56,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -85,6 +86,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			backups := *resp.Items
 
+			backups, err = filter.Items(model.Filter, backups)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(backups) > int(*model.Limit) {
 				backups = backups[:*model.Limit]
@@ -104,6 +109,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -132,28 +139,36 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *postgresfle
 	return req
 }
 
+var backupFields = []tables.Field[postgresflex.Backup]{
+	{Header: "ID", Value: func(b *postgresflex.Backup) any { return utils.PtrString(b.Id) }},
+	{Header: "CREATED AT", Value: func(b *postgresflex.Backup) any { return utils.PtrString(b.StartTime) }},
+	{Header: "EXPIRES AT", Value: func(b *postgresflex.Backup) any {
+		// The start times are checked before the table is built
+		expireDate, _ := backupExpireDate(b)
+		return expireDate
+	}},
+	{Header: "BACKUP SIZE", Value: func(b *postgresflex.Backup) any { return utils.PtrByteSizeDefault(b.Size, "n/a") }},
+}
+
+// Returns the date the backup expires at, after the retention period from its start time
+func backupExpireDate(backup *postgresflex.Backup) (string, error) {
+	backupStartTime, err := time.Parse(time.RFC3339, utils.PtrString(backup.StartTime))
+	if err != nil {
+		return "", fmt.Errorf("parse backup start time: %w", err)
+	}
+	return backupStartTime.AddDate(backupExpireYearOffset, backupExpireMonthOffset, backupExpireDayOffset).Format(time.DateOnly), nil
+}
+
 func outputResult(p *print.Printer, outputFormat string, backups []postgresflex.Backup) error {
 	return p.OutputResult(outputFormat, backups, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "CREATED AT", "EXPIRES AT", "BACKUP SIZE")
 		for i := range backups {
-			backup := backups[i]
-
-			backupStartTime, err := time.Parse(time.RFC3339, utils.PtrString(backup.StartTime))
+			_, err := backupExpireDate(&backups[i])
 			if err != nil {
-				return fmt.Errorf("parse backup start time: %w", err)
+				return err
 			}
-			backupExpireDate := backupStartTime.AddDate(backupExpireYearOffset, backupExpireMonthOffset, backupExpireDayOffset).Format(time.DateOnly)
-
-			backupSize := utils.PtrByteSizeDefault(backup.Size, "n/a")
-			table.AddRow(
-				utils.PtrString(backup.Id),
-				utils.PtrString(backup.StartTime),
-				backupExpireDate,
-				backupSize,
-			)
 		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(backupFields, backups)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
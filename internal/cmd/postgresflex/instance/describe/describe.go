@@ -45,7 +45,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit postgresflex instance describe xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -138,7 +138,7 @@ func outputResult(p *print.Printer, outputFormat string, instance *postgresflex.
 		table.AddSeparator()
 		table.AddRow("BACKUP SCHEDULE (UTC)", utils.PtrString(instance.BackupSchedule))
 		table.AddSeparator()
-		err = table.Display(p)
+		err = table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
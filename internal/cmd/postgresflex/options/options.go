@@ -64,7 +64,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit postgresflex options --storages --flavor-id <FLAVOR_ID>"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -195,7 +195,7 @@ func outputResult(p *print.Printer, model inputModel, flavors *postgresflex.List
 			content = append(content, buildStoragesTable(*options.Storages.Storages))
 		}
 
-		err := tables.DisplayTables(p, content)
+		err := tables.DisplayTables(p, content, model.OutputFormat)
 		if err != nil {
 			return fmt.Errorf("display output: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -49,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -78,6 +79,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			users := *resp.Items
 
+			users, err = filter.Items(model.Filter, users)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(users) > int(*model.Limit) {
 				users = users[:*model.Limit]
@@ -97,6 +102,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -128,18 +135,15 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *postgresfle
 	return req
 }
 
+var userFields = []tables.Field[postgresflex.ListUsersResponseItem]{
+	{Header: "ID", Value: func(u *postgresflex.ListUsersResponseItem) any { return utils.PtrString(u.Id) }},
+	{Header: "USERNAME", Value: func(u *postgresflex.ListUsersResponseItem) any { return utils.PtrString(u.Username) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, users []postgresflex.ListUsersResponseItem) error {
 	return p.OutputResult(outputFormat, users, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "USERNAME")
-		for i := range users {
-			user := users[i]
-			table.AddRow(
-				utils.PtrString(user.Id),
-				utils.PtrString(user.Username),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(userFields, users)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
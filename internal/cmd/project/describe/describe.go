@@ -48,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit project describe --include-parents"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -127,7 +127,7 @@ func outputResult(p *print.Printer, outputFormat string, project *resourcemanage
 		if project.Parent != nil {
 			table.AddRow("PARENT ID", utils.PtrString(project.Parent.Id))
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
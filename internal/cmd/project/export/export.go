@@ -0,0 +1,146 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/export"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	servicesFlag = "services"
+	formatFlag   = "format"
+	dirFlag      = "dir"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Services []string
+	Format   string
+	Dir      string
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the resources of a project",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Exports the resources of a project into a directory, one file per resource, without their read-only fields.",
+			`With the "yaml" format, the files are manifests which can be applied with "stackit apply -f <dir>", e.g. to recreate the resources in another project.`,
+			`SKE clusters can't be declared in manifests, their files are payloads for "stackit ske cluster create --payload @<file>" instead. Volumes and DSA instances are only exported with the "terraform" format.`,
+			`With the "terraform" format, the files are Terraform configurations for the STACKIT provider.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Export the resources of the project into the directory named after the project ID`,
+				"$ stackit project export"),
+			examples.NewExample(
+				`Export the DNS zones and record sets of the project into the directory "dns"`,
+				"$ stackit project export --services dns --dir dns"),
+			examples.NewExample(
+				`Export the resources of the project as Terraform configuration`,
+				"$ stackit project export --format terraform --dir infrastructure"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			clients, err := export.ConfigureClients(params.Printer, params.CliVersion, model.Services)
+			if err != nil {
+				return err
+			}
+
+			projectLabel, err := projectname.GetProjectName(ctx, params.Printer, params.CliVersion, cmd)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get project name: %v", err)
+				projectLabel = model.ProjectId
+			}
+
+			s := spinner.New(params.Printer)
+			s.Start("Reading resources")
+			resources, warnings, err := export.Collect(ctx, clients, model.ProjectId, model.Region, model.Services)
+			if err != nil {
+				s.StopWithError()
+				return fmt.Errorf("read resources: %w", err)
+			}
+			s.Stop()
+			for _, warning := range warnings {
+				params.Printer.Warn("%s\n", warning)
+			}
+			if model.Format == export.FormatYAML {
+				skipped := []string{}
+				for i := range resources {
+					if !resources[i].Declarable() {
+						skipped = append(skipped, fmt.Sprintf("%s %q", resources[i].Kind, resources[i].Name))
+					}
+				}
+				if len(skipped) > 0 {
+					params.Printer.Warn("these resources can't be declared in manifests and are only exported with \"--format %s\": %s\n", export.FormatTerraform, strings.Join(skipped, ", "))
+				}
+			}
+
+			files, err := export.Write(model.Dir, model.Format, model.ProjectId, resources)
+			if err != nil {
+				return fmt.Errorf("export resources: %w", err)
+			}
+			return outputResult(params.Printer, model.OutputFormat, projectLabel, model.Dir, files)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	services := export.Services()
+	formats := export.Formats()
+	cmd.Flags().Var(flags.EnumSliceFlag(false, services, services...), servicesFlag, fmt.Sprintf("Services whose resources are exported, possible values are %q", services))
+	cmd.Flags().Var(flags.EnumFlag(false, export.FormatYAML, formats...), formatFlag, fmt.Sprintf("Format of the exported files, one of %q", formats))
+	cmd.Flags().String(dirFlag, "", "Directory the files are written to, which must be empty or not exist, defaults to a directory named after the project ID")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	dir := flags.FlagToStringValue(p, cmd, dirFlag)
+	if dir == "" {
+		dir = globalFlags.ProjectId
+	}
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Services:        utils.PtrValue(flags.FlagWithDefaultToStringSlicePointer(p, cmd, servicesFlag)),
+		Format:          flags.FlagWithDefaultToStringValue(p, cmd, formatFlag),
+		Dir:             dir,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat, projectLabel, dir string, files []string) error {
+	return p.OutputResult(outputFormat, files, func() error {
+		for _, file := range files {
+			p.Outputln(file)
+		}
+		p.Outputf("Exported the resources of project %q into %d files in %q\n", projectLabel, len(files), dir)
+		return nil
+	})
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/export"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+var testProjectId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		projectIdFlag: testProjectId,
+		servicesFlag:  "iaas,dns",
+		formatFlag:    "terraform",
+		dirFlag:       "infrastructure",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Services: []string{export.ServiceIaaS, export.ServiceDNS},
+		Format:   export.FormatTerraform,
+		Dir:      "infrastructure",
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "defaults",
+			flagValues: map[string]string{
+				projectIdFlag: testProjectId,
+			},
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Services = export.Services()
+				model.Format = export.FormatYAML
+				model.Dir = testProjectId
+			}),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "invalid service",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[servicesFlag] = "iaas,unknown"
+			}),
+			isValid: false,
+		},
+		{
+			description: "invalid format",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[formatFlag] = "json"
+			}),
+			isValid: false,
+		},
+		{
+			description: "arguments not allowed",
+			argValues:   []string{"dir"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	tests := []struct {
+		description  string
+		outputFormat string
+		files        []string
+	}{
+		{
+			description: "no files",
+			files:       []string{},
+		},
+		{
+			description: "files",
+			files:       []string{"dir/network-net.yaml"},
+		},
+		{
+			description:  "files as JSON",
+			outputFormat: print.JSONOutputFormat,
+			files:        []string{"dir/network-net.yaml"},
+		},
+	}
+	p := print.NewPrinter()
+	p.Cmd = &cobra.Command{}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if err := outputResult(p, tt.outputFormat, "project", "dir", tt.files); err != nil {
+				t.Errorf("outputResult() error = %v", err)
+			}
+		})
+	}
+}
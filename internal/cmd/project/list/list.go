@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -63,7 +64,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit project list --member example@email.com"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -99,6 +100,8 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().String(creationTimeAfterFlag, "", "Filter by creation timestamp, in a date-time with the RFC3339 layout format, e.g. 2023-01-01T00:00:00Z. The list of projects that were created after the given timestamp will be shown")
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().Int64(pageSizeFlag, pageSizeDefault, "Number of items fetched in each API call. Does not affect the number of items in the command output")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -194,42 +197,42 @@ func fetchProjects(ctx context.Context, model *inputModel, apiClient resourceMan
 		if len(respProjects) == 0 {
 			break
 		}
-		projects = append(projects, respProjects...)
-		// Stop if no more pages
-		if len(respProjects) < int(model.PageSize) {
-			break
+		matchingProjects, err := filter.Items(model.Filter, respProjects)
+		if err != nil {
+			return nil, err
 		}
-
+		projects = append(projects, matchingProjects...)
 		// Stop and truncate if limit is reached
 		if model.Limit != nil && len(projects) >= int(*model.Limit) {
 			projects = projects[:*model.Limit]
 			break
 		}
+		// Stop if no more pages
+		if len(respProjects) < int(model.PageSize) {
+			break
+		}
 		offset += int(model.PageSize)
 	}
 	return projects, nil
 }
 
+var projectFields = []tables.Field[resourcemanager.Project]{
+	{Header: "ID", Value: func(pr *resourcemanager.Project) any { return utils.PtrString(pr.ProjectId) }},
+	{Header: "NAME", Value: func(pr *resourcemanager.Project) any { return utils.PtrString(pr.Name) }},
+	{Header: "STATE", Value: func(pr *resourcemanager.Project) any { return utils.PtrString(pr.LifecycleState) }},
+	{Header: "PARENT ID", Value: func(pr *resourcemanager.Project) any {
+		if pr.Parent == nil {
+			return ""
+		}
+		return utils.PtrString(pr.Parent.Id)
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, projects []resourcemanager.Project) error {
 	return p.OutputResult(outputFormat, projects, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATE", "PARENT ID")
-		for i := range projects {
-			p := projects[i]
-
-			var parentId *string
-			if p.Parent != nil {
-				parentId = p.Parent.Id
-			}
-			table.AddRow(
-				utils.PtrString(p.ProjectId),
-				utils.PtrString(p.Name),
-				utils.PtrString(p.LifecycleState),
-				utils.PtrString(parentId),
-			)
-		}
+		table := tables.NewTableFromFields(projectFields, projects)
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -283,6 +284,7 @@ func TestFetchProjects(t *testing.T) {
 		description         string
 		model               *inputModel
 		totalItems          int
+		nonMatchingItems    []int
 		apiCallFails        bool
 		expectedNumAPICalls int
 		expectedNumItems    int
@@ -351,6 +353,18 @@ func TestFetchProjects(t *testing.T) {
 			apiCallFails:        false,
 			expectedNumItems:    50,
 		},
+		{
+			description: "filter and limit across pages",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.Filter = `name=="match"`
+				model.Limit = utils.Ptr(int64(5))
+			}),
+			totalItems:          9,
+			nonMatchingItems:    []int{3, 4},
+			expectedNumAPICalls: 2,
+			apiCallFails:        false,
+			expectedNumItems:    5,
+		},
 		{
 			description:  "request fails",
 			model:        fixtureInputModel(),
@@ -403,6 +417,13 @@ func TestFetchProjects(t *testing.T) {
 				}
 
 				projects := make([]resourcemanager.Project, numItemsToReturn)
+				for i := range projects {
+					name := "match"
+					if slices.Contains(tt.nonMatchingItems, offset+i) {
+						name = "other"
+					}
+					projects[i].Name = utils.Ptr(name)
+				}
 				mockedResp := resourcemanager.ListProjectsResponse{
 					Items: &projects,
 				}
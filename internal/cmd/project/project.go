@@ -7,6 +7,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/create"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/describe"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/project/export"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/list"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/member"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project/role"
@@ -40,4 +41,5 @@ func addSubcommands(cmd *cobra.Command, params *params.CmdParams) {
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(member.NewCmd(params))
 	cmd.AddCommand(role.NewCmd(params))
+	cmd.AddCommand(export.NewCmd(params))
 }
@@ -1,7 +1,9 @@
 package list
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -166,3 +168,47 @@ func Test_outputRolesResult(t *testing.T) {
 		})
 	}
 }
+
+func Test_outputRolesResultSeparators(t *testing.T) {
+	t.Setenv("PAGER", "cat")
+	roles := []authorization.Role{
+		{
+			Name: utils.Ptr("reader"),
+			Permissions: &[]authorization.Permission{
+				{Name: utils.Ptr("read-a")},
+				{Name: utils.Ptr("read-b")},
+			},
+		},
+		{
+			Name: utils.Ptr("writer"),
+			Permissions: &[]authorization.Permission{
+				{Name: utils.Ptr("write-a")},
+				{Name: utils.Ptr("write-b")},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	p := print.NewPrinter()
+	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
+	p.Cmd.SetOut(&buf)
+
+	err := outputRolesResult(p, print.PrettyOutputFormat, roles)
+	if err != nil {
+		t.Fatalf("outputRolesResult() failed: %v", err)
+	}
+
+	// The permissions are identified by their name, the separators by "---"
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	rows := []string{}
+	for _, line := range lines[2:] {
+		if strings.Contains(line, "┼") {
+			rows = append(rows, "---")
+			continue
+		}
+		rows = append(rows, strings.TrimSpace(strings.Split(line, "│")[2]))
+	}
+	expected := []string{"read-a", "read-b", "---", "write-a", "write-b"}
+	if diff := cmp.Diff(expected, rows); diff != "" {
+		t.Fatalf("separators do not match: %s\n%s", diff, buf.String())
+	}
+}
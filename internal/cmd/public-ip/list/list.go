@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -56,7 +57,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -87,8 +88,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -103,6 +108,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -138,22 +145,21 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var publicIpFields = []tables.Field[iaas.PublicIp]{
+	{Header: "ID", Value: func(ip *iaas.PublicIp) any { return utils.PtrString(ip.Id) }},
+	{Header: "IP ADDRESS", Value: func(ip *iaas.PublicIp) any { return utils.PtrString(ip.Ip) }},
+	{Header: "USED BY", Value: func(ip *iaas.PublicIp) any { return utils.PtrStringDefault(ip.GetNetworkInterface(), "") }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, publicIps []iaas.PublicIp) error {
 	return p.OutputResult(outputFormat, publicIps, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "IP ADDRESS", "USED BY")
-
-		for _, publicIp := range publicIps {
-			networkInterfaceId := utils.PtrStringDefault(publicIp.GetNetworkInterface(), "")
-			table.AddRow(
-				utils.PtrString(publicIp.Id),
-				utils.PtrString(publicIp.Ip),
-				networkInterfaceId,
-			)
-			table.AddSeparator()
-		}
+		table := tables.NewTableFromFields(publicIpFields, publicIps)
+		table.EnableRowSeparators()
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
@@ -35,7 +35,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -141,7 +141,7 @@ func outputResult(p *print.Printer, outputFormat string, quotas *iaas.QuotaList)
 		if val := quotas.Volumes; val != nil {
 			table.AddRow("Number of volumes [Count]", conv(val.Limit), conv(val.Usage), percentage(val))
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit rabbitmq plans --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -73,6 +74,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			plans, err = filter.Items(model.Filter, plans)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(plans) > int(*model.Limit) {
 				plans = plans[:*model.Limit]
@@ -88,6 +93,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -118,6 +125,20 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *rabbitmq.AP
 	return req
 }
 
+// A row of the pretty output, the plans are listed with their offering
+type planRow struct {
+	offering *rabbitmq.Offering
+	plan     *rabbitmq.Plan
+}
+
+var planFields = []tables.Field[planRow]{
+	{Header: "OFFERING NAME", Value: func(r *planRow) any { return utils.PtrString(r.offering.Name) }},
+	{Header: "VERSION", Value: func(r *planRow) any { return utils.PtrString(r.offering.Version) }},
+	{Header: "ID", Value: func(r *planRow) any { return utils.PtrString(r.plan.Id) }},
+	{Header: "NAME", Value: func(r *planRow) any { return utils.PtrString(r.plan.Name) }},
+	{Header: "DESCRIPTION", Value: func(r *planRow) any { return utils.PtrString(r.plan.Description) }},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, plans []rabbitmq.Offering) error {
 	return p.OutputResult(outputFormat, plans, func() error {
 		if len(plans) == 0 {
@@ -125,26 +146,19 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, plans []r
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("OFFERING NAME", "VERSION", "ID", "NAME", "DESCRIPTION")
+		rows := []planRow{}
 		for i := range plans {
-			o := plans[i]
+			o := &plans[i]
 			if o.Plans != nil {
 				for j := range *o.Plans {
-					plan := (*o.Plans)[j]
-					table.AddRow(
-						utils.PtrString(o.Name),
-						utils.PtrString(o.Version),
-						utils.PtrString(plan.Id),
-						utils.PtrString(plan.Name),
-						utils.PtrString(plan.Description),
-					)
+					rows = append(rows, planRow{offering: o, plan: &(*o.Plans)[j]})
 				}
-				table.AddSeparator()
 			}
 		}
+		// The plans of an offering are separated from the plans of the next offering
+		table := tables.NewGroupedTableFromFields(planFields, rows, func(r *planRow) *rabbitmq.Offering { return r.offering })
 		table.EnableAutoMergeOnColumns(1, 2)
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -1,7 +1,9 @@
 package plans
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -182,3 +184,43 @@ func Test_outputResult(t *testing.T) {
 		})
 	}
 }
+
+func Test_outputResultSeparators(t *testing.T) {
+	t.Setenv("PAGER", "cat")
+	plans := []redis.Offering{
+		{
+			Name:    utils.Ptr("redis"),
+			Version: utils.Ptr("6"),
+			Plans:   &[]redis.Plan{{Id: utils.Ptr("plan-6-a")}, {Id: utils.Ptr("plan-6-b")}},
+		},
+		{
+			Name:    utils.Ptr("redis"),
+			Version: utils.Ptr("7"),
+			Plans:   &[]redis.Plan{{Id: utils.Ptr("plan-7-a")}, {Id: utils.Ptr("plan-7-b")}},
+		},
+	}
+	var buf bytes.Buffer
+	p := print.NewPrinter()
+	p.Cmd = NewCmd(&params.CmdParams{Printer: p})
+	p.Cmd.SetOut(&buf)
+
+	err := outputResult(p, print.PrettyOutputFormat, plans)
+	if err != nil {
+		t.Fatalf("outputResult() failed: %v", err)
+	}
+
+	// The plans are identified by their ID, the separators by "---"
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	rows := []string{}
+	for _, line := range lines[2:] {
+		if strings.Contains(line, "┼") {
+			rows = append(rows, "---")
+			continue
+		}
+		rows = append(rows, strings.TrimSpace(strings.Split(line, "│")[2]))
+	}
+	expected := []string{"plan-6-a", "plan-6-b", "---", "plan-7-a", "plan-7-b"}
+	if diff := cmp.Diff(expected, rows); diff != "" {
+		t.Fatalf("separators do not match: %s\n%s", diff, buf.String())
+	}
+}
@@ -1,18 +1,26 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	affinityGroups "github.com/stackitcloud/stackit-cli/internal/cmd/affinity-groups"
+	aliasCmd "github.com/stackitcloud/stackit-cli/internal/cmd/alias"
+	applyCmd "github.com/stackitcloud/stackit-cli/internal/cmd/apply"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/auth"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/beta"
 	configCmd "github.com/stackitcloud/stackit-cli/internal/cmd/config"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/curl"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/dns"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/doctor"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/git"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/history"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/image"
 	keypair "github.com/stackitcloud/stackit-cli/internal/cmd/key-pair"
 	loadbalancer "github.com/stackitcloud/stackit-cli/internal/cmd/load-balancer"
@@ -27,6 +35,9 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/opensearch"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/organization"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	planCmd "github.com/stackitcloud/stackit-cli/internal/cmd/plan"
+	pluginCmd "github.com/stackitcloud/stackit-cli/internal/cmd/plugin"
+	pluginRun "github.com/stackitcloud/stackit-cli/internal/cmd/plugin/run"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project"
 	publicip "github.com/stackitcloud/stackit-cli/internal/cmd/public-ip"
@@ -37,15 +48,30 @@ import (
 	securitygroup "github.com/stackitcloud/stackit-cli/internal/cmd/security-group"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/server"
 	serviceaccount "github.com/stackitcloud/stackit-cli/internal/cmd/service-account"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/shell"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske"
+	terraformCmd "github.com/stackitcloud/stackit-cli/internal/cmd/terraform"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/volume"
+	waitCmd "github.com/stackitcloud/stackit-cli/internal/cmd/wait"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/alias"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugin"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/requestid"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/resolve"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/watch"
 
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -61,16 +87,72 @@ func NewRootCmd(version, date string, p *print.Printer) *cobra.Command {
 		DisableAutoGenTag: true,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			p.Cmd = cmd
-			p.Verbosity = print.Level(globalflags.Parse(p, cmd).Verbosity)
+			// The doctor command reports an invalid configuration instead of failing on it
+			if err := config.GetInitError(); err != nil && !isDoctorCmd(cmd) {
+				return fmt.Errorf("read configuration: %w", err)
+			}
+			globalFlags := globalflags.Parse(p, cmd)
+			p.Verbosity = print.Level(globalFlags.Verbosity)
+
+			// Each command run gets its own invocation ID, including the commands run in the shell
+			closeLogger, err := print.ConfigureLogger(viper.GetString(config.LogFormatKey), viper.GetString(config.LogFileKey), uuid.NewString())
+			if err != nil {
+				return fmt.Errorf("configure logging: %w", err)
+			}
+			addCleanup(cmd.Context(), func() { _ = closeLogger.Close() })
+
+			err = filter.Validate(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			err = watch.Validate(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			err = validateRequestPolicy()
+			if err != nil {
+				return err
+			}
+
+			err = cassette.Start(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			err = dryrun.Start(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			journal.Start(p, cmd)
+
+			har.Start(p, cmd, version)
+
+			// The deadline applies to all API requests and waits of the command, which use the command's context
+			timeout := viper.GetDuration(config.TimeoutKey)
+			if timeout > 0 {
+				ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+				cmd.SetContext(ctx)
+				addCleanup(ctx, cancel)
+			}
 
 			argsString := print.BuildDebugStrFromSlice(os.Args)
 			p.Debug(print.DebugLevel, "arguments: %s", argsString)
 
 			configFilePath := viper.ConfigFileUsed()
 			p.Debug(print.DebugLevel, "configuration is persisted and read from: %s", configFilePath)
+			if localConfigFilePath := config.GetLocalConfigFilePath(); localConfigFilePath != "" {
+				p.Debug(print.DebugLevel, "local configuration is read from: %s", localConfigFilePath)
+			}
 
 			profileSet, activeProfile, configMethod, err := config.GetConfiguredProfile()
 			if err != nil {
+				// The doctor command reports the error
+				if isDoctorCmd(cmd) {
+					return nil
+				}
 				return fmt.Errorf("get configured profile: %w", err)
 			}
 
@@ -116,10 +198,15 @@ func NewRootCmd(version, date string, p *print.Printer) *cobra.Command {
 	err := configureFlags(cmd)
 	cobra.CheckErr(err)
 
-	addSubcommands(cmd, &params.CmdParams{
+	cmdParams := &params.CmdParams{
 		Printer:    p,
 		CliVersion: version,
-	})
+	}
+	addSubcommands(cmd, cmdParams)
+	// The shell runs each command on a new command tree, so that no flag values are carried over
+	cmd.AddCommand(shell.NewCmd(cmdParams, func(ctx context.Context, args []string, globalFlags map[string]string) int {
+		return run(ctx, version, date, p, args, globalFlags)
+	}))
 
 	// Cobra creates the help flag with "help for <command>" as the description
 	// We want to override that message by capitalizing the first letter to match the other flag descriptions
@@ -128,11 +215,59 @@ func NewRootCmd(version, date string, p *print.Printer) *cobra.Command {
 		c.Flags().BoolP("help", "h", false, fmt.Sprintf("Help for %q", c.CommandPath()))
 	})
 
+	// List and describe commands can be run repeatedly with the --watch flag
+	traverseCommands(cmd, func(c *cobra.Command) {
+		if c.Name() == "list" || c.Name() == "describe" {
+			watch.Enable(p, c)
+		}
+	})
+
+	// Commands that change resources, whose runs are recorded in the journal queried by "stackit history",
+	// can print their API request instead of sending it with the --dry-run flag
+	traverseCommands(cmd, func(c *cobra.Command) {
+		if journal.IsEnabled(c) {
+			dryrun.Enable(c)
+		}
+	})
+
+	// Missing required flags and arguments can be selected from the existing resources
+	traverseCommands(cmd, func(c *cobra.Command) {
+		resolve.Enable(p, version, c)
+	})
+
 	beautifyUsageTemplate(cmd)
 
 	return cmd
 }
 
+// isDoctorCmd returns whether the command is "stackit doctor", which diagnoses the configuration instead of requiring it to be valid
+func isDoctorCmd(c *cobra.Command) bool {
+	return c.Name() == "doctor" && c.Parent() == c.Root()
+}
+
+// validateRequestPolicy returns an error if the timeout or retry settings, set either as flags or in the configuration, are invalid
+func validateRequestPolicy() error {
+	if viper.GetDuration(config.TimeoutKey) < 0 {
+		return &errors.FlagValidationError{
+			Flag:    globalflags.TimeoutFlag,
+			Details: "must not be negative",
+		}
+	}
+	if viper.GetInt(config.RetriesKey) < 0 {
+		return &errors.FlagValidationError{
+			Flag:    globalflags.RetriesFlag,
+			Details: "must not be negative",
+		}
+	}
+	if viper.GetDuration(config.RetryBackoffKey) < 0 {
+		return &errors.FlagValidationError{
+			Flag:    globalflags.RetryBackoffFlag,
+			Details: "must not be negative",
+		}
+	}
+	return nil
+}
+
 func beautifyUsageTemplate(cmd *cobra.Command) {
 	cobra.AddTemplateFunc("WhiteBold", print.WhiteBold)
 	usageTemplate := cmd.UsageTemplate()
@@ -192,6 +327,59 @@ func addSubcommands(cmd *cobra.Command, params *params.CmdParams) {
 	cmd.AddCommand(quota.NewCmd(params))
 	cmd.AddCommand(affinityGroups.NewCmd(params))
 	cmd.AddCommand(git.NewCmd(params))
+	cmd.AddCommand(waitCmd.NewCmd(params))
+	cmd.AddCommand(pluginCmd.NewCmd(params))
+	cmd.AddCommand(aliasCmd.NewCmd(params))
+	cmd.AddCommand(applyCmd.NewCmd(params))
+	cmd.AddCommand(planCmd.NewCmd(params))
+	cmd.AddCommand(terraformCmd.NewCmd(params))
+	cmd.AddCommand(history.NewCmd(params))
+	cmd.AddCommand(doctor.NewCmd(params))
+}
+
+// setArgs sets the arguments of the command, after expanding a user-defined alias, and returns them.
+// If they start with the name of a plugin, the command running it is added.
+func setArgs(cmd *cobra.Command, params *params.CmdParams, inputArgs []string) ([]string, error) {
+	inputArgs, err := expandAlias(cmd, inputArgs)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(inputArgs)
+	addPluginCmd(cmd, params, inputArgs)
+	return inputArgs, nil
+}
+
+// expandAlias replaces the name of a user-defined alias at the start of the arguments with its expansion.
+// Aliases don't shadow built-in commands.
+func expandAlias(cmd *cobra.Command, inputArgs []string) ([]string, error) {
+	if len(inputArgs) == 0 || strings.HasPrefix(inputArgs[0], "-") || utils.IsBuiltinCommand(cmd, inputArgs[0]) {
+		return inputArgs, nil
+	}
+	expansion, ok := alias.Get(inputArgs[0])
+	if !ok {
+		return inputArgs, nil
+	}
+	expanded, err := alias.Expand(expansion, inputArgs[1:])
+	if err != nil {
+		return nil, &errors.ArgValidationError{
+			Arg:     inputArgs[0],
+			Details: err.Error(),
+		}
+	}
+	return expanded, nil
+}
+
+// addPluginCmd adds a command running the plugin named by the first argument, if it is not a built-in command.
+// The PATH is only searched for the plugin then, instead of on every invocation.
+func addPluginCmd(cmd *cobra.Command, params *params.CmdParams, inputArgs []string) {
+	if len(inputArgs) == 0 || strings.HasPrefix(inputArgs[0], "-") || utils.IsBuiltinCommand(cmd, inputArgs[0]) {
+		return
+	}
+	p := plugin.Find(inputArgs[0])
+	if p == nil {
+		return
+	}
+	cmd.AddCommand(pluginRun.NewCmd(params, p))
 }
 
 // traverseCommands calls f for c and all of its children.
@@ -203,32 +391,124 @@ func traverseCommands(c *cobra.Command, f func(*cobra.Command)) {
 }
 
 func Execute(version, date string) {
-	p := print.NewPrinter()
+	exitCode := run(context.Background(), version, date, print.NewPrinter(), os.Args[1:], nil)
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// run runs the command given by the arguments and returns the exit code of the CLI.
+// The global flags are set to the given values before the arguments are parsed, so that the arguments override them.
+func run(ctx context.Context, version, date string, p *print.Printer, inputArgs []string, globalFlags map[string]string) int {
 	cmd := NewRootCmd(version, date, p)
+	requestid.Reset()
+
+	cleanups := []func(){}
+	ctx = context.WithValue(ctx, cleanupsKey{}, &cleanups)
+	defer func() {
+		for _, cleanup := range slices.Backward(cleanups) {
+			cleanup()
+		}
+	}()
 
 	// We need to set the printer and verbosity here because the
 	// PersistentPreRun is not called when the command is wrongly called
 	p.Cmd = cmd
 	p.Verbosity = print.InfoLevel
 
-	err := cmd.Execute()
+	var err error
+	for name, value := range globalFlags {
+		err = cmd.PersistentFlags().Set(name, value)
+		if err != nil {
+			err = &errors.FlagValidationError{
+				Flag:    name,
+				Details: err.Error(),
+			}
+			break
+		}
+	}
+	if err == nil {
+		var expandedArgs []string
+		expandedArgs, err = setArgs(cmd, &params.CmdParams{Printer: p, CliVersion: version}, inputArgs)
+		if err == nil {
+			// The run is recorded with the command the alias expands to, which can be run again without the alias
+			inputArgs = expandedArgs
+		}
+	}
+	if err == nil {
+		var executedCmd *cobra.Command
+		executedCmd, err = cmd.ExecuteContextC(ctx)
+		// The deadline of the --timeout flag is set on the context of the executed command
+		if err != nil && executedCmd != nil && goerrors.Is(executedCmd.Context().Err(), context.DeadlineExceeded) {
+			err = &errors.TimeoutError{Err: err}
+		}
+	}
+	if harErr := har.Save(); harErr != nil {
+		p.Warn("%v\n", harErr)
+	}
+	journal.Record(p, inputArgs, err)
+	// In a dry run, the requests that would have been sent are printed instead of the output of the command
+	if dryrun.IsActive() && (err == nil || dryrun.IsNotSent(err)) {
+		err = dryrun.Output(p, viper.GetString(config.OutputFormatKey))
+	}
+	dryrun.Stop()
+	// Plugins print their own errors
+	if pluginErr, ok := err.(*errors.PluginExitError); ok { //nolint:errorlint // the error is returned by the plugin command as is
+		return pluginErr.ExitCode
+	}
+	// The commands run by the shell print their own errors too
+	if shellErr, ok := err.(*errors.ShellCommandExitError); ok { //nolint:errorlint // the error is returned by the shell command as is
+		p.Debug(print.ErrorLevel, "execute command: %v", shellErr)
+		return shellErr.ExitCode
+	}
 	if err != nil {
-		err := beautifyUnknownAndMissingCommandsError(cmd, err)
+		err := beautifyUnknownAndMissingCommandsError(cmd, inputArgs, err)
 		p.Debug(print.ErrorLevel, "execute command: %v", err)
-		p.Error("%s", err.Error())
-		os.Exit(1)
+
+		details := errors.Details(err)
+		if details.HTTPStatus != 0 {
+			details.RequestId = requestid.LastFailed(details.HTTPStatus)
+		}
+		if viper.GetString(config.OutputFormatKey) == print.JSONOutputFormat {
+			printErrorJSON(p, details)
+		} else {
+			p.Error("%s", err.Error())
+		}
+		return details.ExitCode
+	}
+	return 0
+}
+
+// Key of the context value holding the cleanups of the command run by run()
+type cleanupsKey struct{}
+
+// addCleanup registers a function that run() calls once the command has finished, e.g. to close the log file.
+// cobra.OnFinalize isn't used, as its functions would add up over the commands run in the shell.
+func addCleanup(ctx context.Context, cleanup func()) {
+	if cleanups, ok := ctx.Value(cleanupsKey{}).(*[]func()); ok {
+		*cleanups = append(*cleanups, cleanup)
+	}
+}
+
+// Prints the error as a JSON object to stderr, so that scripts can process it
+func printErrorJSON(p *print.Printer, details errors.ErrorDetails) {
+	content, err := json.Marshal(details)
+	if err != nil {
+		p.Error("%s", details.Message)
+		return
 	}
+	p.Cmd.PrintErrln(string(content))
 }
 
 // Returns a more user-friendly error if the input error is due to unknown/missing subcommands (issue: https://github.com/spf13/cobra/issues/706)
 //
 // Otherwise, returns the input error unchanged
-func beautifyUnknownAndMissingCommandsError(rootCmd *cobra.Command, cmdErr error) error {
+func beautifyUnknownAndMissingCommandsError(rootCmd *cobra.Command, inputArgs []string, cmdErr error) error {
 	if !strings.HasPrefix(cmdErr.Error(), "unknown flag") {
 		return cmdErr
 	}
 
-	cmd, unparsedInputs, err := rootCmd.Traverse(os.Args[1:])
+	cmd, unparsedInputs, err := rootCmd.Traverse(inputArgs)
 	if err != nil {
 		return cmdErr
 	}
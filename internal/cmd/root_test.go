@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
 	pkgErrors "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 )
 
 var cmd *cobra.Command
@@ -34,6 +38,7 @@ func setupCmd() {
 func TestBeautifyUnknownAndMissingCommandsError(t *testing.T) {
 	tests := []struct {
 		description           string
+		inputArgs             []string
 		inputError            error
 		command               *cobra.Command
 		expectedMsg           string
@@ -41,6 +46,7 @@ func TestBeautifyUnknownAndMissingCommandsError(t *testing.T) {
 	}{
 		{
 			description: "root command, extra input is a flag",
+			inputArgs:   []string{"--something"},
 			inputError:  errors.New("unknown flag: --something"),
 			command:     cmd,
 			expectedMsg: pkgErrors.SUBCOMMAND_MISSING,
@@ -57,7 +63,7 @@ func TestBeautifyUnknownAndMissingCommandsError(t *testing.T) {
 	setupCmd()
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			actualError := beautifyUnknownAndMissingCommandsError(cmd, tt.inputError)
+			actualError := beautifyUnknownAndMissingCommandsError(cmd, tt.inputArgs, tt.inputError)
 
 			if tt.isNotUnknownFlagError {
 				if actualError.Error() != tt.expectedMsg {
@@ -73,3 +79,64 @@ func TestBeautifyUnknownAndMissingCommandsError(t *testing.T) {
 		})
 	}
 }
+
+func TestDryRunSupport(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected bool
+	}{
+		{path: []string{"server", "create"}, expected: true},
+		{path: []string{"server", "stop"}, expected: true},
+		{path: []string{"dns", "record-set", "update"}, expected: true},
+		{path: []string{"project", "member", "add"}, expected: true},
+		{path: []string{"server", "list"}, expected: false},
+		{path: []string{"server", "describe"}, expected: false},
+		{path: []string{"config", "profile", "create"}, expected: false},
+		{path: []string{"alias", "delete"}, expected: false},
+		{path: []string{"secrets-manager", "instance", "create"}, expected: false},
+		{path: []string{"apply"}, expected: false},
+	}
+
+	root := NewRootCmd("", "", print.NewPrinter())
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.path, " "), func(t *testing.T) {
+			c, _, err := root.Find(tt.path)
+			if err != nil {
+				t.Fatalf("find command: %v", err)
+			}
+			if dryrun.IsEnabled(c) != tt.expected {
+				t.Fatalf("expected dry run support %t", tt.expected)
+			}
+		})
+	}
+}
+
+func TestJournalSupport(t *testing.T) {
+	tests := []struct {
+		path     []string
+		expected bool
+	}{
+		{path: []string{"server", "create"}, expected: true},
+		{path: []string{"dns", "record-set", "update"}, expected: true},
+		{path: []string{"secrets-manager", "instance", "create"}, expected: true},
+		{path: []string{"apply"}, expected: true},
+		{path: []string{"load-balancer", "observability-credentials", "cleanup"}, expected: true},
+		{path: []string{"server", "list"}, expected: false},
+		{path: []string{"plan"}, expected: false},
+		{path: []string{"config", "profile", "create"}, expected: false},
+		{path: []string{"history"}, expected: false},
+	}
+
+	root := NewRootCmd("", "", print.NewPrinter())
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.path, " "), func(t *testing.T) {
+			c, _, err := root.Find(tt.path)
+			if err != nil {
+				t.Fatalf("find command: %v", err)
+			}
+			if journal.IsEnabled(c) != tt.expected {
+				t.Fatalf("expected the runs to be recorded: %t", tt.expected)
+			}
+		})
+	}
+}
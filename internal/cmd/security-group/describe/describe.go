@@ -36,7 +36,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			examples.NewExample(`Describe group "xxx"`, `$ stackit security-group describe xxx`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -185,7 +185,7 @@ func outputResult(p *print.Printer, outputFormat string, resp *iaas.SecurityGrou
 			content = append(content, rulesTable)
 		}
 
-		if err := tables.DisplayTables(p, content); err != nil {
+		if err := tables.DisplayTables(p, content, outputFormat); err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
 
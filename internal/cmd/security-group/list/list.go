@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -40,7 +41,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			examples.NewExample(`List groups with labels`, `$ stackit security-group list --label-selector label1=value1,label2=value2`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -66,7 +67,11 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return fmt.Errorf("list security group: %w", err)
 			}
 
-			if items := response.GetItems(); len(items) == 0 {
+			items, err := filter.Items(model.Filter, response.GetItems())
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
 				params.Printer.Info("No security groups found for project %q", projectLabel)
 			} else {
 				if err := outputResult(params.Printer, model.OutputFormat, items); err != nil {
@@ -84,6 +89,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -109,29 +115,28 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 
 	return request
 }
-func outputResult(p *print.Printer, outputFormat string, items []iaas.SecurityGroup) error {
-	return p.OutputResult(outputFormat, items, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "STATEFUL", "DESCRIPTION", "LABELS")
-		for _, item := range items {
-			var labelsString string
-			if item.Labels != nil {
-				var labels []string
-				for key, value := range *item.Labels {
-					labels = append(labels, fmt.Sprintf("%s: %s", key, value))
-				}
-				labelsString = strings.Join(labels, ", ")
-			}
 
-			table.AddRow(
-				utils.PtrString(item.Id),
-				utils.PtrString(item.Name),
-				utils.PtrString(item.Stateful),
-				utils.PtrString(item.Description),
-				labelsString,
-			)
+var securityGroupFields = []tables.Field[iaas.SecurityGroup]{
+	{Header: "ID", Value: func(sg *iaas.SecurityGroup) any { return utils.PtrString(sg.Id) }},
+	{Header: "NAME", Value: func(sg *iaas.SecurityGroup) any { return utils.PtrString(sg.Name) }},
+	{Header: "STATEFUL", Value: func(sg *iaas.SecurityGroup) any { return utils.PtrString(sg.Stateful) }},
+	{Header: "DESCRIPTION", Value: func(sg *iaas.SecurityGroup) any { return utils.PtrString(sg.Description) }},
+	{Header: "LABELS", Value: func(sg *iaas.SecurityGroup) any {
+		if sg.Labels == nil {
+			return ""
+		}
+		var labels []string
+		for key, value := range *sg.Labels {
+			labels = append(labels, fmt.Sprintf("%s: %s", key, value))
 		}
-		err := table.Display(p)
+		return strings.Join(labels, ", ")
+	}},
+}
+
+func outputResult(p *print.Printer, outputFormat string, items []iaas.SecurityGroup) error {
+	return p.OutputResult(outputFormat, items, func() error {
+		table := tables.NewTableFromFields(securityGroupFields, items)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
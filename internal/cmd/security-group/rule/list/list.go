@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -54,7 +55,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -89,8 +90,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -108,6 +113,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, securityGroupIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -138,32 +145,28 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return apiClient.ListSecurityGroupRules(ctx, model.ProjectId, *model.SecurityGroupId)
 }
 
+var securityGroupRuleFields = []tables.Field[iaas.SecurityGroupRule]{
+	{Header: "ID", Value: func(r *iaas.SecurityGroupRule) any { return utils.PtrString(r.Id) }},
+	{Header: "ETHER TYPE", Value: func(r *iaas.SecurityGroupRule) any { return utils.PtrStringDefault(r.Ethertype, "") }},
+	{Header: "DIRECTION", Value: func(r *iaas.SecurityGroupRule) any { return utils.PtrString(r.Direction) }},
+	{Header: "PROTOCOL", Value: func(r *iaas.SecurityGroupRule) any {
+		if r.Protocol == nil || r.Protocol.Name == nil {
+			return ""
+		}
+		return *r.Protocol.Name
+	}},
+	{Header: "REMOTE SECURITY GROUP ID", Value: func(r *iaas.SecurityGroupRule) any { return utils.PtrString(r.RemoteSecurityGroupId) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, securityGroupRules []iaas.SecurityGroupRule) error {
 	return p.OutputResult(outputFormat, securityGroupRules, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "ETHER TYPE", "DIRECTION", "PROTOCOL", "REMOTE SECURITY GROUP ID")
-
-		for _, securityGroupRule := range securityGroupRules {
-			etherType := utils.PtrStringDefault(securityGroupRule.Ethertype, "")
-
-			protocolName := ""
-			if securityGroupRule.Protocol != nil {
-				if securityGroupRule.Protocol.Name != nil {
-					protocolName = *securityGroupRule.Protocol.Name
-				}
-			}
+		table := tables.NewTableFromFields(securityGroupRuleFields, securityGroupRules)
+		table.EnableRowSeparators()
 
-			table.AddRow(
-				utils.PtrString(securityGroupRule.Id),
-				etherType,
-				utils.PtrString(securityGroupRule.Direction),
-				protocolName,
-				utils.PtrString(securityGroupRule.RemoteSecurityGroupId),
-			)
-			table.AddSeparator()
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server backup list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -81,6 +82,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			backups, err = filter.Items(model.Filter, backups)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(backups) > int(*model.Limit) {
 				backups = backups[:*model.Limit]
@@ -98,6 +103,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -129,30 +136,27 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serverbacku
 	return req
 }
 
+var backupFields = []tables.Field[serverbackup.Backup]{
+	{Header: "ID", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.Id) }},
+	{Header: "NAME", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.Name) }},
+	{Header: "SIZE (GB)", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.Size) }},
+	{Header: "STATUS", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.Status) }},
+	{Header: "CREATED AT", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.CreatedAt) }},
+	{Header: "EXPIRES AT", Value: func(b *serverbackup.Backup) any { return utils.PtrString(b.ExpireAt) }},
+	{Header: "LAST RESTORED AT", Value: func(b *serverbackup.Backup) any { return utils.PtrStringDefault(b.LastRestoredAt, "") }},
+	{Header: "VOLUME BACKUPS", Value: func(b *serverbackup.Backup) any {
+		var volBackups int
+		if b.VolumeBackups != nil {
+			volBackups = len(*b.VolumeBackups)
+		}
+		return volBackups
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, backups []serverbackup.Backup) error {
 	return p.OutputResult(outputFormat, backups, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "SIZE (GB)", "STATUS", "CREATED AT", "EXPIRES AT", "LAST RESTORED AT", "VOLUME BACKUPS")
-		for i := range backups {
-			s := backups[i]
-
-			lastRestored := utils.PtrStringDefault(s.LastRestoredAt, "")
-			var volBackups int
-			if s.VolumeBackups != nil {
-				volBackups = len(*s.VolumeBackups)
-			}
-			table.AddRow(
-				utils.PtrString(s.Id),
-				utils.PtrString(s.Name),
-				utils.PtrString(s.Size),
-				utils.PtrString(s.Status),
-				utils.PtrString(s.CreatedAt),
-				utils.PtrString(s.ExpireAt),
-				lastRestored,
-				volBackups,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(backupFields, backups)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
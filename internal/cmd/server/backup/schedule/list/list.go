@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server backup schedule list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,6 +83,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			schedules, err = filter.Items(model.Filter, schedules)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(schedules) > int(*model.Limit) {
 				schedules = schedules[:*model.Limit]
@@ -99,6 +104,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -130,33 +137,35 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serverbacku
 	return req
 }
 
+var scheduleFields = []tables.Field[serverbackup.BackupSchedule]{
+	{Header: "SCHEDULE ID", Value: func(s *serverbackup.BackupSchedule) any { return utils.PtrString(s.Id) }},
+	{Header: "SCHEDULE NAME", Value: func(s *serverbackup.BackupSchedule) any { return utils.PtrString(s.Name) }},
+	{Header: "ENABLED", Value: func(s *serverbackup.BackupSchedule) any { return utils.PtrString(s.Enabled) }},
+	{Header: "RRULE", Value: func(s *serverbackup.BackupSchedule) any { return utils.PtrString(s.Rrule) }},
+	{Header: "BACKUP NAME", Value: func(s *serverbackup.BackupSchedule) any {
+		if s.BackupProperties == nil {
+			return ""
+		}
+		return utils.PtrString(s.BackupProperties.Name)
+	}},
+	{Header: "BACKUP RETENTION DAYS", Value: func(s *serverbackup.BackupSchedule) any {
+		if s.BackupProperties == nil {
+			return ""
+		}
+		return utils.PtrString(s.BackupProperties.RetentionPeriod)
+	}},
+	{Header: "BACKUP VOLUME IDS", Value: func(s *serverbackup.BackupSchedule) any {
+		if s.BackupProperties == nil {
+			return ""
+		}
+		return utils.JoinStringPtr(s.BackupProperties.VolumeIds, ",")
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, schedules []serverbackup.BackupSchedule) error {
 	return p.OutputResult(outputFormat, schedules, func() error {
-		table := tables.NewTable()
-		table.SetHeader("SCHEDULE ID", "SCHEDULE NAME", "ENABLED", "RRULE", "BACKUP NAME", "BACKUP RETENTION DAYS", "BACKUP VOLUME IDS")
-		for i := range schedules {
-			s := schedules[i]
-
-			backupName := ""
-			retentionPeriod := ""
-			ids := ""
-			if s.BackupProperties != nil {
-				backupName = utils.PtrString(s.BackupProperties.Name)
-				retentionPeriod = utils.PtrString(s.BackupProperties.RetentionPeriod)
-
-				ids = utils.JoinStringPtr(s.BackupProperties.VolumeIds, ",")
-			}
-			table.AddRow(
-				utils.PtrString(s.Id),
-				utils.PtrString(s.Name),
-				utils.PtrString(s.Enabled),
-				utils.PtrString(s.Rrule),
-				backupName,
-				retentionPeriod,
-				ids,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(scheduleFields, schedules)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
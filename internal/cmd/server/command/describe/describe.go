@@ -44,7 +44,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server command describe xxx --server-id=yyy --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -120,7 +120,7 @@ func outputResult(p *print.Printer, outputFormat string, command runcommand.Comm
 		table.AddSeparator()
 		table.AddRow("COMMAND OUTPUT", utils.PtrString(command.Output))
 		table.AddSeparator()
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
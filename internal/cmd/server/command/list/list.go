@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server command list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -81,6 +82,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 			commands := *resp.Items
+			commands, err = filter.Items(model.Filter, commands)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(commands) > int(*model.Limit) {
 				commands = commands[:*model.Limit]
@@ -98,6 +103,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -129,22 +136,19 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *runcommand.
 	return req
 }
 
+var commandFields = []tables.Field[runcommand.Commands]{
+	{Header: "ID", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.Id) }},
+	{Header: "TEMPLATE NAME", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.CommandTemplateName) }},
+	{Header: "TEMPLATE TITLE", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.CommandTemplateTitle) }},
+	{Header: "STATUS", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.Status) }},
+	{Header: "STARTED_AT", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.StartedAt) }},
+	{Header: "FINISHED_AT", Value: func(c *runcommand.Commands) any { return utils.PtrString(c.FinishedAt) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, commands []runcommand.Commands) error {
 	return p.OutputResult(outputFormat, commands, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "TEMPLATE NAME", "TEMPLATE TITLE", "STATUS", "STARTED_AT", "FINISHED_AT")
-		for i := range commands {
-			s := commands[i]
-			table.AddRow(
-				utils.PtrString(s.Id),
-				utils.PtrString(s.CommandTemplateName),
-				utils.PtrString(s.CommandTemplateTitle),
-				utils.PtrString(s.Status),
-				utils.PtrString(s.StartedAt),
-				utils.PtrString(s.FinishedAt),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(commandFields, commands)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -42,7 +43,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server command template list --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -66,6 +67,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			templates := *resp.Items
 
+			templates, err = filter.Items(model.Filter, templates)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(templates) > int(*model.Limit) {
 				templates = templates[:*model.Limit]
@@ -79,6 +84,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -109,25 +116,21 @@ func buildRequest(ctx context.Context, _ *inputModel, apiClient *runcommand.APIC
 	return req
 }
 
+var templateFields = []tables.Field[runcommand.CommandTemplate]{
+	{Header: "NAME", Value: func(t *runcommand.CommandTemplate) any { return utils.PtrString(t.Name) }},
+	{Header: "OS TYPE", Value: func(t *runcommand.CommandTemplate) any {
+		if t.OsType == nil || len(*t.OsType) == 0 {
+			return ""
+		}
+		return utils.JoinStringPtr(t.OsType, ",")
+	}},
+	{Header: "TITLE", Value: func(t *runcommand.CommandTemplate) any { return utils.PtrString(t.Title) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, templates []runcommand.CommandTemplate) error {
 	return p.OutputResult(outputFormat, templates, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NAME", "OS TYPE", "TITLE")
-		for i := range templates {
-			s := templates[i]
-
-			var osType string
-			if s.OsType != nil && len(*s.OsType) > 0 {
-				osType = utils.JoinStringPtr(s.OsType, ",")
-			}
-
-			table.AddRow(
-				utils.PtrString(s.Name),
-				osType,
-				utils.PtrString(s.Title),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(templateFields, templates)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/bulk"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
 	iaasUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/utils"
@@ -26,26 +28,33 @@ const (
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	ServerId string
+	ServerId      string
+	LabelSelector *string
+	Concurrency   int64
 }
 
 func NewCmd(params *params.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("delete %s", serverIdArg),
 		Short: "Deletes a server",
-		Long: fmt.Sprintf("%s\n%s\n",
+		Long: fmt.Sprintf("%s\n%s\n%s\n",
 			"Deletes a server.",
 			"If the server is still in use, the deletion will fail",
+			"Use --label-selector to delete all servers matching the selector, after a single confirmation.",
 		),
-		Args: args.SingleArg(serverIdArg, utils.ValidateUUID),
+		Args: args.SingleArgUnlessFlag(serverIdArg, bulk.LabelSelectorFlag, utils.ValidateUUID),
 		Example: examples.Build(
 			examples.NewExample(
 				`Delete server with ID "xxx"`,
 				"$ stackit server delete xxx",
 			),
+			examples.NewExample(
+				`Delete all servers with the label "env" set to "test", at most 10 at the same time`,
+				"$ stackit server delete --label-selector env=test --concurrency 10",
+			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -57,6 +66,20 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return err
 			}
 
+			if model.LabelSelector != nil {
+				return bulk.RunSelected(ctx, params.Printer, model.GlobalFlagModel, bulk.Selection{
+					Action:        "delete",
+					Resource:      "server",
+					Progress:      "Deleting",
+					LabelSelector: *model.LabelSelector,
+					Concurrency:   model.Concurrency,
+				}, func(ctx context.Context) ([]bulk.Item, error) {
+					return listServers(ctx, model, apiClient)
+				}, func(ctx context.Context, item bulk.Item) error {
+					return deleteServer(ctx, model, apiClient, item)
+				})
+			}
+
 			serverLabel, err := iaasUtils.GetServerName(ctx, apiClient, model.ProjectId, model.ServerId)
 			if err != nil {
 				params.Printer.Debug(print.ErrorLevel, "get server name: %v", err)
@@ -99,20 +122,36 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			return nil
 		},
 	}
+	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
+func configureFlags(cmd *cobra.Command) {
+	bulk.ConfigureFlags(cmd, "delete", "server")
+}
+
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
-	serverId := inputArgs[0]
+	var serverId string
+	if len(inputArgs) > 0 {
+		serverId = inputArgs[0]
+	}
 
 	globalFlags := globalflags.Parse(p, cmd)
 	if globalFlags.ProjectId == "" {
 		return nil, &errors.ProjectIdError{}
 	}
 
+	labelSelector, concurrency, err := bulk.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
 		GlobalFlagModel: globalFlags,
 		ServerId:        serverId,
+		LabelSelector:   labelSelector,
+		Concurrency:     concurrency,
 	}
 
 	p.DebugInputModel(model)
@@ -122,3 +161,37 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiDeleteServerRequest {
 	return apiClient.DeleteServer(ctx, model.ProjectId, model.ServerId)
 }
+
+func buildListRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiListServersRequest {
+	return apiClient.ListServers(ctx, model.ProjectId).LabelSelector(*model.LabelSelector)
+}
+
+// listServers lists the servers matching the label selector
+func listServers(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) ([]bulk.Item, error) {
+	resp, err := buildListRequest(ctx, model, apiClient).Execute()
+	if err != nil {
+		return nil, err
+	}
+	items := []bulk.Item{}
+	for _, server := range utils.GetSliceFromPointer(resp.Items) {
+		items = append(items, bulk.Item{Id: server.GetId(), Name: server.GetName()})
+	}
+	return items, nil
+}
+
+// deleteServer deletes a server matching the label selector
+func deleteServer(ctx context.Context, model *inputModel, apiClient *iaas.APIClient, item bulk.Item) error {
+	err := apiClient.DeleteServer(ctx, model.ProjectId, item.Id).Execute()
+	if err != nil {
+		return fmt.Errorf("delete server: %w", err)
+	}
+	// Wait for async operation, if async mode not enabled
+	if model.Async {
+		return nil
+	}
+	_, err = wait.DeleteServerWaitHandler(ctx, apiClient, model.ProjectId, item.Id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for server deletion: %w", err)
+	}
+	return nil
+}
@@ -47,7 +47,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -100,6 +100,10 @@ func outputResult(p *print.Printer, outputFormat string, server *iaas.Server) er
 	if server == nil {
 		return fmt.Errorf("api response is empty")
 	}
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, server, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(server, "", "  ")
@@ -237,7 +241,7 @@ func outputResult(p *print.Printer, outputFormat string, server *iaas.Server) er
 			content = append(content, maintenanceWindow)
 		}
 
-		err := tables.DisplayTables(p, content)
+		err := tables.DisplayTables(p, content, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -58,7 +59,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -87,8 +88,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -103,6 +108,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -139,7 +146,51 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+// The power status, image, key pair, affinity group and creation date are only displayed with "--columns wide"
+var serverFields = []tables.Field[iaas.Server]{
+	{Header: "ID", Value: func(s *iaas.Server) any { return utils.PtrString(s.Id) }},
+	{Header: "Name", Value: func(s *iaas.Server) any { return utils.PtrString(s.Name) }},
+	{Header: "Status", Value: func(s *iaas.Server) any { return utils.PtrString(s.Status) }},
+	{Header: "Machine Type", Value: func(s *iaas.Server) any { return utils.PtrString(s.MachineType) }},
+	{Header: "Availability Zones", Value: func(s *iaas.Server) any { return utils.PtrString(s.AvailabilityZone) }},
+	{Header: "Nic IPv4", Value: func(s *iaas.Server) any {
+		nicIPv4, _ := nicAddresses(s)
+		return nicIPv4
+	}},
+	{Header: "Public IPs", Value: func(s *iaas.Server) any {
+		_, publicIPs := nicAddresses(s)
+		return publicIPs
+	}},
+	{Header: "Power Status", Value: func(s *iaas.Server) any { return utils.PtrString(s.PowerStatus) }, Wide: true},
+	{Header: "Image ID", Value: func(s *iaas.Server) any { return utils.PtrString(s.ImageId) }, Wide: true},
+	{Header: "Keypair Name", Value: func(s *iaas.Server) any { return utils.PtrString(s.KeypairName) }, Wide: true},
+	{Header: "Affinity Group", Value: func(s *iaas.Server) any { return utils.PtrString(s.AffinityGroup) }, Wide: true},
+	{Header: "Created At", Value: func(s *iaas.Server) any { return utils.ConvertTimePToDateTimeString(s.CreatedAt) }, Wide: true},
+}
+
+// Returns the IPv4 and public IP addresses of the server's network interfaces, one per line
+func nicAddresses(server *iaas.Server) (nicIPv4, publicIPs string) {
+	if server.Nics != nil && len(*server.Nics) > 0 {
+		for i, nic := range *server.Nics {
+			if nic.Ipv4 != nil || nic.PublicIp != nil {
+				nicIPv4 += utils.PtrString(nic.Ipv4)
+				publicIPs += utils.PtrString(nic.PublicIp)
+
+				if i != len(*server.Nics)-1 {
+					publicIPs += "\n"
+					nicIPv4 += "\n"
+				}
+			}
+		}
+	}
+	return nicIPv4, publicIPs
+}
+
 func outputResult(p *print.Printer, outputFormat string, servers []iaas.Server) error {
+	if print.IsTemplateOutputFormat(outputFormat) {
+		return p.OutputResult(outputFormat, servers, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		details, err := json.MarshalIndent(servers, "", "  ")
@@ -163,40 +214,12 @@ func outputResult(p *print.Printer, outputFormat string, servers []iaas.Server)
 
 		return nil
 	default:
-		table := tables.NewTable()
-		table.SetHeader("ID", "Name", "Status", "Machine Type", "Availability Zones", "Nic IPv4", "Public IPs")
-
-		for i := range servers {
-			server := servers[i]
-
-			nicIPv4 := ""
-			publicIPs := ""
-			if server.Nics != nil && len(*server.Nics) > 0 {
-				for i, nic := range *server.Nics {
-					if nic.Ipv4 != nil || nic.PublicIp != nil {
-						nicIPv4 += utils.PtrString(nic.Ipv4)
-						publicIPs += utils.PtrString(nic.PublicIp)
-
-						if i != len(*server.Nics)-1 {
-							publicIPs += "\n"
-							nicIPv4 += "\n"
-						}
-					}
-				}
-			}
-
-			table.AddRow(
-				utils.PtrString(server.Id),
-				utils.PtrString(server.Name),
-				utils.PtrString(server.Status),
-				utils.PtrString(server.MachineType),
-				utils.PtrString(server.AvailabilityZone),
-				nicIPv4,
-				publicIPs,
-			)
+		table := tables.NewTableFromFields(serverFields, servers)
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
 
-		p.Outputln(table.Render())
 		return nil
 	}
 }
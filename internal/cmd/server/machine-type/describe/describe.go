@@ -44,7 +44,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -107,7 +107,7 @@ func outputResult(p *print.Printer, outputFormat string, machineType *iaas.Machi
 		table.AddRow("DESCRIPTION", utils.PtrString(machineType.Description))
 		table.AddSeparator()
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
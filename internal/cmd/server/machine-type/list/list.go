@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -79,6 +80,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			filteredItems, err := filter.Items(model.Filter, *resp.Items)
+			if err != nil {
+				return err
+			}
+			*resp.Items = filteredItems
+
 			// limit output
 			if model.Limit != nil && len(*resp.Items) > int(*model.Limit) {
 				*resp.Items = (*resp.Items)[:*model.Limit]
@@ -94,6 +101,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Limit the output to the first n elements")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -123,19 +132,17 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return apiClient.ListMachineTypes(ctx, model.ProjectId)
 }
 
+var machineTypeFields = []tables.Field[iaas.MachineType]{
+	{Header: "NAME", Value: func(mt *iaas.MachineType) any { return utils.PtrString(mt.Name) }},
+	{Header: "DESCRIPTION", Value: func(mt *iaas.MachineType) any { return utils.PtrString(mt.Description) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, machineTypes iaas.MachineTypeListResponse) error {
 	return p.OutputResult(outputFormat, machineTypes, func() error {
-		table := tables.NewTable()
+		table := tables.NewTableFromFields(machineTypeFields, machineTypes.GetItems())
 		table.SetTitle("Machine-Types")
 
-		table.SetHeader("NAME", "DESCRIPTION")
-		if items := machineTypes.GetItems(); len(items) > 0 {
-			for _, machineType := range items {
-				table.AddRow(*machineType.Name, utils.PtrString(machineType.Description))
-			}
-		}
-
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -51,7 +52,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,8 +83,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -101,6 +106,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -131,18 +138,23 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return apiClient.ListServerNics(ctx, model.ProjectId, *model.ServerId)
 }
 
+// The network interfaces are listed with the server they are attached to
+func nicFields(serverId string) []tables.Field[iaas.NIC] {
+	return []tables.Field[iaas.NIC]{
+		{Header: "NIC ID", Value: func(nic *iaas.NIC) any { return utils.PtrString(nic.Id) }},
+		{Header: "SERVER ID", Value: func(*iaas.NIC) any { return serverId }},
+	}
+}
+
 func outputResult(p *print.Printer, outputFormat, serverId string, serverNics []iaas.NIC) error {
 	return p.OutputResult(outputFormat, serverNics, func() error {
-		table := tables.NewTable()
-		table.SetHeader("NIC ID", "SERVER ID")
-
-		for i := range serverNics {
-			nic := serverNics[i]
-			table.AddRow(utils.PtrString(nic.Id), serverId)
-		}
+		table := tables.NewTableFromFields(nicFields(serverId), serverNics)
 		table.EnableAutoMergeOnColumns(2)
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
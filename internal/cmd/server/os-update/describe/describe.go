@@ -44,7 +44,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server os-update describe my-os-update-id --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -117,7 +117,7 @@ func outputResult(p *print.Printer, outputFormat string, update serverupdate.Upd
 		table.AddRow("END DATE", utils.PtrString(update.EndDate))
 		table.AddSeparator()
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
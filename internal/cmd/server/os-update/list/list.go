@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -48,7 +49,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server os-update list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,6 +83,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			updates, err = filter.Items(model.Filter, updates)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(updates) > int(*model.Limit) {
 				updates = updates[:*model.Limit]
@@ -99,6 +104,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -130,35 +137,29 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serverupdat
 	return req
 }
 
+var updateFields = []tables.Field[serverupdate.Update]{
+	{Header: "ID", Value: func(u *serverupdate.Update) any { return utils.PtrString(u.Id) }},
+	{Header: "STATUS", Value: func(u *serverupdate.Update) any { return utils.PtrString(u.Status) }},
+	{Header: "INSTALLED UPDATES", Value: func(u *serverupdate.Update) any {
+		if u.InstalledUpdates == nil {
+			return "n/a"
+		}
+		return strconv.FormatInt(*u.InstalledUpdates, 10)
+	}},
+	{Header: "FAILED UPDATES", Value: func(u *serverupdate.Update) any {
+		if u.FailedUpdates == nil {
+			return "n/a"
+		}
+		return strconv.FormatInt(*u.FailedUpdates, 10)
+	}},
+	{Header: "START DATE", Value: func(u *serverupdate.Update) any { return utils.PtrString(u.StartDate) }},
+	{Header: "END DATE", Value: func(u *serverupdate.Update) any { return utils.PtrStringDefault(u.EndDate, "n/a") }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, updates []serverupdate.Update) error {
 	return p.OutputResult(outputFormat, updates, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "STATUS", "INSTALLED UPDATES", "FAILED UPDATES", "START DATE", "END DATE")
-		for i := range updates {
-			s := updates[i]
-
-			endDate := utils.PtrStringDefault(s.EndDate, "n/a")
-
-			installed := "n/a"
-			if s.InstalledUpdates != nil {
-				installed = strconv.FormatInt(*s.InstalledUpdates, 10)
-			}
-
-			failed := "n/a"
-			if s.FailedUpdates != nil {
-				failed = strconv.FormatInt(*s.FailedUpdates, 10)
-			}
-
-			table.AddRow(
-				utils.PtrString(s.Id),
-				utils.PtrString(s.Status),
-				installed,
-				failed,
-				utils.PtrString(s.StartDate),
-				endDate,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(updateFields, updates)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
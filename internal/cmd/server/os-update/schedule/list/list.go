@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -47,7 +48,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server os-update schedule list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -81,6 +82,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			schedules, err = filter.Items(model.Filter, schedules)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(schedules) > int(*model.Limit) {
 				schedules = schedules[:*model.Limit]
@@ -98,6 +103,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -129,21 +136,18 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serverupdat
 	return req
 }
 
+var scheduleFields = []tables.Field[serverupdate.UpdateSchedule]{
+	{Header: "SCHEDULE ID", Value: func(s *serverupdate.UpdateSchedule) any { return utils.PtrString(s.Id) }},
+	{Header: "SCHEDULE NAME", Value: func(s *serverupdate.UpdateSchedule) any { return utils.PtrString(s.Name) }},
+	{Header: "ENABLED", Value: func(s *serverupdate.UpdateSchedule) any { return utils.PtrString(s.Enabled) }},
+	{Header: "RRULE", Value: func(s *serverupdate.UpdateSchedule) any { return utils.PtrString(s.Rrule) }},
+	{Header: "MAINTENANCE WINDOW", Value: func(s *serverupdate.UpdateSchedule) any { return utils.PtrString(s.MaintenanceWindow) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, schedules []serverupdate.UpdateSchedule) error {
 	return p.OutputResult(outputFormat, schedules, func() error {
-		table := tables.NewTable()
-		table.SetHeader("SCHEDULE ID", "SCHEDULE NAME", "ENABLED", "RRULE", "MAINTENANCE WINDOW")
-		for i := range schedules {
-			s := schedules[i]
-			table.AddRow(
-				utils.PtrString(s.Id),
-				utils.PtrString(s.Name),
-				utils.PtrString(s.Enabled),
-				utils.PtrString(s.Rrule),
-				utils.PtrString(s.MaintenanceWindow),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(scheduleFields, schedules)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
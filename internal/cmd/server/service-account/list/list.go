@@ -7,6 +7,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -82,6 +83,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			serviceAccounts, err = filter.Items(model.Filter, serviceAccounts)
+			if err != nil {
+				return err
+			}
 			if model.Limit != nil && len(serviceAccounts) > int(*model.Limit) {
 				serviceAccounts = serviceAccounts[:int(*model.Limit)]
 			}
@@ -99,6 +104,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -130,14 +137,19 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+// The service accounts are listed with the server they are attached to
+func serviceAccountFields(serverId, serverName string) []tables.Field[string] {
+	return []tables.Field[string]{
+		{Header: "SERVER ID", Value: func(*string) any { return serverId }},
+		{Header: "SERVER NAME", Value: func(*string) any { return serverName }},
+		{Header: "SERVICE ACCOUNT", Value: func(sa *string) any { return *sa }},
+	}
+}
+
 func outputResult(p *print.Printer, outputFormat, serverId, serverName string, serviceAccounts []string) error {
 	return p.OutputResult(outputFormat, serviceAccounts, func() error {
-		table := tables.NewTable()
-		table.SetHeader("SERVER ID", "SERVER NAME", "SERVICE ACCOUNT")
-		for i := range serviceAccounts {
-			table.AddRow(serverId, serverName, serviceAccounts[i])
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(serviceAccountFields(serverId, serverName), serviceAccounts)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("rednder table: %w", err)
 		}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/bulk"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
 	iaasUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/utils"
@@ -26,23 +28,32 @@ const (
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	ServerId string
+	ServerId      string
+	LabelSelector *string
+	Concurrency   int64
 }
 
 func NewCmd(params *params.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("stop %s", serverIdArg),
 		Short: "Stops an existing server",
-		Long:  "Stops an existing server.",
-		Args:  args.SingleArg(serverIdArg, utils.ValidateUUID),
+		Long: fmt.Sprintf("%s\n%s\n",
+			"Stops an existing server.",
+			"Use --label-selector to stop all servers matching the selector, after a single confirmation.",
+		),
+		Args: args.SingleArgUnlessFlag(serverIdArg, bulk.LabelSelectorFlag, utils.ValidateUUID),
 		Example: examples.Build(
 			examples.NewExample(
 				`Stop an existing server with ID "xxx"`,
 				"$ stackit server stop xxx",
 			),
+			examples.NewExample(
+				`Stop all servers with the label "env" set to "test", at most 10 at the same time`,
+				"$ stackit server stop --label-selector env=test --concurrency 10",
+			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -54,6 +65,20 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return err
 			}
 
+			if model.LabelSelector != nil {
+				return bulk.RunSelected(ctx, params.Printer, model.GlobalFlagModel, bulk.Selection{
+					Action:        "stop",
+					Resource:      "server",
+					Progress:      "Stopping",
+					LabelSelector: *model.LabelSelector,
+					Concurrency:   model.Concurrency,
+				}, func(ctx context.Context) ([]bulk.Item, error) {
+					return listServers(ctx, model, apiClient)
+				}, func(ctx context.Context, item bulk.Item) error {
+					return stopServer(ctx, model, apiClient, item)
+				})
+			}
+
 			serverLabel, err := iaasUtils.GetServerName(ctx, apiClient, model.ProjectId, model.ServerId)
 			if err != nil {
 				params.Printer.Debug(print.ErrorLevel, "get server name: %v", err)
@@ -97,20 +122,36 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			return nil
 		},
 	}
+	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
+func configureFlags(cmd *cobra.Command) {
+	bulk.ConfigureFlags(cmd, "stop", "server")
+}
+
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
-	serverId := inputArgs[0]
+	var serverId string
+	if len(inputArgs) > 0 {
+		serverId = inputArgs[0]
+	}
 
 	globalFlags := globalflags.Parse(p, cmd)
 	if globalFlags.ProjectId == "" {
 		return nil, &errors.ProjectIdError{}
 	}
 
+	labelSelector, concurrency, err := bulk.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
 		GlobalFlagModel: globalFlags,
 		ServerId:        serverId,
+		LabelSelector:   labelSelector,
+		Concurrency:     concurrency,
 	}
 
 	p.DebugInputModel(model)
@@ -120,3 +161,37 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiStopServerRequest {
 	return apiClient.StopServer(ctx, model.ProjectId, model.ServerId)
 }
+
+func buildListRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiListServersRequest {
+	return apiClient.ListServers(ctx, model.ProjectId).LabelSelector(*model.LabelSelector)
+}
+
+// listServers lists the servers matching the label selector
+func listServers(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) ([]bulk.Item, error) {
+	resp, err := buildListRequest(ctx, model, apiClient).Execute()
+	if err != nil {
+		return nil, err
+	}
+	items := []bulk.Item{}
+	for _, server := range utils.GetSliceFromPointer(resp.Items) {
+		items = append(items, bulk.Item{Id: server.GetId(), Name: server.GetName()})
+	}
+	return items, nil
+}
+
+// stopServer stops a server matching the label selector
+func stopServer(ctx context.Context, model *inputModel, apiClient *iaas.APIClient, item bulk.Item) error {
+	err := apiClient.StopServer(ctx, model.ProjectId, item.Id).Execute()
+	if err != nil {
+		return fmt.Errorf("server stop: %w", err)
+	}
+	// Wait for async operation, if async mode not enabled
+	if model.Async {
+		return nil
+	}
+	_, err = wait.StopServerWaitHandler(ctx, apiClient, model.ProjectId, item.Id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for server stopping: %w", err)
+	}
+	return nil
+}
@@ -51,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -137,7 +137,7 @@ func outputResult(p *print.Printer, outputFormat, serverLabel, volumeLabel strin
 		table.AddRow("DELETE ON TERMINATION", utils.PtrString(volume.DeleteOnTermination))
 		table.AddSeparator()
 
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -43,7 +44,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit server volumes list --server-id xxx --output-format json"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -69,7 +70,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("list server volumes: %w", err)
 			}
-			volumes := *resp.Items
+			volumes, err := filter.Items(model.Filter, *resp.Items)
+			if err != nil {
+				return err
+			}
 			if len(volumes) == 0 {
 				params.Printer.Info("No volumes found for server %s\n", serverLabel)
 				return nil
@@ -98,6 +102,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serverIdFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -120,19 +126,33 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+// A row of the pretty output, the attached volumes are listed with their name
+type volumeRow struct {
+	volume *iaas.VolumeAttachment
+	name   string
+}
+
+// The volumes are listed with the server they are attached to
+func volumeFields(serverLabel string) []tables.Field[volumeRow] {
+	return []tables.Field[volumeRow]{
+		{Header: "SERVER ID", Value: func(r *volumeRow) any { return utils.PtrString(r.volume.ServerId) }},
+		{Header: "SERVER NAME", Value: func(*volumeRow) any { return serverLabel }},
+		{Header: "VOLUME ID", Value: func(r *volumeRow) any { return utils.PtrString(r.volume.VolumeId) }},
+		{Header: "VOLUME NAME", Value: func(r *volumeRow) any { return r.name }},
+	}
+}
+
 func outputResult(p *print.Printer, outputFormat, serverLabel string, volumeNames []string, volumes []iaas.VolumeAttachment) error {
 	return p.OutputResult(outputFormat, volumes, func() error {
-		table := tables.NewTable()
-		table.SetHeader("SERVER ID", "SERVER NAME", "VOLUME ID", "VOLUME NAME")
+		rows := make([]volumeRow, len(volumes))
 		for i := range volumes {
-			s := volumes[i]
-			var volumeName string
+			rows[i].volume = &volumes[i]
 			if len(volumeNames)-1 > i {
-				volumeName = volumeNames[i]
+				rows[i].name = volumeNames[i]
 			}
-			table.AddRow(utils.PtrString(s.ServerId), serverLabel, utils.PtrString(s.VolumeId), volumeName)
 		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(volumeFields(serverLabel), rows)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -35,7 +35,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit service-account get-jwks my-service-account-1234567@sa.stackit.cloud"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -48,7 +49,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit service-account key list --email my-service-account-1234567@sa.stackit.cloud --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -72,6 +73,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			keys, err = filter.Items(model.Filter, keys)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(keys) > int(*model.Limit) {
 				keys = keys[:*model.Limit]
@@ -91,6 +96,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serviceAccountEmailFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -130,24 +137,22 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serviceacco
 	return req
 }
 
+var keyFields = []tables.Field[serviceaccount.ServiceAccountKeyListResponse]{
+	{Header: "ID", Value: func(k *serviceaccount.ServiceAccountKeyListResponse) any { return utils.PtrString(k.Id) }},
+	{Header: "ACTIVE", Value: func(k *serviceaccount.ServiceAccountKeyListResponse) any { return utils.PtrString(k.Active) }},
+	{Header: "CREATED_AT", Value: func(k *serviceaccount.ServiceAccountKeyListResponse) any { return utils.PtrString(k.CreatedAt) }},
+	{Header: "VALID_UNTIL", Value: func(k *serviceaccount.ServiceAccountKeyListResponse) any {
+		if k.ValidUntil == nil {
+			return "does not expire"
+		}
+		return k.ValidUntil.String()
+	}},
+}
+
 func outputResult(p *print.Printer, outputFormat string, keys []serviceaccount.ServiceAccountKeyListResponse) error {
 	return p.OutputResult(outputFormat, keys, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "ACTIVE", "CREATED_AT", "VALID_UNTIL")
-		for i := range keys {
-			k := keys[i]
-			validUntil := "does not expire"
-			if k.ValidUntil != nil {
-				validUntil = k.ValidUntil.String()
-			}
-			table.AddRow(
-				utils.PtrString(k.Id),
-				utils.PtrString(k.Active),
-				utils.PtrString(k.CreatedAt),
-				validUntil,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(keyFields, keys)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
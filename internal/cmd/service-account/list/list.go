@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -40,7 +41,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit service-account list"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -69,6 +70,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			serviceAccounts, err = filter.Items(model.Filter, serviceAccounts)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(serviceAccounts) > int(*model.Limit) {
 				serviceAccounts = serviceAccounts[:*model.Limit]
@@ -84,6 +89,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -114,18 +121,15 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serviceacco
 	return req
 }
 
+var serviceAccountFields = []tables.Field[serviceaccount.ServiceAccount]{
+	{Header: "ID", Value: func(sa *serviceaccount.ServiceAccount) any { return utils.PtrString(sa.Id) }},
+	{Header: "EMAIL", Value: func(sa *serviceaccount.ServiceAccount) any { return utils.PtrString(sa.Email) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, serviceAccounts []serviceaccount.ServiceAccount) error {
 	return p.OutputResult(outputFormat, serviceAccounts, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "EMAIL")
-		for i := range serviceAccounts {
-			account := serviceAccounts[i]
-			table.AddRow(
-				utils.PtrString(account.Id),
-				utils.PtrString(account.Email),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(serviceAccountFields, serviceAccounts)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
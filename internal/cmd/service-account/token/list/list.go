@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -53,7 +54,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit service-account token list --email my-service-account-1234567@sa.stackit.cloud --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -77,6 +78,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
+			tokensMetadata, err = filter.Items(model.Filter, tokensMetadata)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(tokensMetadata) > int(*model.Limit) {
 				tokensMetadata = tokensMetadata[:*model.Limit]
@@ -96,6 +101,8 @@ func configureFlags(cmd *cobra.Command) {
 
 	err := flags.MarkFlagsRequired(cmd, serviceAccountEmailFlag)
 	cobra.CheckErr(err)
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -135,20 +142,17 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *serviceacco
 	return req
 }
 
+var tokenFields = []tables.Field[serviceaccount.AccessTokenMetadata]{
+	{Header: "ID", Value: func(t *serviceaccount.AccessTokenMetadata) any { return utils.PtrString(t.Id) }},
+	{Header: "ACTIVE", Value: func(t *serviceaccount.AccessTokenMetadata) any { return utils.PtrString(t.Active) }},
+	{Header: "CREATED_AT", Value: func(t *serviceaccount.AccessTokenMetadata) any { return utils.PtrString(t.CreatedAt) }},
+	{Header: "VALID_UNTIL", Value: func(t *serviceaccount.AccessTokenMetadata) any { return utils.PtrString(t.ValidUntil) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, tokensMetadata []serviceaccount.AccessTokenMetadata) error {
 	return p.OutputResult(outputFormat, tokensMetadata, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "ACTIVE", "CREATED_AT", "VALID_UNTIL")
-		for i := range tokensMetadata {
-			t := tokensMetadata[i]
-			table.AddRow(
-				utils.PtrString(t.Id),
-				utils.PtrString(t.Active),
-				utils.PtrString(t.CreatedAt),
-				utils.PtrString(t.ValidUntil),
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(tokenFields, tokensMetadata)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
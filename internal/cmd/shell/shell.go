@@ -0,0 +1,510 @@
+package shell
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/alias"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/session"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const (
+	continueOnErrorFlag = "continue-on-error"
+
+	// Commands of the shell itself, next to the CLI commands
+	useCommand  = "use"
+	exitCommand = "exit"
+	quitCommand = "quit"
+
+	// Contexts that can be set with "use", mapped to the global flags they set
+	projectContext = "project"
+	regionContext  = "region"
+
+	prompt = "stackit> "
+
+	// The history is kept in the user's cache directory, limited to the most recent lines
+	historyCacheIdentifier = "shell-history"
+	historySize            = 500
+)
+
+var contextFlags = map[string]string{
+	projectContext: globalflags.ProjectIdFlag,
+	regionContext:  globalflags.RegionFlag,
+}
+
+// Commands that change the active profile or the credentials.
+// After them, the configuration is read again and the state kept in the session is dropped.
+var reloadingCommands = []string{"auth", "config profile"}
+
+// Executor runs the command given by the arguments on a new command tree and returns its exit code.
+// The global flags are set to the given values, unless they are set in the arguments too.
+type Executor func(ctx context.Context, args []string, globalFlags map[string]string) int
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ContinueOnError bool
+}
+
+func NewCmd(params *params.CmdParams, execute Executor) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shell",
+		Short: "Starts an interactive shell",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			`Starts an interactive shell to run commands without the "stackit" prefix.`,
+			"The configuration, the authentication and looked up names are kept between the commands. Commands and flags are completed with the Tab key and the history is kept across shells.",
+			fmt.Sprintf(`"%[1]s %[2]s <project-id>" and "%[1]s %[3]s <region>" set the project ID and region of the following commands, unless they are set with flags. "%[1]s" shows them.`, useCommand, projectContext, regionContext),
+			fmt.Sprintf(`Ctrl+C interrupts the running command, "%s" or Ctrl+D ends the shell. If the input is not a terminal, the commands are read from it line by line and the shell stops at the first failing command, exiting with its exit code.`, exitCommand),
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Start an interactive shell`,
+				"$ stackit shell"),
+			examples.NewExample(
+				`Start an interactive shell with the project ID of the commands set to "xxx"`,
+				"$ stackit shell --project-id xxx"),
+			examples.NewExample(
+				`Run the commands in the file "commands.txt", keeping the authentication between them`,
+				"$ stackit shell < commands.txt"),
+			examples.NewExample(
+				`Run all commands in the file "commands.txt", even if some of them fail`,
+				"$ stackit shell --continue-on-error < commands.txt"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			s := newShell(params.Printer, cmd.Root(), execute)
+			// The project ID and region the shell is started with are used for all commands
+			if cmd.Flags().Changed(globalflags.ProjectIdFlag) {
+				s.context[globalflags.ProjectIdFlag] = model.ProjectId
+			}
+			if cmd.Flags().Changed(globalflags.RegionFlag) {
+				s.context[globalflags.RegionFlag] = model.Region
+			}
+
+			session.Start()
+			defer session.End()
+
+			stdin, ok := cmd.InOrStdin().(*os.File)
+			if !ok || !term.IsTerminal(int(stdin.Fd())) {
+				return s.runScript(cmd.Context(), cmd.InOrStdin(), model.ContinueOnError)
+			}
+			return s.runInteractive(cmd.Context(), stdin, cmd.OutOrStdout())
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(continueOnErrorFlag, false, "If the commands are read from a file, run the following commands when one fails. The shell exits with the exit code of the last failing command")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ContinueOnError: flags.FlagToBoolValue(p, cmd, continueOnErrorFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model
+}
+
+type shell struct {
+	p *print.Printer
+	// Command tree used to complete the input and to find the commands that are run
+	root    *cobra.Command
+	execute Executor
+	// Global flags set with "use", by flag name
+	context map[string]string
+}
+
+func newShell(p *print.Printer, root *cobra.Command, execute Executor) *shell {
+	return &shell{
+		p:       p,
+		root:    root,
+		execute: execute,
+		context: map[string]string{},
+	}
+}
+
+// runScript runs the commands read from the input, one per line.
+// It stops at the first failing command, unless continueOnError is set, and returns its exit code in the error.
+func (s *shell) runScript(ctx context.Context, input io.Reader, continueOnError bool) error {
+	var failed *cliErr.ShellCommandExitError
+	scanner := bufio.NewScanner(input)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		exitCode, exit := s.runLine(ctx, scanner.Text())
+		if exitCode != 0 {
+			failed = &cliErr.ShellCommandExitError{Line: lineNumber, ExitCode: exitCode}
+			if !continueOnError {
+				return failed
+			}
+		}
+		if exit {
+			break
+		}
+	}
+	err := scanner.Err()
+	if err != nil {
+		return fmt.Errorf("read commands: %w", err)
+	}
+	if failed != nil {
+		return failed
+	}
+	return nil
+}
+
+// runInteractive prompts for commands on the terminal until the shell is ended
+func (s *shell) runInteractive(ctx context.Context, stdin *os.File, stdout io.Writer) error {
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{stdin, stdout}, prompt)
+	t.AutoCompleteCallback = s.autoComplete(t)
+
+	history := s.loadHistory()
+	for _, line := range history {
+		t.History.Add(line)
+	}
+	defer func() { s.saveHistory(history) }()
+
+	for {
+		t.SetPrompt(s.prompt())
+		line, err := readLine(stdin, t)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read command: %w", err)
+		}
+		if strings.TrimSpace(line) != "" {
+			history = append(history, line)
+		}
+		exitCode, exit := s.runLine(ctx, line)
+		if exitCode != 0 {
+			s.p.Info("Exit code: %d\n", exitCode)
+		}
+		if exit {
+			return nil
+		}
+	}
+}
+
+// readLine reads a line with the terminal in raw mode, for the line editing.
+// The commands are run with the terminal restored, so that they can print and prompt as usual.
+func readLine(stdin *os.File, t *term.Terminal) (string, error) {
+	state, err := term.MakeRaw(int(stdin.Fd()))
+	if err != nil {
+		return "", fmt.Errorf("set terminal to raw mode: %w", err)
+	}
+	defer term.Restore(int(stdin.Fd()), state) //nolint:errcheck // the terminal is left as it is if it can't be restored
+	return t.ReadLine()
+}
+
+// runLine runs the command of a line of input.
+// It returns the exit code of the command, and true if the shell should end.
+func (s *shell) runLine(ctx context.Context, line string) (exitCode int, exit bool) {
+	words, err := alias.SplitWords(line)
+	if err != nil {
+		s.p.Error("parse command: %v", err)
+		return cliErr.ExitCodeUsage, false
+	}
+	// Commands can be copied as they are, with the "stackit" prefix
+	if len(words) > 0 && words[0] == s.root.Name() {
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		return 0, false
+	}
+
+	switch words[0] {
+	case exitCommand, quitCommand:
+		return 0, true
+	case useCommand:
+		err := s.use(words[1:])
+		if err != nil {
+			s.p.Error("%v", err)
+			return cliErr.ExitCodeUsage, false
+		}
+		return 0, false
+	case "shell":
+		s.p.Error("already in an interactive shell")
+		return cliErr.ExitCodeUsage, false
+	}
+
+	// Ctrl+C interrupts the command, instead of ending the shell
+	cmdCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	exitCode = s.execute(cmdCtx, words, s.context)
+	stop()
+
+	if s.isReloadingCommand(words) {
+		s.reload()
+	}
+	return exitCode, false
+}
+
+// use sets the context of the following commands, or prints it when no arguments are given.
+// A context is unset by omitting its value.
+func (s *shell) use(args []string) error {
+	if len(args) == 0 {
+		for _, name := range []string{projectContext, regionContext} {
+			value, ok := s.context[contextFlags[name]]
+			if !ok {
+				value = "(not set, the configured value is used)"
+			}
+			s.p.Outputf("%s: %s\n", name, value)
+		}
+		return nil
+	}
+	if len(args) > 2 {
+		return fmt.Errorf(`usage: %s %s|%s [value]`, useCommand, projectContext, regionContext)
+	}
+
+	flag, ok := contextFlags[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown context %q, expected %q or %q", args[0], projectContext, regionContext)
+	}
+	if len(args) == 1 || args[1] == "" {
+		delete(s.context, flag)
+		return nil
+	}
+	value := args[1]
+	if flag == globalflags.ProjectIdFlag {
+		err := utils.ValidateUUID(value)
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+	}
+	s.context[flag] = value
+	return nil
+}
+
+// prompt returns the prompt of the shell, showing the context set with "use"
+func (s *shell) prompt() string {
+	values := []string{}
+	for _, name := range []string{projectContext, regionContext} {
+		if value, ok := s.context[contextFlags[name]]; ok {
+			values = append(values, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+	if len(values) == 0 {
+		return prompt
+	}
+	return fmt.Sprintf("stackit [%s]> ", strings.Join(values, " "))
+}
+
+func (s *shell) isReloadingCommand(words []string) bool {
+	cmd, _, err := s.root.Find(words)
+	if err != nil {
+		return false
+	}
+	path := strings.TrimPrefix(cmd.CommandPath(), s.root.Name()+" ")
+	for _, reloadingCommand := range reloadingCommands {
+		if path == reloadingCommand || strings.HasPrefix(path, reloadingCommand+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// reload reads the configuration of the active profile again and drops the state kept in the session
+func (s *shell) reload() {
+	s.p.Debug(print.DebugLevel, "reloading the configuration of the shell")
+	viper.Reset()
+	config.InitConfig()
+	session.Forget()
+}
+
+// autoComplete returns the callback completing the word before the cursor when the Tab key is pressed.
+// If there are several completions, they are completed up to their common prefix, or listed.
+func (s *shell) autoComplete(t *term.Terminal) func(line string, pos int, key rune) (string, int, bool) {
+	return func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		head := line[:pos]
+		wordStart := strings.LastIndexAny(head, " \t") + 1
+		word := head[wordStart:]
+
+		candidates := completions(s.root, strings.Fields(head[:wordStart]), word)
+		completed := word
+		switch len(candidates) {
+		case 0:
+			return line, pos, true
+		case 1:
+			completed = candidates[0] + " "
+		default:
+			completed = commonPrefix(candidates)
+			if completed == word {
+				_, _ = fmt.Fprintf(t, "%s\n", strings.Join(candidates, "  "))
+				return line, pos, true
+			}
+		}
+		head = head[:wordStart] + completed
+		return head + line[pos:], len(head), true
+	}
+}
+
+// completions returns the commands or flags starting with the word, that can follow the previous words
+func completions(root *cobra.Command, previous []string, word string) []string {
+	if len(previous) > 0 && previous[0] == root.Name() {
+		previous = previous[1:]
+	}
+
+	candidates := []string{}
+	if len(previous) > 0 && previous[0] == useCommand {
+		if len(previous) == 1 {
+			candidates = append(candidates, projectContext, regionContext)
+		}
+		return filterCandidates(candidates, word)
+	}
+
+	cmd := root
+	for _, w := range previous {
+		// Flags, flag values and arguments are skipped
+		for _, c := range cmd.Commands() {
+			if c.Name() == w || c.HasAlias(w) {
+				cmd = c
+				break
+			}
+		}
+	}
+
+	if strings.HasPrefix(word, "-") {
+		addFlag := func(f *pflag.Flag) {
+			if !f.Hidden {
+				candidates = append(candidates, "--"+f.Name)
+			}
+		}
+		cmd.LocalFlags().VisitAll(addFlag)
+		cmd.InheritedFlags().VisitAll(addFlag)
+		return filterCandidates(candidates, word)
+	}
+
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() {
+			candidates = append(candidates, c.Name())
+		}
+	}
+	if len(previous) == 0 {
+		candidates = append(candidates, useCommand, exitCommand)
+		for _, a := range alias.List() {
+			candidates = append(candidates, a.Name)
+		}
+	}
+	return filterCandidates(candidates, word)
+}
+
+// filterCandidates returns the candidates starting with the prefix, sorted and without duplicates
+func filterCandidates(candidates []string, prefix string) []string {
+	filtered := []string{}
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) && !seen[candidate] {
+			filtered = append(filtered, candidate)
+			seen[candidate] = true
+		}
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+func (s *shell) loadHistory() []string {
+	err := cache.Init()
+	if err != nil {
+		s.p.Debug(print.ErrorLevel, "initialize cache for the shell history: %v", err)
+		return nil
+	}
+	content, err := cache.GetObject(historyCacheIdentifier)
+	if err != nil {
+		s.p.Debug(print.DebugLevel, "read shell history: %v", err)
+		return nil
+	}
+	return strings.FieldsFunc(string(content), func(r rune) bool { return r == '\n' })
+}
+
+func (s *shell) saveHistory(history []string) {
+	if len(history) > historySize {
+		history = history[len(history)-historySize:]
+	}
+	redacted := make([]string, 0, len(history))
+	for _, line := range history {
+		if line, ok := s.redactLine(line); ok {
+			redacted = append(redacted, line)
+		}
+	}
+	err := cache.PutObject(historyCacheIdentifier, []byte(strings.Join(redacted, "\n")+"\n"))
+	if err != nil {
+		s.p.Debug(print.ErrorLevel, "write shell history: %v", err)
+	}
+}
+
+// redactLine replaces the values of the flags holding secrets in a line of the history, as the journal does.
+// Lines that can't be parsed are not kept, as the secrets in them can't be found.
+func (s *shell) redactLine(line string) (string, bool) {
+	words, err := alias.SplitWords(line)
+	if err != nil {
+		return "", false
+	}
+	args := words
+	if len(args) > 0 && args[0] == s.root.Name() {
+		args = args[1:]
+	}
+	cmd, _, err := s.root.Find(args)
+	if err != nil {
+		cmd = s.root
+	}
+	redacted := journal.RedactArgs(cmd, words)
+	if slices.Equal(redacted, words) {
+		return line, true
+	}
+	for i, word := range redacted {
+		redacted[i] = quoteWord(word)
+	}
+	return strings.Join(redacted, " "), true
+}
+
+// quoteWord quotes the word if needed, so that it is split as one word again
+func quoteWord(word string) string {
+	if word != "" && !strings.ContainsAny(word, " \t\n'\"\\") {
+		return word
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(word) + `"`
+}
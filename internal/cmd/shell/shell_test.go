@@ -0,0 +1,389 @@
+package shell
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+const testProjectId = "11111111-2222-3333-4444-555555555555"
+
+func fixtureRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "stackit"}
+	root.PersistentFlags().String(globalflags.ProjectIdFlag, "", "")
+	root.PersistentFlags().String(globalflags.RegionFlag, "", "")
+
+	noop := func(*cobra.Command, []string) {}
+	dns := &cobra.Command{Use: "dns", Run: noop}
+	zone := &cobra.Command{Use: "zone", Run: noop}
+	list := &cobra.Command{Use: "list", Run: noop}
+	list.Flags().Int("limit", 0, "")
+	create := &cobra.Command{Use: "create", Run: noop}
+	deprecated := &cobra.Command{Use: "describe", Deprecated: "use list", Run: noop}
+	zone.AddCommand(list, create, deprecated)
+	dns.AddCommand(zone)
+	root.AddCommand(dns, &cobra.Command{Use: "curl", Run: noop})
+	return root
+}
+
+// fixtureExitCode is the exit code of the commands run by the shell in the tests, "dns zone create" fails
+func fixtureExitCode(args []string) int {
+	if slices.Equal(args, []string{"dns", "zone", "create"}) {
+		return cliErr.ExitCodeNotFound
+	}
+	return 0
+}
+
+func TestRunLine(t *testing.T) {
+	tests := []struct {
+		description         string
+		lines               []string
+		expectedArgs        [][]string
+		expectedGlobalFlags []map[string]string
+		expectedExitCode    int
+		expectedExit        bool
+	}{
+		{
+			description:         "command",
+			lines:               []string{"dns zone list --limit 5"},
+			expectedArgs:        [][]string{{"dns", "zone", "list", "--limit", "5"}},
+			expectedGlobalFlags: []map[string]string{{}},
+		},
+		{
+			description:         "command with prefix and quotes",
+			lines:               []string{`stackit curl "https://example.com/a b"`},
+			expectedArgs:        [][]string{{"curl", "https://example.com/a b"}},
+			expectedGlobalFlags: []map[string]string{{}},
+		},
+		{
+			description: "empty lines",
+			lines:       []string{"", "  ", "stackit"},
+		},
+		{
+			description: "context",
+			lines: []string{
+				"use project " + testProjectId,
+				"use region eu02",
+				"dns zone list",
+				"use region",
+				"dns zone list",
+			},
+			expectedArgs: [][]string{{"dns", "zone", "list"}, {"dns", "zone", "list"}},
+			expectedGlobalFlags: []map[string]string{
+				{globalflags.ProjectIdFlag: testProjectId, globalflags.RegionFlag: "eu02"},
+				{globalflags.ProjectIdFlag: testProjectId},
+			},
+		},
+		{
+			description: "invalid context",
+			lines:       []string{"use project xxx", "use zone xxx", "use project xxx yyy", "dns zone list"},
+			expectedArgs: [][]string{
+				{"dns", "zone", "list"},
+			},
+			expectedGlobalFlags: []map[string]string{{}},
+		},
+		{
+			description:      "unterminated quote",
+			lines:            []string{`curl "https://example.com`},
+			expectedExitCode: cliErr.ExitCodeUsage,
+		},
+		{
+			description:      "nested shell",
+			lines:            []string{"shell"},
+			expectedExitCode: cliErr.ExitCodeUsage,
+		},
+		{
+			description:         "failing command",
+			lines:               []string{"dns zone create"},
+			expectedArgs:        [][]string{{"dns", "zone", "create"}},
+			expectedGlobalFlags: []map[string]string{{}},
+			expectedExitCode:    cliErr.ExitCodeNotFound,
+		},
+		{
+			description:  "exit",
+			lines:        []string{"exit"},
+			expectedExit: true,
+		},
+		{
+			description:  "quit",
+			lines:        []string{"quit"},
+			expectedExit: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			root := fixtureRootCmd()
+			p.Cmd = root
+
+			args := [][]string{}
+			globalFlags := []map[string]string{}
+			s := newShell(p, root, func(_ context.Context, a []string, g map[string]string) int {
+				args = append(args, a)
+				copied := map[string]string{}
+				for k, v := range g {
+					copied[k] = v
+				}
+				globalFlags = append(globalFlags, copied)
+				return fixtureExitCode(a)
+			})
+
+			exitCode := 0
+			exit := false
+			for _, line := range tt.lines {
+				exitCode, exit = s.runLine(context.Background(), line)
+			}
+
+			if exitCode != tt.expectedExitCode {
+				t.Errorf("expected exit code %d, got %d", tt.expectedExitCode, exitCode)
+			}
+			if exit != tt.expectedExit {
+				t.Errorf("expected exit to be %t, got %t", tt.expectedExit, exit)
+			}
+			if tt.expectedArgs == nil {
+				tt.expectedArgs = [][]string{}
+			}
+			if diff := cmp.Diff(args, tt.expectedArgs); diff != "" {
+				t.Errorf("unexpected arguments (-got +want):\n%s", diff)
+			}
+			if tt.expectedGlobalFlags == nil {
+				tt.expectedGlobalFlags = []map[string]string{}
+			}
+			if diff := cmp.Diff(globalFlags, tt.expectedGlobalFlags); diff != "" {
+				t.Errorf("unexpected global flags (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunScript(t *testing.T) {
+	tests := []struct {
+		description     string
+		script          string
+		continueOnError bool
+		expectedArgs    [][]string
+		isValid         bool
+		expectedErr     *cliErr.ShellCommandExitError
+	}{
+		{
+			description:  "succeeding commands",
+			script:       "dns zone list\n\ncurl https://example.com\n",
+			expectedArgs: [][]string{{"dns", "zone", "list"}, {"curl", "https://example.com"}},
+			isValid:      true,
+		},
+		{
+			description:  "exit",
+			script:       "dns zone list\nexit\ndns zone list\n",
+			expectedArgs: [][]string{{"dns", "zone", "list"}},
+			isValid:      true,
+		},
+		{
+			description:  "stops at failing command",
+			script:       "dns zone list\ndns zone create\ndns zone list\n",
+			expectedArgs: [][]string{{"dns", "zone", "list"}, {"dns", "zone", "create"}},
+			expectedErr:  &cliErr.ShellCommandExitError{Line: 2, ExitCode: cliErr.ExitCodeNotFound},
+		},
+		{
+			description:  "stops at invalid line",
+			script:       "use project xxx\ndns zone list\n",
+			expectedArgs: [][]string{},
+			expectedErr:  &cliErr.ShellCommandExitError{Line: 1, ExitCode: cliErr.ExitCodeUsage},
+		},
+		{
+			description:     "continue on error",
+			script:          "dns zone create\nuse project xxx\ndns zone list\n",
+			continueOnError: true,
+			expectedArgs:    [][]string{{"dns", "zone", "create"}, {"dns", "zone", "list"}},
+			expectedErr:     &cliErr.ShellCommandExitError{Line: 2, ExitCode: cliErr.ExitCodeUsage},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			root := fixtureRootCmd()
+			p.Cmd = root
+
+			args := [][]string{}
+			s := newShell(p, root, func(_ context.Context, a []string, _ map[string]string) int {
+				args = append(args, a)
+				return fixtureExitCode(a)
+			})
+
+			err := s.runScript(context.Background(), strings.NewReader(tt.script), tt.continueOnError)
+			if tt.isValid {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else {
+				var exitErr *cliErr.ShellCommandExitError
+				if !errors.As(err, &exitErr) {
+					t.Fatalf("expected shell command exit error, got %v", err)
+				}
+				if diff := cmp.Diff(exitErr, tt.expectedErr); diff != "" {
+					t.Errorf("unexpected error (-got +want):\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(args, tt.expectedArgs); diff != "" {
+				t.Errorf("unexpected arguments (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPrompt(t *testing.T) {
+	s := newShell(print.NewPrinter(), fixtureRootCmd(), nil)
+	if s.prompt() != prompt {
+		t.Errorf("expected prompt %q, got %q", prompt, s.prompt())
+	}
+	s.context[globalflags.RegionFlag] = "eu02"
+	expected := "stackit [region=eu02]> "
+	if s.prompt() != expected {
+		t.Errorf("expected prompt %q, got %q", expected, s.prompt())
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	tests := []struct {
+		description string
+		previous    []string
+		word        string
+		expected    []string
+	}{
+		{
+			description: "top level",
+			word:        "",
+			expected:    []string{"curl", "dns", "exit", "use"},
+		},
+		{
+			description: "top level with prefix",
+			previous:    []string{"stackit"},
+			word:        "d",
+			expected:    []string{"dns"},
+		},
+		{
+			description: "subcommands",
+			previous:    []string{"dns", "zone"},
+			word:        "",
+			expected:    []string{"create", "list"},
+		},
+		{
+			description: "flags",
+			previous:    []string{"dns", "zone", "list"},
+			word:        "--",
+			expected:    []string{"--limit", "--project-id", "--region"},
+		},
+		{
+			description: "flags after flags",
+			previous:    []string{"dns", "zone", "list", "--limit", "5"},
+			word:        "--re",
+			expected:    []string{"--region"},
+		},
+		{
+			description: "use",
+			previous:    []string{"use"},
+			word:        "",
+			expected:    []string{"project", "region"},
+		},
+		{
+			description: "use value",
+			previous:    []string{"use", "region"},
+			word:        "",
+			expected:    []string{},
+		},
+		{
+			description: "no match",
+			previous:    []string{"dns"},
+			word:        "x",
+			expected:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			completed := completions(fixtureRootCmd(), tt.previous, tt.word)
+			if diff := cmp.Diff(completed, tt.expected); diff != "" {
+				t.Errorf("unexpected completions (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		words    []string
+		expected string
+	}{
+		{[]string{"list"}, "list"},
+		{[]string{"describe", "delete"}, "de"},
+		{[]string{"--project-id", "--region"}, "--"},
+		{[]string{"a", "b"}, ""},
+	}
+	for _, tt := range tests {
+		if prefix := commonPrefix(tt.words); prefix != tt.expected {
+			t.Errorf("expected common prefix of %q to be %q, got %q", tt.words, tt.expected, prefix)
+		}
+	}
+}
+
+func TestRedactLine(t *testing.T) {
+	root := fixtureRootCmd()
+	create, _, err := root.Find([]string{"dns", "zone", "create"})
+	if err != nil {
+		t.Fatalf("find command: %v", err)
+	}
+	create.Flags().String("password", "", "")
+	create.Flags().Bool("show-password", false, "")
+	s := newShell(print.NewPrinter(), root, nil)
+
+	tests := []struct {
+		description  string
+		line         string
+		expectedLine string
+		expectedOk   bool
+	}{
+		{
+			description:  "no secrets",
+			line:         `dns zone list --limit  5`,
+			expectedLine: `dns zone list --limit  5`,
+			expectedOk:   true,
+		},
+		{
+			description:  "secret flag",
+			line:         `dns zone create --password "my secret" --name 'a b'`,
+			expectedLine: `dns zone create --password REDACTED --name "a b"`,
+			expectedOk:   true,
+		},
+		{
+			description:  "secret flag with value and prefix",
+			line:         `stackit dns zone create --password=secret --show-password`,
+			expectedLine: `stackit dns zone create --password=REDACTED --show-password`,
+			expectedOk:   true,
+		},
+		{
+			description: "unterminated quote",
+			line:        `dns zone create --password "secret`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			line, ok := s.redactLine(tt.line)
+			if ok != tt.expectedOk {
+				t.Fatalf("expected ok to be %t, got %t", tt.expectedOk, ok)
+			}
+			if line != tt.expectedLine {
+				t.Fatalf("expected line %q, got %q", tt.expectedLine, line)
+			}
+		})
+	}
+}
@@ -56,7 +56,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				`$ stackit ske cluster generate-payload --cluster-name my-cluster`),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
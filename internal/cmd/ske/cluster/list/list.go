@@ -8,6 +8,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -49,7 +50,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit ske cluster list --limit 10"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -84,6 +85,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			clusters := *resp.Items
 
+			clusters, err = filter.Items(model.Filter, clusters)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(clusters) > int(*model.Limit) {
 				clusters = clusters[:*model.Limit]
@@ -107,6 +112,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -137,6 +144,47 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClie
 	return req
 }
 
+// The creation time and egress address ranges are only displayed with "--columns wide"
+var clusterFields = []tables.Field[ske.Cluster]{
+	{Header: "NAME", Value: func(c *ske.Cluster) any { return utils.PtrString(c.Name) }},
+	{Header: "STATE", Value: func(c *ske.Cluster) any {
+		if c.HasStatus() {
+			return utils.PtrString(c.Status.Aggregated)
+		}
+		return ""
+	}},
+	{Header: "VERSION", Value: func(c *ske.Cluster) any {
+		if c.Kubernetes != nil {
+			return utils.PtrString(c.Kubernetes.Version)
+		}
+		return ""
+	}},
+	{Header: "POOLS", Value: func(c *ske.Cluster) any {
+		if c.Nodepools != nil {
+			return len(*c.Nodepools)
+		}
+		return 0
+	}},
+	{Header: "MONITORING", Value: func(c *ske.Cluster) any {
+		if c.Extensions != nil && c.Extensions.Observability != nil && *c.Extensions.Observability.Enabled {
+			return "Enabled"
+		}
+		return "Disabled"
+	}},
+	{Header: "CREATION TIME", Value: func(c *ske.Cluster) any {
+		if c.HasStatus() {
+			return utils.ConvertTimePToDateTimeString(c.Status.CreationTime)
+		}
+		return ""
+	}, Wide: true},
+	{Header: "EGRESS ADDRESS RANGES", Value: func(c *ske.Cluster) any {
+		if c.HasStatus() {
+			return utils.JoinStringPtr(c.Status.EgressAddressRanges, ", ")
+		}
+		return ""
+	}, Wide: true},
+}
+
 func outputResult(p *print.Printer, outputFormat, projectLabel string, clusters []ske.Cluster) error {
 	return p.OutputResult(outputFormat, clusters, func() error {
 		if len(clusters) == 0 {
@@ -144,34 +192,8 @@ func outputResult(p *print.Printer, outputFormat, projectLabel string, clusters
 			return nil
 		}
 
-		table := tables.NewTable()
-		table.SetHeader("NAME", "STATE", "VERSION", "POOLS", "MONITORING")
-		for i := range clusters {
-			c := clusters[i]
-			monitoring := "Disabled"
-			if c.Extensions != nil && c.Extensions.Observability != nil && *c.Extensions.Observability.Enabled {
-				monitoring = "Enabled"
-			}
-			statusAggregated, kubernetesVersion := "", ""
-			if c.HasStatus() {
-				statusAggregated = utils.PtrString(c.Status.Aggregated)
-			}
-			if c.Kubernetes != nil {
-				kubernetesVersion = utils.PtrString(c.Kubernetes.Version)
-			}
-			countNodepools := 0
-			if c.Nodepools != nil {
-				countNodepools = len(*c.Nodepools)
-			}
-			table.AddRow(
-				utils.PtrString(c.Name),
-				statusAggregated,
-				kubernetesVersion,
-				countNodepools,
-				monitoring,
-			)
-		}
-		err := table.Display(p)
+		table := tables.NewTableFromFields(clusterFields, clusters)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -34,7 +34,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit ske describe"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -89,7 +89,7 @@ func outputResult(p *print.Printer, outputFormat string, project *serviceenablem
 		if project.HasState() {
 			table.AddRow("STATE", utils.PtrString(project.State))
 		}
-		err := table.Display(p)
+		err := table.Display(p, outputFormat)
 		if err != nil {
 			return fmt.Errorf("render table: %w", err)
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
 	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
@@ -77,7 +78,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit ske kubeconfig create my-cluster --overwrite true"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -164,6 +165,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 		},
 	}
 	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
@@ -236,6 +238,13 @@ func buildRequestLogin(ctx context.Context, model *inputModel, apiClient *ske.AP
 }
 
 func outputResult(p *print.Printer, outputFormat, clusterName, kubeconfigPath string, respKubeconfig *ske.Kubeconfig, respLogin *ske.LoginKubeconfig) error {
+	if print.IsTemplateOutputFormat(outputFormat) {
+		if respKubeconfig != nil {
+			return p.OutputResult(outputFormat, respKubeconfig, nil)
+		}
+		return p.OutputResult(outputFormat, respLogin, nil)
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		var err error
@@ -57,7 +57,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ kubectl get pods"),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			if err := cache.Init(); err != nil {
 				return fmt.Errorf("cache init failed: %w", err)
@@ -58,7 +58,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit ske options --kubernetes-versions --machine-images"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -156,11 +156,11 @@ func outputResult(p *print.Printer, model *inputModel, options *ske.ProviderOpti
 	}
 
 	return p.OutputResult(model.OutputFormat, options, func() error {
-		return outputResultAsTable(p, options)
+		return outputResultAsTable(p, model.OutputFormat, options)
 	})
 }
 
-func outputResultAsTable(p *print.Printer, options *ske.ProviderOptions) error {
+func outputResultAsTable(p *print.Printer, outputFormat string, options *ske.ProviderOptions) error {
 	if options == nil {
 		return fmt.Errorf("options is nil")
 	}
@@ -190,7 +190,7 @@ func outputResultAsTable(p *print.Printer, options *ske.ProviderOptions) error {
 		content = append(content, buildVolumeTypesTable(options))
 	}
 
-	err := tables.DisplayTables(p, content)
+	err := tables.DisplayTables(p, content, outputFormat)
 	if err != nil {
 		return fmt.Errorf("display output: %w", err)
 	}
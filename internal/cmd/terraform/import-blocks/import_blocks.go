@@ -0,0 +1,124 @@
+package importblocks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/export"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	servicesFlag = "services"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Services []string
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-blocks",
+		Short: "Generates Terraform import blocks for the resources of a project",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Generates Terraform import blocks for the existing resources of a project, together with the matching resources of the STACKIT provider.",
+			"The resources use the project ID of the \"project_id\" variable, which is declared with the project ID as default.",
+			`Write the output into a file of a Terraform configuration and run "terraform plan" to check the imported resources (requires Terraform 1.5 or later).`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Generate import blocks for the resources of the project`,
+				"$ stackit terraform import-blocks --project-id xxx > imports.tf"),
+			examples.NewExample(
+				`Generate import blocks for the servers, volumes, networks and security groups of the project`,
+				"$ stackit terraform import-blocks --project-id xxx --services iaas > imports.tf"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			clients, err := export.ConfigureClients(params.Printer, params.CliVersion, model.Services)
+			if err != nil {
+				return err
+			}
+
+			s := spinner.New(params.Printer)
+			s.Start("Reading resources")
+			resources, warnings, err := export.Collect(ctx, clients, model.ProjectId, model.Region, model.Services)
+			if err != nil {
+				s.StopWithError()
+				return fmt.Errorf("read resources: %w", err)
+			}
+			s.Stop()
+			for _, warning := range warnings {
+				params.Printer.Warn("%s\n", warning)
+			}
+
+			configuration, err := buildConfiguration(model.ProjectId, resources)
+			if err != nil {
+				return fmt.Errorf("generate import blocks: %w", err)
+			}
+			params.Printer.Outputf("%s", configuration)
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	services := export.Services()
+	cmd.Flags().Var(flags.EnumSliceFlag(false, services, services...), servicesFlag, fmt.Sprintf("Services whose resources are imported, possible values are %q", services))
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Services:        utils.PtrValue(flags.FlagWithDefaultToStringSlicePointer(p, cmd, servicesFlag)),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// buildConfiguration returns the variable of the project ID, the import blocks and the resources, separated by empty lines
+func buildConfiguration(projectId string, resources []export.Resource) (string, error) {
+	blocks := []*terraform.Block{export.ProjectIdVariable(projectId)}
+	for i := range resources {
+		imports, err := resources[i].ImportBlocks()
+		if err != nil {
+			return "", err
+		}
+		blocks = append(blocks, imports...)
+	}
+	for i := range resources {
+		blocks = append(blocks, resources[i].Terraform...)
+	}
+
+	rendered := make([]string, len(blocks))
+	for i, b := range blocks {
+		rendered[i] = b.String()
+	}
+	return strings.Join(rendered, "\n"), nil
+}
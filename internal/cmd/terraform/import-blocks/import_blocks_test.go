@@ -0,0 +1,124 @@
+package importblocks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/export"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+var testProjectId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		projectIdFlag: testProjectId,
+		servicesFlag:  "iaas,ske",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Services: []string{export.ServiceIaaS, export.ServiceSKE},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "all services by default",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, servicesFlag)
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Services = export.Services()
+			}),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "invalid service",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[servicesFlag] = "unknown"
+			}),
+			isValid: false,
+		},
+		{
+			description: "arguments not allowed",
+			argValues:   []string{"server"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildConfiguration(t *testing.T) {
+	resources := []export.Resource{
+		{
+			Kind:      export.KindServer,
+			Name:      "web01",
+			Terraform: []*terraform.Block{terraform.NewResource("stackit_server", "web01").Set("project_id", terraform.Reference("var.project_id"))},
+			ImportIds: [][]string{{"project", "server"}},
+		},
+	}
+	configuration, err := buildConfiguration("project", resources)
+	if err != nil {
+		t.Fatalf("buildConfiguration() failed: %v", err)
+	}
+	expected := strings.Join([]string{
+		"variable \"project_id\" {\n  type    = string\n  default = \"project\"\n}\n",
+		"import {\n  to = stackit_server.web01\n  id = \"project,server\"\n}\n",
+		"resource \"stackit_server\" \"web01\" {\n  project_id = var.project_id\n}\n",
+	}, "\n")
+	diff := cmp.Diff(configuration, expected)
+	if diff != "" {
+		t.Fatalf("Configuration does not match: %s", diff)
+	}
+
+	resources[0].ImportIds = nil
+	_, err = buildConfiguration("project", resources)
+	if err == nil {
+		t.Fatalf("buildConfiguration() did not fail for a resource without import ID")
+	}
+}
@@ -0,0 +1,26 @@
+package terraform
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	importblocks "github.com/stackitcloud/stackit-cli/internal/cmd/terraform/import-blocks"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform",
+		Short: "Provides functionality for Terraform",
+		Long:  "Provides functionality for managing existing resources with Terraform and the STACKIT provider.",
+		Args:  args.NoArgs,
+		Run:   utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *params.CmdParams) {
+	cmd.AddCommand(importblocks.NewCmd(params))
+}
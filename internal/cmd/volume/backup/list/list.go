@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -52,7 +53,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit volume backup list --label-selector key1=value1,key2=value2"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -81,6 +82,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			}
 			backups := *resp.Items
 
+			backups, err = filter.Items(model.Filter, backups)
+			if err != nil {
+				return err
+			}
 			// Truncate output
 			if model.Limit != nil && len(backups) > int(*model.Limit) {
 				backups = backups[:*model.Limit]
@@ -97,6 +102,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter backups by labels")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -135,41 +142,41 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var backupFields = []tables.Field[iaas.Backup]{
+	{Header: "ID", Value: func(b *iaas.Backup) any { return utils.PtrString(b.Id) }},
+	{Header: "NAME", Value: func(b *iaas.Backup) any { return utils.PtrString(b.Name) }},
+	{Header: "SIZE", Value: func(b *iaas.Backup) any { return utils.PtrGigaByteSizeDefault(b.Size, "n/a") }},
+	{Header: "STATUS", Value: func(b *iaas.Backup) any { return utils.PtrString(b.Status) }},
+	{Header: "SNAPSHOT ID", Value: func(b *iaas.Backup) any { return utils.PtrString(b.SnapshotId) }},
+	{Header: "VOLUME ID", Value: func(b *iaas.Backup) any { return utils.PtrString(b.VolumeId) }},
+	{Header: "AVAILABILITY ZONE", Value: func(b *iaas.Backup) any { return utils.PtrString(b.AvailabilityZone) }},
+	{Header: "LABELS", Value: func(b *iaas.Backup) any {
+		if b.Labels == nil {
+			return ""
+		}
+		var labels []string
+		for key, value := range *b.Labels {
+			labels = append(labels, fmt.Sprintf("%s: %s", key, value))
+		}
+		return strings.Join(labels, ", ")
+	}},
+	{Header: "CREATED AT", Value: func(b *iaas.Backup) any { return utils.ConvertTimePToDateTimeString(b.CreatedAt) }},
+	{Header: "UPDATED AT", Value: func(b *iaas.Backup) any { return utils.ConvertTimePToDateTimeString(b.UpdatedAt) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, backups []iaas.Backup) error {
 	if backups == nil {
 		return fmt.Errorf("backups is empty")
 	}
 
 	return p.OutputResult(outputFormat, backups, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "SIZE", "STATUS", "SNAPSHOT ID", "VOLUME ID", "AVAILABILITY ZONE", "LABELS", "CREATED AT", "UPDATED AT")
-
-		for _, backup := range backups {
-			var labelsString string
-			if backup.Labels != nil {
-				var labels []string
-				for key, value := range *backup.Labels {
-					labels = append(labels, fmt.Sprintf("%s: %s", key, value))
-				}
-				labelsString = strings.Join(labels, ", ")
-			}
+		table := tables.NewTableFromFields(backupFields, backups)
+		table.EnableRowSeparators()
 
-			table.AddRow(
-				utils.PtrString(backup.Id),
-				utils.PtrString(backup.Name),
-				utils.PtrGigaByteSizeDefault(backup.Size, "n/a"),
-				utils.PtrString(backup.Status),
-				utils.PtrString(backup.SnapshotId),
-				utils.PtrString(backup.VolumeId),
-				utils.PtrString(backup.AvailabilityZone),
-				labelsString,
-				utils.ConvertTimePToDateTimeString(backup.CreatedAt),
-				utils.ConvertTimePToDateTimeString(backup.UpdatedAt),
-			)
-			table.AddSeparator()
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
-
-		p.Outputln(table.Render())
 		return nil
 	})
 }
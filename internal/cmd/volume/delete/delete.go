@@ -6,9 +6,11 @@ import (
 
 	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/bulk"
 	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
 	iaasUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/utils"
@@ -26,26 +28,33 @@ const (
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	VolumeId string
+	VolumeId      string
+	LabelSelector *string
+	Concurrency   int64
 }
 
 func NewCmd(params *params.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("delete %s", volumeIdArg),
 		Short: "Deletes a volume",
-		Long: fmt.Sprintf("%s\n%s\n",
+		Long: fmt.Sprintf("%s\n%s\n%s\n",
 			"Deletes a volume.",
 			"If the volume is still in use, the deletion will fail",
+			"Use --label-selector to delete all volumes matching the selector, after a single confirmation.",
 		),
-		Args: args.SingleArg(volumeIdArg, utils.ValidateUUID),
+		Args: args.SingleArgUnlessFlag(volumeIdArg, bulk.LabelSelectorFlag, utils.ValidateUUID),
 		Example: examples.Build(
 			examples.NewExample(
 				`Delete volume with ID "xxx"`,
 				"$ stackit volume delete xxx",
 			),
+			examples.NewExample(
+				`Delete all volumes with the label "env" set to "test", at most 10 at the same time`,
+				"$ stackit volume delete --label-selector env=test --concurrency 10",
+			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -57,6 +66,20 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return err
 			}
 
+			if model.LabelSelector != nil {
+				return bulk.RunSelected(ctx, params.Printer, model.GlobalFlagModel, bulk.Selection{
+					Action:        "delete",
+					Resource:      "volume",
+					Progress:      "Deleting",
+					LabelSelector: *model.LabelSelector,
+					Concurrency:   model.Concurrency,
+				}, func(ctx context.Context) ([]bulk.Item, error) {
+					return listVolumes(ctx, model, apiClient)
+				}, func(ctx context.Context, item bulk.Item) error {
+					return deleteVolume(ctx, model, apiClient, item)
+				})
+			}
+
 			volumeLabel, err := iaasUtils.GetVolumeName(ctx, apiClient, model.ProjectId, model.VolumeId)
 			if err != nil {
 				params.Printer.Debug(print.ErrorLevel, "get volume name: %v", err)
@@ -97,20 +120,36 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			return nil
 		},
 	}
+	configureFlags(cmd)
+	journal.Enable(cmd)
 	return cmd
 }
 
+func configureFlags(cmd *cobra.Command) {
+	bulk.ConfigureFlags(cmd, "delete", "volume")
+}
+
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
-	volumeId := inputArgs[0]
+	var volumeId string
+	if len(inputArgs) > 0 {
+		volumeId = inputArgs[0]
+	}
 
 	globalFlags := globalflags.Parse(p, cmd)
 	if globalFlags.ProjectId == "" {
 		return nil, &cliErr.ProjectIdError{}
 	}
 
+	labelSelector, concurrency, err := bulk.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
 		GlobalFlagModel: globalFlags,
 		VolumeId:        volumeId,
+		LabelSelector:   labelSelector,
+		Concurrency:     concurrency,
 	}
 
 	p.DebugInputModel(model)
@@ -120,3 +159,37 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiDeleteVolumeRequest {
 	return apiClient.DeleteVolume(ctx, model.ProjectId, model.VolumeId)
 }
+
+func buildListRequest(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) iaas.ApiListVolumesRequest {
+	return apiClient.ListVolumes(ctx, model.ProjectId).LabelSelector(*model.LabelSelector)
+}
+
+// listVolumes lists the volumes matching the label selector
+func listVolumes(ctx context.Context, model *inputModel, apiClient *iaas.APIClient) ([]bulk.Item, error) {
+	resp, err := buildListRequest(ctx, model, apiClient).Execute()
+	if err != nil {
+		return nil, err
+	}
+	items := []bulk.Item{}
+	for _, volume := range utils.GetSliceFromPointer(resp.Items) {
+		items = append(items, bulk.Item{Id: volume.GetId(), Name: volume.GetName()})
+	}
+	return items, nil
+}
+
+// deleteVolume deletes a volume matching the label selector
+func deleteVolume(ctx context.Context, model *inputModel, apiClient *iaas.APIClient, item bulk.Item) error {
+	err := apiClient.DeleteVolume(ctx, model.ProjectId, item.Id).Execute()
+	if err != nil {
+		return fmt.Errorf("delete volume: %w", err)
+	}
+	// Wait for async operation, if async mode not enabled
+	if model.Async {
+		return nil
+	}
+	_, err = wait.DeleteVolumeWaitHandler(ctx, apiClient, model.ProjectId, item.Id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for volume deletion: %w", err)
+	}
+	return nil
+}
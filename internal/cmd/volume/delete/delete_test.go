@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stackitcloud/stackit-cli/internal/pkg/bulk"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -48,7 +50,8 @@ func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 			Verbosity: globalflags.VerbosityDefault,
 			ProjectId: testProjectId,
 		},
-		VolumeId: testVolumeId,
+		VolumeId:    testVolumeId,
+		Concurrency: bulk.DefaultConcurrency,
 	}
 	for _, mod := range mods {
 		mod(model)
@@ -133,6 +136,28 @@ func TestParseInput(t *testing.T) {
 			flagValues:  fixtureFlagValues(),
 			isValid:     false,
 		},
+		{
+			description: "label selector",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[bulk.LabelSelectorFlag] = "env=test"
+				flagValues[bulk.ConcurrencyFlag] = "10"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.VolumeId = ""
+				model.LabelSelector = utils.Ptr("env=test")
+				model.Concurrency = 10
+			}),
+		},
+		{
+			description: "label selector with volume id",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[bulk.LabelSelectorFlag] = "env=test"
+			}),
+			isValid: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -169,3 +194,20 @@ func TestBuildRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildListRequest(t *testing.T) {
+	model := fixtureInputModel(func(model *inputModel) {
+		model.LabelSelector = utils.Ptr("env=test")
+	})
+	expectedRequest := testClient.ListVolumes(testCtx, testProjectId).LabelSelector("env=test")
+
+	request := buildListRequest(testCtx, model, testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -55,7 +56,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -84,8 +85,12 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			// Truncate output
 			items := *resp.Items
+			items, err = filter.Items(model.Filter, items)
+			if err != nil {
+				return err
+			}
+			// Truncate output
 			if model.Limit != nil && len(items) > int(*model.Limit) {
 				items = items[:*model.Limit]
 			}
@@ -100,6 +105,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter by label")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -135,25 +142,30 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+// The performance class, flags, description and creation date are only displayed with "--columns wide"
+var volumeFields = []tables.Field[iaas.Volume]{
+	{Header: "ID", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Id) }},
+	{Header: "Name", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Name) }},
+	{Header: "Status", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Status) }},
+	{Header: "Server", Value: func(v *iaas.Volume) any { return utils.PtrString(v.ServerId) }},
+	{Header: "Availability Zone", Value: func(v *iaas.Volume) any { return utils.PtrString(v.AvailabilityZone) }},
+	{Header: "Size (GB)", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Size) }},
+	{Header: "Performance Class", Value: func(v *iaas.Volume) any { return utils.PtrString(v.PerformanceClass) }, Wide: true},
+	{Header: "Bootable", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Bootable) }, Wide: true},
+	{Header: "Encrypted", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Encrypted) }, Wide: true},
+	{Header: "Description", Value: func(v *iaas.Volume) any { return utils.PtrString(v.Description) }, Wide: true},
+	{Header: "Created At", Value: func(v *iaas.Volume) any { return utils.ConvertTimePToDateTimeString(v.CreatedAt) }, Wide: true},
+}
+
 func outputResult(p *print.Printer, outputFormat string, volumes []iaas.Volume) error {
 	return p.OutputResult(outputFormat, volumes, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "Name", "Status", "Server", "Availability Zone", "Size (GB)")
-
-		for i := range volumes {
-			volume := volumes[i]
-			table.AddRow(
-				utils.PtrString(volume.Id),
-				utils.PtrString(volume.Name),
-				utils.PtrString(volume.Status),
-				utils.PtrString(volume.ServerId),
-				utils.PtrString(volume.AvailabilityZone),
-				utils.PtrString(volume.Size),
-			)
-			table.AddSeparator()
+		table := tables.NewTableFromFields(volumeFields, volumes)
+		table.EnableRowSeparators()
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
 		}
 
-		p.Outputln(table.Render())
 		return nil
 	})
 }
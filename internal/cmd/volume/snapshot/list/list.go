@@ -10,6 +10,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/filter"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
@@ -50,7 +51,7 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				"$ stackit volume snapshot list --label-selector key1=value1"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 			model, err := parseInput(params.Printer, cmd, args)
 			if err != nil {
 				return err
@@ -80,7 +81,10 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 				return nil
 			}
 
-			snapshots := *resp.Items
+			snapshots, err := filter.Items(model.Filter, *resp.Items)
+			if err != nil {
+				return err
+			}
 
 			// Apply limit if specified
 			if model.Limit != nil && int(*model.Limit) < len(snapshots) {
@@ -98,6 +102,8 @@ func NewCmd(params *params.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
 	cmd.Flags().String(labelSelectorFlag, "", "Filter snapshots by labels")
+	tables.EnableColumns(cmd)
+	filter.Enable(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
@@ -134,38 +140,39 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *iaas.APICli
 	return req
 }
 
+var snapshotFields = []tables.Field[iaas.Snapshot]{
+	{Header: "ID", Value: func(s *iaas.Snapshot) any { return utils.PtrString(s.Id) }},
+	{Header: "NAME", Value: func(s *iaas.Snapshot) any { return utils.PtrString(s.Name) }},
+	{Header: "SIZE", Value: func(s *iaas.Snapshot) any { return utils.PtrGigaByteSizeDefault(s.Size, "n/a") }},
+	{Header: "STATUS", Value: func(s *iaas.Snapshot) any { return utils.PtrString(s.Status) }},
+	{Header: "VOLUME ID", Value: func(s *iaas.Snapshot) any { return utils.PtrString(s.VolumeId) }},
+	{Header: "LABELS", Value: func(s *iaas.Snapshot) any {
+		if s.Labels == nil {
+			return ""
+		}
+		var labels []string
+		for key, value := range *s.Labels {
+			labels = append(labels, fmt.Sprintf("%s: %s", key, value))
+		}
+		return strings.Join(labels, "\n")
+	}},
+	{Header: "CREATED AT", Value: func(s *iaas.Snapshot) any { return utils.ConvertTimePToDateTimeString(s.CreatedAt) }},
+	{Header: "UPDATED AT", Value: func(s *iaas.Snapshot) any { return utils.ConvertTimePToDateTimeString(s.UpdatedAt) }},
+}
+
 func outputResult(p *print.Printer, outputFormat string, snapshots []iaas.Snapshot) error {
 	if snapshots == nil {
 		return fmt.Errorf("list snapshots response is empty")
 	}
 
 	return p.OutputResult(outputFormat, snapshots, func() error {
-		table := tables.NewTable()
-		table.SetHeader("ID", "NAME", "SIZE", "STATUS", "VOLUME ID", "LABELS", "CREATED AT", "UPDATED AT")
-
-		for _, snapshot := range snapshots {
-			var labelsString string
-			if snapshot.Labels != nil {
-				var labels []string
-				for key, value := range *snapshot.Labels {
-					labels = append(labels, fmt.Sprintf("%s: %s", key, value))
-				}
-				labelsString = strings.Join(labels, "\n")
-			}
-			table.AddRow(
-				utils.PtrString(snapshot.Id),
-				utils.PtrString(snapshot.Name),
-				utils.PtrGigaByteSizeDefault(snapshot.Size, "n/a"),
-				utils.PtrString(snapshot.Status),
-				utils.PtrString(snapshot.VolumeId),
-				labelsString,
-				utils.ConvertTimePToDateTimeString(snapshot.CreatedAt),
-				utils.ConvertTimePToDateTimeString(snapshot.UpdatedAt),
-			)
-			table.AddSeparator()
-		}
+		table := tables.NewTableFromFields(snapshotFields, snapshots)
+		table.EnableRowSeparators()
 
-		p.Outputln(table.Render())
+		err := table.Print(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
 		return nil
 	})
 }
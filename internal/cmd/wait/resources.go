@@ -0,0 +1,191 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	dnsClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/dns/client"
+	iaasClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
+	mongodbflexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	postgresflexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	skeClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	dnsWait "github.com/stackitcloud/stackit-sdk-go/services/dns/wait"
+	iaasWait "github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/mongodbflex"
+	mongodbflexWait "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/wait"
+	postgresflexWait "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+)
+
+// waitFunc waits until the resource with the given ID reaches the condition, or the timeout of the model expires
+type waitFunc func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error
+
+type resource struct {
+	// SDK wait handlers, by the (upper case) state they wait for
+	states map[string]waitFunc
+	// SDK wait handler for the deletion of the resource
+	deleted waitFunc
+}
+
+// resources holds the resources supported by the wait command, by service and resource type
+var resources = map[string]map[string]resource{
+	"dns": {
+		"zone": {
+			states: map[string]waitFunc{
+				string(dns.ZONESTATE_CREATE_SUCCEEDED): dnsWaitFunc(dnsWait.CreateZoneWaitHandler),
+				string(dns.ZONESTATE_UPDATE_SUCCEEDED): dnsWaitFunc(dnsWait.PartialUpdateZoneWaitHandler),
+			},
+			deleted: dnsWaitFunc(dnsWait.DeleteZoneWaitHandler),
+		},
+	},
+	"iaas": {
+		"backup": {
+			states: map[string]waitFunc{
+				iaasWait.BackupAvailableStatus: iaasWaitFunc(iaasWait.CreateBackupWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteBackupWaitHandler),
+		},
+		"image": {
+			states: map[string]waitFunc{
+				iaasWait.ImageAvailableStatus: iaasWaitFunc(iaasWait.UploadImageWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteImageWaitHandler),
+		},
+		"network": {
+			states: map[string]waitFunc{
+				iaasWait.CreateSuccess: iaasWaitFunc(iaasWait.CreateNetworkWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteNetworkWaitHandler),
+		},
+		"server": {
+			states: map[string]waitFunc{
+				iaasWait.ServerActiveStatus:      iaasWaitFunc(iaasWait.CreateServerWaitHandler),
+				iaasWait.ServerInactiveStatus:    iaasWaitFunc(iaasWait.StopServerWaitHandler),
+				iaasWait.ServerDeallocatedStatus: iaasWaitFunc(iaasWait.DeallocateServerWaitHandler),
+				iaasWait.ServerRescueStatus:      iaasWaitFunc(iaasWait.RescueServerWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteServerWaitHandler),
+		},
+		"snapshot": {
+			states: map[string]waitFunc{
+				iaasWait.SnapshotAvailableStatus: iaasWaitFunc(iaasWait.CreateSnapshotWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteSnapshotWaitHandler),
+		},
+		"volume": {
+			states: map[string]waitFunc{
+				iaasWait.VolumeAvailableStatus: iaasWaitFunc(iaasWait.CreateVolumeWaitHandler),
+			},
+			deleted: iaasWaitFunc(iaasWait.DeleteVolumeWaitHandler),
+		},
+	},
+	"mongodbflex": {
+		"instance": {
+			states: map[string]waitFunc{
+				string(mongodbflex.INSTANCESTATUS_READY): mongodbflexWaitFunc(mongodbflexWait.CreateInstanceWaitHandler),
+			},
+			deleted: mongodbflexWaitFunc(mongodbflexWait.DeleteInstanceWaitHandler),
+		},
+	},
+	"postgresflex": {
+		"instance": {
+			states: map[string]waitFunc{
+				strings.ToUpper(postgresflexWait.InstanceStateSuccess): postgresflexWaitFunc(postgresflexWait.CreateInstanceWaitHandler),
+			},
+			deleted: postgresflexWaitFunc(postgresflexWait.DeleteInstanceWaitHandler),
+		},
+	},
+	"ske": {
+		"cluster": {
+			states: map[string]waitFunc{
+				string(ske.CLUSTERSTATUSSTATE_HEALTHY):    skeWaitFunc(skeWait.CreateOrUpdateClusterWaitHandler),
+				string(ske.CLUSTERSTATUSSTATE_HIBERNATED): skeWaitFunc(skeWait.TriggerClusterHibernationWaitHandler),
+			},
+			deleted: skeWaitFunc(skeWait.DeleteClusterWaitHandler),
+		},
+	},
+}
+
+func getResource(service, resourceType string) (*resource, error) {
+	serviceResources, ok := resources[service]
+	if !ok {
+		return nil, fmt.Errorf("service %q is not supported, supported services are %q", service, sortedKeys(resources))
+	}
+	r, ok := serviceResources[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q is not supported for service %q, supported resource types are %q", resourceType, service, sortedKeys(serviceResources))
+	}
+	return &r, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func iaasWaitFunc[T any](handler func(ctx context.Context, a iaasWait.APIClientInterface, projectId, resourceId string) *sdkWait.AsyncActionHandler[T]) waitFunc {
+	return func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error {
+		apiClient, err := iaasClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		_, err = handler(ctx, apiClient, model.ProjectId, resourceId).SetTimeout(model.Timeout).WaitWithContext(ctx)
+		return err
+	}
+}
+
+func dnsWaitFunc[T any](handler func(ctx context.Context, a dnsWait.APIClientInterface, projectId, resourceId string) *sdkWait.AsyncActionHandler[T]) waitFunc {
+	return func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error {
+		apiClient, err := dnsClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		_, err = handler(ctx, apiClient, model.ProjectId, resourceId).SetTimeout(model.Timeout).WaitWithContext(ctx)
+		return err
+	}
+}
+
+func skeWaitFunc[T any](handler func(ctx context.Context, a skeWait.APIClientClusterInterface, projectId, region, resourceId string) *sdkWait.AsyncActionHandler[T]) waitFunc {
+	return func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error {
+		apiClient, err := skeClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		_, err = handler(ctx, apiClient, model.ProjectId, model.Region, resourceId).SetTimeout(model.Timeout).WaitWithContext(ctx)
+		return err
+	}
+}
+
+func postgresflexWaitFunc[T any](handler func(ctx context.Context, a postgresflexWait.APIClientInstanceInterface, projectId, region, resourceId string) *sdkWait.AsyncActionHandler[T]) waitFunc {
+	return func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error {
+		apiClient, err := postgresflexClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		_, err = handler(ctx, apiClient, model.ProjectId, model.Region, resourceId).SetTimeout(model.Timeout).WaitWithContext(ctx)
+		return err
+	}
+}
+
+// The MongoDB Flex wait handlers take the region after the instance ID
+func mongodbflexWaitFunc[T any](handler func(ctx context.Context, a mongodbflexWait.APIClientInstanceInterface, projectId, resourceId, region string) *sdkWait.AsyncActionHandler[T]) waitFunc {
+	return func(ctx context.Context, params *params.CmdParams, model *inputModel, resourceId string) error {
+		apiClient, err := mongodbflexClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		_, err = handler(ctx, apiClient, model.ProjectId, resourceId, model.Region).SetTimeout(model.Timeout).WaitWithContext(ctx)
+		return err
+	}
+}
@@ -0,0 +1,215 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	serviceArg      = "SERVICE"
+	resourceTypeArg = "RESOURCE_TYPE"
+	resourceIdArg   = "RESOURCE_ID"
+
+	forFlag = "for"
+
+	deletedCondition = "deleted"
+	stateCondition   = "state="
+
+	// Used if the timeout is 0, which doesn't limit other commands
+	timeoutDefault = 20 * time.Minute
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Service      string
+	ResourceType string
+	ResourceIds  []string
+	// State to wait for, in upper case. Empty if waiting for the deletion
+	State   string
+	Deleted bool
+	Timeout time.Duration
+}
+
+func NewCmd(params *params.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("wait %s %s %s [%s...]", serviceArg, resourceTypeArg, resourceIdArg, resourceIdArg),
+		Short: "Waits for resources to reach a state",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s",
+			"Waits for one or more resources to reach a state, or to be deleted.",
+			`This is useful together with the "--async" flag: operations can be triggered first and waited for later.`,
+			"If multiple resource IDs are provided, they are waited for concurrently and the command returns once all of them reached the state.",
+			fmt.Sprintf(`The wait is limited by the "--%s" flag. Unlike other commands, a timeout of 0 doesn't remove the limit but waits for at most %s, as resources may never reach the state.`, globalflags.TimeoutFlag, timeoutDefault),
+			fmt.Sprintf("Supported services and resource types:\n%s", supportedResourcesHelp()),
+		),
+		Args: args.MinimumArgs(serviceArg, resourceTypeArg, resourceIdArg),
+		Example: examples.Build(
+			examples.NewExample(
+				`Wait for the server with ID "xxx" to become active`,
+				"$ stackit wait iaas server xxx --for state=ACTIVE"),
+			examples.NewExample(
+				`Wait for the servers with IDs "xxx" and "yyy" to be deleted, for at most 10 minutes`,
+				"$ stackit wait iaas server xxx yyy --for deleted --timeout 10m"),
+			examples.NewExample(
+				`Wait for the SKE cluster with name "my-cluster" to become healthy`,
+				"$ stackit wait ske cluster my-cluster --for state=STATE_HEALTHY"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			r, err := getResource(model.Service, model.ResourceType)
+			if err != nil {
+				return err
+			}
+			waitForCondition := r.deleted
+			if !model.Deleted {
+				waitForCondition = r.states[model.State]
+			}
+
+			s := spinner.New(params.Printer)
+			s.Start(fmt.Sprintf("Waiting for %s %s", model.ResourceType, getConditionLabel(model)))
+			err = waitForAll(ctx, params, model, waitForCondition)
+			if err != nil {
+				s.StopWithError()
+				return err
+			}
+			s.Stop()
+
+			for _, resourceId := range model.ResourceIds {
+				if model.Deleted {
+					params.Printer.Info("The %s %q was deleted\n", model.ResourceType, resourceId)
+				} else {
+					params.Printer.Info("The %s %q reached state %q\n", model.ResourceType, resourceId, model.State)
+				}
+			}
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(forFlag, "", fmt.Sprintf(`Condition to wait for, either "%s<STATE>" or %q`, stateCondition, deletedCondition))
+
+	err := flags.MarkFlagsRequired(cmd, forFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Service:         inputArgs[0],
+		ResourceType:    inputArgs[1],
+		ResourceIds:     inputArgs[2:],
+		Timeout:         viper.GetDuration(config.TimeoutKey),
+	}
+	if model.Timeout < 0 {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    globalflags.TimeoutFlag,
+			Details: "must not be negative",
+		}
+	}
+	if model.Timeout == 0 {
+		model.Timeout = timeoutDefault
+	}
+
+	r, err := getResource(model.Service, model.ResourceType)
+	if err != nil {
+		return nil, &cliErr.ArgValidationError{
+			Arg:     fmt.Sprintf("%s %s", model.Service, model.ResourceType),
+			Details: err.Error(),
+		}
+	}
+
+	condition := flags.FlagToStringValue(p, cmd, forFlag)
+	switch {
+	case condition == deletedCondition:
+		model.Deleted = true
+	case strings.HasPrefix(condition, stateCondition):
+		model.State = strings.ToUpper(strings.TrimPrefix(condition, stateCondition))
+		if _, ok := r.states[model.State]; !ok {
+			return nil, &cliErr.FlagValidationError{
+				Flag:    forFlag,
+				Details: fmt.Sprintf("state %q is not supported for %s %s, supported states are %q", model.State, model.Service, model.ResourceType, sortedKeys(r.states)),
+			}
+		}
+	default:
+		return nil, &cliErr.FlagValidationError{
+			Flag:    forFlag,
+			Details: fmt.Sprintf(`must be either "%s<STATE>" or %q`, stateCondition, deletedCondition),
+		}
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// waitForAll waits for all resources of the model concurrently and returns the errors of all of them
+func waitForAll(ctx context.Context, params *params.CmdParams, model *inputModel, waitForCondition waitFunc) error {
+	// The waits of the SDK fail with an error that doesn't wrap the error of the context, so the deadline is checked afterwards
+	ctx, cancel := context.WithTimeout(ctx, model.Timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(model.ResourceIds))
+	for i, resourceId := range model.ResourceIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := waitForCondition(ctx, params, model, resourceId)
+			if err != nil {
+				errs[i] = fmt.Errorf("wait for %s %q: %w", model.ResourceType, resourceId, err)
+			}
+		}()
+	}
+	wg.Wait()
+	err := errors.Join(errs...)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &cliErr.TimeoutError{Err: err}
+	}
+	return err
+}
+
+func getConditionLabel(model *inputModel) string {
+	if model.Deleted {
+		return "deletion"
+	}
+	return fmt.Sprintf("state %q", model.State)
+}
+
+func supportedResourcesHelp() string {
+	var lines []string
+	for _, service := range sortedKeys(resources) {
+		for _, resourceType := range sortedKeys(resources[service]) {
+			states := sortedKeys(resources[service][resourceType].states)
+			lines = append(lines, fmt.Sprintf("  %s %s: states %s", service, resourceType, strings.Join(states, ", ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,318 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/params"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+
+	"github.com/google/uuid"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+var testProjectId = uuid.NewString()
+var testServerId = uuid.NewString()
+var testServerId2 = uuid.NewString()
+
+func fixtureArgValues(mods ...func(argValues []string) []string) []string {
+	argValues := []string{
+		"iaas",
+		"server",
+		testServerId,
+	}
+	for _, mod := range mods {
+		argValues = mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		projectIdFlag: testProjectId,
+		forFlag:       "state=ACTIVE",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Service:      "iaas",
+		ResourceType: "server",
+		ResourceIds:  []string{testServerId},
+		State:        "ACTIVE",
+		Timeout:      timeoutDefault,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "multiple resource ids",
+			argValues: fixtureArgValues(func(argValues []string) []string {
+				return append(argValues, testServerId2)
+			}),
+			flagValues: fixtureFlagValues(),
+			isValid:    true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ResourceIds = []string{testServerId, testServerId2}
+			}),
+		},
+		{
+			description: "wait for deletion",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[forFlag] = "deleted"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.State = ""
+				model.Deleted = true
+			}),
+		},
+		{
+			description: "state is case insensitive",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[forFlag] = "state=inactive"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.State = "INACTIVE"
+			}),
+		},
+		{
+			description: "with timeout",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[globalflags.TimeoutFlag] = "5m"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Timeout = 5 * time.Minute
+			}),
+		},
+		{
+			description: "timeout zero",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[globalflags.TimeoutFlag] = "0s"
+			}),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "timeout invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[globalflags.TimeoutFlag] = "-1m"
+			}),
+			isValid: false,
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "resource id missing",
+			argValues:   []string{"iaas", "server"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "for missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, forFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "for invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[forFlag] = "ACTIVE"
+			}),
+			isValid: false,
+		},
+		{
+			description: "state not supported",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[forFlag] = "state=AVAILABLE"
+			}),
+			isValid: false,
+		},
+		{
+			description: "service not supported",
+			argValues: fixtureArgValues(func(argValues []string) []string {
+				argValues[0] = "unknown"
+				return argValues
+			}),
+			flagValues: fixtureFlagValues(),
+			isValid:    false,
+		},
+		{
+			description: "resource type not supported",
+			argValues: fixtureArgValues(func(argValues []string) []string {
+				argValues[1] = "unknown"
+				return argValues
+			}),
+			flagValues: fixtureFlagValues(),
+			isValid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestResources(t *testing.T) {
+	for service, serviceResources := range resources {
+		for resourceType, r := range serviceResources {
+			if r.deleted == nil {
+				t.Errorf("%s %s: wait handler for deletion is missing", service, resourceType)
+			}
+			for state, f := range r.states {
+				if state != strings.ToUpper(state) {
+					t.Errorf("%s %s: state %q is not in upper case", service, resourceType, state)
+				}
+				if f == nil {
+					t.Errorf("%s %s: wait handler for state %q is missing", service, resourceType, state)
+				}
+			}
+		}
+	}
+}
+
+func TestWaitForAll(t *testing.T) {
+	tests := []struct {
+		description string
+		resourceIds []string
+		failingIds  []string
+		isValid     bool
+	}{
+		{
+			description: "base",
+			resourceIds: []string{testServerId},
+			isValid:     true,
+		},
+		{
+			description: "multiple resources",
+			resourceIds: []string{testServerId, testServerId2},
+			isValid:     true,
+		},
+		{
+			description: "one resource fails",
+			resourceIds: []string{testServerId, testServerId2},
+			failingIds:  []string{testServerId2},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := fixtureInputModel(func(model *inputModel) {
+				model.ResourceIds = tt.resourceIds
+			})
+
+			waited := make(chan string, len(tt.resourceIds))
+			waitForCondition := func(_ context.Context, _ *params.CmdParams, _ *inputModel, resourceId string) error {
+				waited <- resourceId
+				for _, id := range tt.failingIds {
+					if id == resourceId {
+						return fmt.Errorf("timed out")
+					}
+				}
+				return nil
+			}
+
+			err := waitForAll(context.Background(), &params.CmdParams{Printer: print.NewPrinter()}, model, waitForCondition)
+			close(waited)
+
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				for _, id := range tt.failingIds {
+					if !strings.Contains(err.Error(), id) {
+						t.Fatalf("error %q does not mention resource %q", err.Error(), id)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			count := 0
+			for range waited {
+				count++
+			}
+			if count != len(tt.resourceIds) {
+				t.Fatalf("waited for %d resources, expected %d", count, len(tt.resourceIds))
+			}
+		})
+	}
+}
+
+func TestWaitForAllTimeout(t *testing.T) {
+	model := fixtureInputModel(func(model *inputModel) {
+		model.Timeout = time.Millisecond
+	})
+	// Like the waits of the SDK, the error doesn't wrap the error of the context
+	waitForCondition := func(ctx context.Context, _ *params.CmdParams, _ *inputModel, _ string) error {
+		<-ctx.Done()
+		return fmt.Errorf("WaitWithContext() has timed out")
+	}
+
+	err := waitForAll(context.Background(), &params.CmdParams{Printer: print.NewPrinter()}, model, waitForCondition)
+	var timeoutErr *cliErr.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
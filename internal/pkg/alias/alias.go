@@ -0,0 +1,186 @@
+package alias
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+
+	"github.com/spf13/viper"
+)
+
+// Alias names are stored as keys of the profile's config file, which are case-insensitive and use "." as separator
+var nameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Positional parameters, such as $1, in the expansion of an alias
+var parameterRegex = regexp.MustCompile(`\$(\d+)`)
+
+type Alias struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+// ValidateName returns an error if the name can't be used for an alias
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("must start with a lowercase letter and only contain lowercase letters, digits and dashes")
+	}
+	return nil
+}
+
+// List returns the aliases of the active profile, sorted by name
+func List() []Alias {
+	aliases := []Alias{}
+	for name, expansion := range viper.GetStringMapString(config.AliasesKey) {
+		aliases = append(aliases, Alias{Name: name, Expansion: expansion})
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i].Name < aliases[j].Name
+	})
+	return aliases
+}
+
+// Get returns the expansion of the alias, and whether it exists
+func Get(name string) (string, bool) {
+	if ValidateName(name) != nil {
+		return "", false
+	}
+	expansion := viper.GetString(key(name))
+	return expansion, expansion != ""
+}
+
+// Set stores the alias in the config of the active profile
+func Set(name, expansion string) error {
+	viper.Set(key(name), expansion)
+	err := config.Write()
+	if err != nil {
+		return fmt.Errorf("write config to file: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the alias from the config of the active profile
+func Delete(name string) error {
+	aliases := viper.GetStringMap(config.AliasesKey)
+	delete(aliases, name)
+	viper.Set(config.AliasesKey, aliases)
+	err := config.Write()
+	if err != nil {
+		return fmt.Errorf("write config to file: %w", err)
+	}
+	return nil
+}
+
+func key(name string) string {
+	return fmt.Sprintf("%s.%s", config.AliasesKey, name)
+}
+
+// Expand returns the arguments the alias stands for. Positional parameters ($1, $2, ...) in the expansion
+// are replaced with the arguments given to the alias, the arguments not referred to are appended.
+func Expand(expansion string, args []string) ([]string, error) {
+	words, err := SplitWords(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("parse the expansion of the alias: %w", err)
+	}
+
+	used, err := countParameters(words)
+	if err != nil {
+		return nil, err
+	}
+	if used > len(args) {
+		return nil, fmt.Errorf("the alias expects %d arguments, got %d", used, len(args))
+	}
+
+	expanded := make([]string, 0, len(words)+len(args)-used)
+	for _, word := range words {
+		expanded = append(expanded, parameterRegex.ReplaceAllStringFunc(word, func(parameter string) string {
+			index, _ := strconv.Atoi(parameter[1:])
+			return args[index-1]
+		}))
+	}
+	return append(expanded, args[used:]...), nil
+}
+
+// ValidateExpansion returns an error if the expansion of an alias can't be parsed or has invalid positional parameters
+func ValidateExpansion(expansion string) error {
+	words, err := SplitWords(expansion)
+	if err != nil {
+		return err
+	}
+	_, err = countParameters(words)
+	return err
+}
+
+// Returns the number of positional parameters in the words of an expansion.
+// The parameters must be numbered from $1 without gaps, so that no argument given to the alias is dropped
+func countParameters(words []string) (int, error) {
+	referenced := map[int]bool{}
+	count := 0
+	for _, word := range words {
+		for _, match := range parameterRegex.FindAllStringSubmatch(word, -1) {
+			index, err := strconv.Atoi(match[1])
+			if err != nil || index == 0 {
+				return 0, fmt.Errorf("the expansion of the alias has an invalid parameter %q", match[0])
+			}
+			referenced[index] = true
+			count = max(count, index)
+		}
+	}
+	for i := 1; i <= count; i++ {
+		if !referenced[i] {
+			return 0, fmt.Errorf("the expansion of the alias has the parameter $%d, but not $%d", count, i)
+		}
+	}
+	return count, nil
+}
+
+// SplitWords splits the expansion of an alias into arguments, as a shell would.
+// Words are separated by whitespace, unless it is quoted with single or double quotes or escaped with a backslash.
+func SplitWords(s string) ([]string, error) {
+	words := []string{}
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %q", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("unterminated escape")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
@@ -0,0 +1,147 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/viper"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		description  string
+		expansion    string
+		args         []string
+		isValid      bool
+		expectedArgs []string
+	}{
+		{
+			description:  "no parameters",
+			expansion:    "ske cluster list",
+			isValid:      true,
+			expectedArgs: []string{"ske", "cluster", "list"},
+		},
+		{
+			description:  "arguments are appended",
+			expansion:    "ske cluster list",
+			args:         []string{"--project-id", "xxx"},
+			isValid:      true,
+			expectedArgs: []string{"ske", "cluster", "list", "--project-id", "xxx"},
+		},
+		{
+			description:  "parameter",
+			expansion:    "ske kubeconfig create $1 --login --overwrite",
+			args:         []string{"my-cluster"},
+			isValid:      true,
+			expectedArgs: []string{"ske", "kubeconfig", "create", "my-cluster", "--login", "--overwrite"},
+		},
+		{
+			description:  "parameters in words and remaining arguments",
+			expansion:    "dns zone create --name=$2 --dns-name $1",
+			args:         []string{"example.com", "zone", "--async"},
+			isValid:      true,
+			expectedArgs: []string{"dns", "zone", "create", "--name=zone", "--dns-name", "example.com", "--async"},
+		},
+		{
+			description:  "quoted words",
+			expansion:    `server command create --script 'echo "hello world"' --name it\'s`,
+			isValid:      true,
+			expectedArgs: []string{"server", "command", "create", "--script", `echo "hello world"`, "--name", "it's"},
+		},
+		{
+			description: "missing argument",
+			expansion:   "ske kubeconfig create $1 --cluster $2",
+			args:        []string{"my-cluster"},
+			isValid:     false,
+		},
+		{
+			description: "skipped parameter",
+			expansion:   "ske kubeconfig create $2",
+			args:        []string{"my-cluster", "--login"},
+			isValid:     false,
+		},
+		{
+			description: "invalid parameter",
+			expansion:   "ske kubeconfig create $0",
+			args:        []string{"my-cluster"},
+			isValid:     false,
+		},
+		{
+			description: "unterminated quote",
+			expansion:   "ske cluster describe 'my-cluster",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			args, err := Expand(tt.expansion, tt.args)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(args, tt.expectedArgs)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAndList(t *testing.T) {
+	defer viper.Reset()
+	viper.Set(config.AliasesKey, map[string]any{
+		"kc":    "ske kubeconfig create $1 --login",
+		"zones": "dns zone list",
+	})
+
+	expected := []Alias{
+		{Name: "kc", Expansion: "ske kubeconfig create $1 --login"},
+		{Name: "zones", Expansion: "dns zone list"},
+	}
+	diff := cmp.Diff(List(), expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	tests := []struct {
+		description       string
+		name              string
+		expectedFound     bool
+		expectedExpansion string
+	}{
+		{
+			description:       "existing",
+			name:              "kc",
+			expectedFound:     true,
+			expectedExpansion: "ske kubeconfig create $1 --login",
+		},
+		{
+			description: "not existing",
+			name:        "other",
+		},
+		{
+			description: "invalid name",
+			name:        "kc.x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			expansion, found := Get(tt.name)
+			if found != tt.expectedFound {
+				t.Errorf("expected found to be %t, got %t", tt.expectedFound, found)
+			}
+			if expansion != tt.expectedExpansion {
+				t.Errorf("expected expansion %q, got %q", tt.expectedExpansion, expansion)
+			}
+		})
+	}
+}
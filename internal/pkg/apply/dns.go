@@ -0,0 +1,181 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns/wait"
+)
+
+const (
+	defaultRecordSetType    = "A"
+	dnsDeleteSucceededState = "DELETE_SUCCEEDED"
+	dnsPageSize             = 1000
+)
+
+// id identifies the record set in the manifest, as record sets with the same name can have different types
+func (rs *DNSRecordSet) id() string {
+	if rs.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s %s in zone %s", rs.Name, rs.recordType(), rs.Zone)
+}
+
+func (rs *DNSRecordSet) recordType() string {
+	if rs.Type == "" {
+		return defaultRecordSetType
+	}
+	return strings.ToUpper(rs.Type)
+}
+
+// fqdn returns the fully qualified name of the record set in the zone with the DNS name
+func (rs *DNSRecordSet) fqdn(zoneDnsName string) string {
+	if strings.HasSuffix(rs.Name, ".") {
+		return rs.Name
+	}
+	zoneDnsName = trimDot(zoneDnsName)
+	if rs.Name == "@" || rs.Name == zoneDnsName {
+		return zoneDnsName + "."
+	}
+	return rs.Name + "." + zoneDnsName + "."
+}
+
+func (pl *planner) planDNSRecordSets(ctx context.Context, m *Manifest) error {
+	for i := range m.DNSRecordSets {
+		desired := &m.DNSRecordSets[i]
+		zone, err := pl.zoneReference(desired.Zone)
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", kindDNSRecordSet, desired.Name, err)
+		}
+		if zone.isDeleted {
+			if isAbsent(desired.State) {
+				continue
+			}
+			return fmt.Errorf("%s %q: DNS zone %q is referenced, but deleted by the manifest", kindDNSRecordSet, desired.Name, desired.Zone)
+		}
+		name := desired.fqdn(zone.dnsName)
+		displayName := fmt.Sprintf("%s %s", name, desired.recordType())
+
+		live := []dns.RecordSet{}
+		if !zone.isNew {
+			recordSets, err := pl.clients.DNS.ListRecordSets(ctx, pl.projectId, zone.id()).
+				NameEq(name).TypeEq(desired.recordType()).StateNeq(dnsDeleteSucceededState).Execute()
+			if err != nil {
+				return fmt.Errorf("get DNS record sets: %w", err)
+			}
+			for _, rs := range utils.GetSliceFromPointer(recordSets.RrSets) {
+				if rs.GetName() == name && string(rs.GetType()) == desired.recordType() {
+					live = append(live, rs)
+				}
+			}
+		}
+		if len(live) > 1 {
+			ids := []string{}
+			for j := range live {
+				ids = append(ids, live[j].GetId())
+			}
+			return fmt.Errorf("%s %q: found %d record sets with the name and type: %s", kindDNSRecordSet, displayName, len(live), strings.Join(ids, ", "))
+		}
+
+		switch {
+		case isAbsent(desired.State):
+			if len(live) == 1 {
+				id := live[0].GetId()
+				pl.delete(kindDNSRecordSet, displayName, id, func(ctx context.Context) error {
+					return pl.deleteRecordSet(ctx, zone.id(), id)
+				})
+			}
+		case len(live) == 0:
+			pl.create(kindDNSRecordSet, displayName, recordSetChanges(desired, nil), func(ctx context.Context) (string, error) {
+				return pl.createRecordSet(ctx, zone.id(), name, desired)
+			})
+		default:
+			id := live[0].GetId()
+			pl.update(kindDNSRecordSet, displayName, id, recordSetChanges(desired, &live[0]), func(ctx context.Context) error {
+				return pl.updateRecordSet(ctx, zone.id(), id, desired)
+			})
+		}
+	}
+	return nil
+}
+
+// recordSetChanges returns the changes of the declared record set compared to the live one, which is nil if it doesn't exist
+func recordSetChanges(desired *DNSRecordSet, live *dns.RecordSet) []Change {
+	if live == nil {
+		changes := changeInt(nil, "ttl", nil, desired.TTL)
+		changes = changeSet(changes, "records", nil, desired.Records)
+		return changeString(changes, "comment", "", desired.Comment)
+	}
+	liveRecords := []string{}
+	for _, r := range utils.GetSliceFromPointer(live.Records) {
+		liveRecords = append(liveRecords, r.GetContent())
+	}
+	changes := changeInt(nil, "ttl", live.Ttl, desired.TTL)
+	changes = changeSet(changes, "records", liveRecords, desired.Records)
+	if desired.Comment != "" {
+		changes = changeString(changes, "comment", live.GetComment(), desired.Comment)
+	}
+	return changes
+}
+
+func recordPayloads(records []string) *[]dns.RecordPayload {
+	if records == nil {
+		return nil
+	}
+	payloads := []dns.RecordPayload{}
+	for _, r := range records {
+		payloads = append(payloads, dns.RecordPayload{Content: utils.Ptr(r)})
+	}
+	return &payloads
+}
+
+func (pl *planner) createRecordSet(ctx context.Context, zoneId, name string, desired *DNSRecordSet) (string, error) {
+	resp, err := pl.clients.DNS.CreateRecordSet(ctx, pl.projectId, zoneId).CreateRecordSetPayload(dns.CreateRecordSetPayload{
+		Name:    utils.Ptr(name),
+		Type:    utils.Ptr(dns.CreateRecordSetPayloadTypes(desired.recordType())),
+		Ttl:     desired.TTL,
+		Records: recordPayloads(desired.Records),
+		Comment: optionalString(desired.Comment),
+	}).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create DNS record set: %w", err)
+	}
+	id := resp.Rrset.GetId()
+	_, err = wait.CreateRecordSetWaitHandler(ctx, pl.clients.DNS, pl.projectId, zoneId, id).WaitWithContext(ctx)
+	if err != nil {
+		return id, fmt.Errorf("wait for DNS record set creation: %w", err)
+	}
+	return id, nil
+}
+
+func (pl *planner) updateRecordSet(ctx context.Context, zoneId, id string, desired *DNSRecordSet) error {
+	_, err := pl.clients.DNS.PartialUpdateRecordSet(ctx, pl.projectId, zoneId, id).PartialUpdateRecordSetPayload(dns.PartialUpdateRecordSetPayload{
+		Ttl:     desired.TTL,
+		Records: recordPayloads(desired.Records),
+		Comment: optionalString(desired.Comment),
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("update DNS record set: %w", err)
+	}
+	_, err = wait.PartialUpdateRecordSetWaitHandler(ctx, pl.clients.DNS, pl.projectId, zoneId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for DNS record set update: %w", err)
+	}
+	return nil
+}
+
+func (pl *planner) deleteRecordSet(ctx context.Context, zoneId, id string) error {
+	_, err := pl.clients.DNS.DeleteRecordSet(ctx, pl.projectId, zoneId, id).Execute()
+	if err != nil {
+		return fmt.Errorf("delete DNS record set: %w", err)
+	}
+	_, err = wait.DeleteRecordSetWaitHandler(ctx, pl.clients.DNS, pl.projectId, zoneId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for DNS record set deletion: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestFqdn(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     string
+		expected string
+	}{
+		{name: "www", zone: "example.com", expected: "www.example.com."},
+		{name: "www", zone: "example.com.", expected: "www.example.com."},
+		{name: "www.other.com.", zone: "example.com", expected: "www.other.com."},
+		{name: "@", zone: "example.com", expected: "example.com."},
+		{name: "example.com", zone: "example.com", expected: "example.com."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" in "+tt.zone, func(t *testing.T) {
+			rs := &DNSRecordSet{Name: tt.name}
+			if got := rs.fqdn(tt.zone); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRecordSetChanges(t *testing.T) {
+	live := &dns.RecordSet{
+		Ttl:     utils.Ptr(int64(3600)),
+		Records: &[]dns.Record{{Content: utils.Ptr("1.2.3.4")}},
+	}
+	tests := []struct {
+		description string
+		desired     *DNSRecordSet
+		live        *dns.RecordSet
+		expected    []Change
+	}{
+		{
+			description: "create",
+			desired:     &DNSRecordSet{Name: "www", Records: []string{"1.2.3.4"}},
+			expected:    []Change{{Field: "records", Old: "[]", New: "[1.2.3.4]"}},
+		},
+		{
+			description: "no changes",
+			desired:     &DNSRecordSet{Name: "www", TTL: utils.Ptr(int64(3600)), Records: []string{"1.2.3.4"}},
+			live:        live,
+		},
+		{
+			description: "records and comment changed",
+			desired:     &DNSRecordSet{Name: "www", Records: []string{"1.2.3.4", "5.6.7.8"}, Comment: "web"},
+			live:        live,
+			expected: []Change{
+				{Field: "records", Old: "[1.2.3.4]", New: "[1.2.3.4, 5.6.7.8]"},
+				{Field: "comment", Old: `""`, New: `"web"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes := recordSetChanges(tt.desired, tt.live)
+			if diff := cmp.Diff(changes, tt.expected); diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns/wait"
+)
+
+const defaultZoneType = "primary"
+
+// zoneReference is a zone referenced by record sets
+type zoneReference struct {
+	id      func() string
+	dnsName string
+	// The zone is created by the plan, so it has no record sets yet
+	isNew bool
+	// The zone is deleted by the plan, together with its record sets
+	isDeleted bool
+}
+
+func (pl *planner) planDNSZones(ctx context.Context, m *Manifest) error {
+	// Record sets may reference zones which aren't declared
+	if len(m.DNSZones) == 0 && len(m.DNSRecordSets) == 0 {
+		return nil
+	}
+	resp, err := pl.clients.DNS.ListZones(ctx, pl.projectId).StateNeq(dnsDeleteSucceededState).PageSize(dnsPageSize).Execute()
+	if err != nil {
+		return fmt.Errorf("get DNS zones: %w", err)
+	}
+	pl.liveZones = utils.GetSliceFromPointer(resp.Zones)
+	live := map[string]*dns.Zone{}
+	names := []string{}
+	ids := []string{}
+	for i := range pl.liveZones {
+		live[pl.liveZones[i].GetId()] = &pl.liveZones[i]
+		names = append(names, pl.liveZones[i].GetName())
+		ids = append(ids, pl.liveZones[i].GetId())
+	}
+	declared := []string{}
+	for i := range m.DNSZones {
+		declared = append(declared, m.DNSZones[i].Name)
+	}
+	liveZones, err := liveIds(kindDNSZone, names, ids, declared)
+	if err != nil {
+		return err
+	}
+
+	for i := range m.DNSZones {
+		desired := &m.DNSZones[i]
+		id, exists := liveZones[desired.Name]
+		switch {
+		case isAbsent(desired.State):
+			if exists {
+				pl.delete(kindDNSZone, desired.Name, id, func(ctx context.Context) error {
+					return pl.deleteZone(ctx, id)
+				})
+			}
+		case !exists:
+			if desired.DNSName == "" {
+				return fmt.Errorf("%s %q: dnsName is required to create it", kindDNSZone, desired.Name)
+			}
+			pl.newZones = append(pl.newZones, desired)
+			changes, _ := zoneChanges(desired, nil)
+			pl.create(kindDNSZone, desired.Name, changes, func(ctx context.Context) (string, error) {
+				return pl.createZone(ctx, desired)
+			})
+		default:
+			pl.ids[resourceKey(kindDNSZone, desired.Name)] = id
+			changes, err := zoneChanges(desired, live[id])
+			if err != nil {
+				return err
+			}
+			pl.update(kindDNSZone, desired.Name, id, changes, func(ctx context.Context) error {
+				return pl.updateZone(ctx, id, desired)
+			})
+		}
+	}
+	return nil
+}
+
+// zoneReference returns the zone with the ID, name or DNS name, which is either created by the plan or exists in the project
+func (pl *planner) zoneReference(zone string) (*zoneReference, error) {
+	for _, z := range pl.newZones {
+		if z.Name == zone || trimDot(z.DNSName) == trimDot(zone) {
+			key := resourceKey(kindDNSZone, z.Name)
+			return &zoneReference{id: func() string { return pl.ids[key] }, dnsName: z.DNSName, isNew: true}, nil
+		}
+	}
+	live, err := findZone(pl.liveZones, zone)
+	if err != nil {
+		return nil, err
+	}
+	id := live.GetId()
+	return &zoneReference{
+		id:        func() string { return id },
+		dnsName:   live.GetDnsName(),
+		isDeleted: pl.deleted[resourceKey(kindDNSZone, live.GetName())],
+	}, nil
+}
+
+// findZone returns the zone with the ID, name or DNS name
+func findZone(zones []dns.Zone, zone string) (*dns.Zone, error) {
+	matches := []*dns.Zone{}
+	for i := range zones {
+		if zones[i].GetId() == zone {
+			return &zones[i], nil
+		}
+		if zones[i].GetName() == zone || trimDot(zones[i].GetDnsName()) == trimDot(zone) {
+			matches = append(matches, &zones[i])
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("DNS zone %q is referenced, but neither declared in the manifest nor found in the project", zone)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("DNS zone %q is ambiguous, use its ID instead", zone)
+	}
+}
+
+func trimDot(dnsName string) string {
+	return strings.TrimSuffix(dnsName, ".")
+}
+
+// zoneChanges returns the changes of the declared zone compared to the live one, which is nil if it doesn't exist
+func zoneChanges(desired *DNSZone, live *dns.Zone) ([]Change, error) {
+	if live == nil {
+		changes := changeString(nil, "dnsName", "", desired.DNSName)
+		changes = changeString(changes, "type", "", valueOrDefault(desired.Type, defaultZoneType))
+		changes = changeString(changes, "description", "", desired.Description)
+		changes = changeString(changes, "contactEmail", "", desired.ContactEmail)
+		changes = changeInt(changes, "defaultTTL", nil, desired.DefaultTTL)
+		changes = changeString(changes, "acl", "", desired.ACL)
+		return changeSet(changes, "primaries", nil, desired.Primaries), nil
+	}
+
+	if desired.DNSName != "" && trimDot(desired.DNSName) != trimDot(live.GetDnsName()) {
+		return nil, immutableError(kindDNSZone, desired.Name, "dnsName")
+	}
+	if desired.Type != "" && desired.Type != string(live.GetType()) {
+		return nil, immutableError(kindDNSZone, desired.Name, "type")
+	}
+	var changes []Change
+	if desired.Description != "" {
+		changes = changeString(changes, "description", live.GetDescription(), desired.Description)
+	}
+	if desired.ContactEmail != "" {
+		changes = changeString(changes, "contactEmail", live.GetContactEmail(), desired.ContactEmail)
+	}
+	changes = changeInt(changes, "defaultTTL", live.DefaultTTL, desired.DefaultTTL)
+	if desired.ACL != "" {
+		changes = changeString(changes, "acl", live.GetAcl(), desired.ACL)
+	}
+	return changeSet(changes, "primaries", utils.GetSliceFromPointer(live.Primaries), desired.Primaries), nil
+}
+
+func (pl *planner) createZone(ctx context.Context, desired *DNSZone) (string, error) {
+	payload := dns.CreateZonePayload{
+		Name:         utils.Ptr(desired.Name),
+		DnsName:      utils.Ptr(desired.DNSName),
+		Description:  optionalString(desired.Description),
+		ContactEmail: optionalString(desired.ContactEmail),
+		DefaultTTL:   desired.DefaultTTL,
+		Acl:          optionalString(desired.ACL),
+		Primaries:    optionalSlice(desired.Primaries),
+	}
+	if desired.Type != "" {
+		payload.Type = utils.Ptr(dns.CreateZonePayloadTypes(desired.Type))
+	}
+	resp, err := pl.clients.DNS.CreateZone(ctx, pl.projectId).CreateZonePayload(payload).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create DNS zone: %w", err)
+	}
+	id := resp.Zone.GetId()
+	_, err = wait.CreateZoneWaitHandler(ctx, pl.clients.DNS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return id, fmt.Errorf("wait for DNS zone creation: %w", err)
+	}
+	return id, nil
+}
+
+func (pl *planner) updateZone(ctx context.Context, id string, desired *DNSZone) error {
+	_, err := pl.clients.DNS.PartialUpdateZone(ctx, pl.projectId, id).PartialUpdateZonePayload(dns.PartialUpdateZonePayload{
+		Description:  optionalString(desired.Description),
+		ContactEmail: optionalString(desired.ContactEmail),
+		DefaultTTL:   desired.DefaultTTL,
+		Acl:          optionalString(desired.ACL),
+		Primaries:    optionalSlice(desired.Primaries),
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("update DNS zone: %w", err)
+	}
+	_, err = wait.PartialUpdateZoneWaitHandler(ctx, pl.clients.DNS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for DNS zone update: %w", err)
+	}
+	return nil
+}
+
+func (pl *planner) deleteZone(ctx context.Context, id string) error {
+	_, err := pl.clients.DNS.DeleteZone(ctx, pl.projectId, id).Execute()
+	if err != nil {
+		return fmt.Errorf("delete DNS zone: %w", err)
+	}
+	_, err = wait.DeleteZoneWaitHandler(ctx, pl.clients.DNS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for DNS zone deletion: %w", err)
+	}
+	return nil
+}
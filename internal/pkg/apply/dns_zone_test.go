@@ -0,0 +1,122 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestFindZone(t *testing.T) {
+	zones := []dns.Zone{
+		{Id: utils.Ptr("zone-1"), Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com")},
+		{Id: utils.Ptr("zone-2"), Name: utils.Ptr("dup"), DnsName: utils.Ptr("a.com")},
+		{Id: utils.Ptr("zone-3"), Name: utils.Ptr("dup"), DnsName: utils.Ptr("b.com")},
+	}
+	tests := []struct {
+		zone       string
+		isValid    bool
+		expectedId string
+	}{
+		{zone: "zone-1", isValid: true, expectedId: "zone-1"},
+		{zone: "example", isValid: true, expectedId: "zone-1"},
+		{zone: "example.com.", isValid: true, expectedId: "zone-1"},
+		{zone: "dup", isValid: false},
+		{zone: "missing", isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			zone, err := findZone(zones, tt.zone)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if zone.GetId() != tt.expectedId {
+				t.Fatalf("expected zone %q, got %q", tt.expectedId, zone.GetId())
+			}
+		})
+	}
+}
+
+func TestZoneChanges(t *testing.T) {
+	live := &dns.Zone{
+		DnsName:     utils.Ptr("example.com"),
+		Type:        utils.Ptr(dns.ZoneTypes("primary")),
+		Description: utils.Ptr("old"),
+		DefaultTTL:  utils.Ptr(int64(3600)),
+	}
+	tests := []struct {
+		description     string
+		desired         *DNSZone
+		live            *dns.Zone
+		isValid         bool
+		expectedChanges []Change
+	}{
+		{
+			description: "create",
+			desired:     &DNSZone{Name: "example", DNSName: "example.com", DefaultTTL: utils.Ptr(int64(60))},
+			isValid:     true,
+			expectedChanges: []Change{
+				{Field: "dnsName", Old: `""`, New: `"example.com"`},
+				{Field: "type", Old: `""`, New: `"primary"`},
+				{Field: "defaultTTL", Old: "-", New: "60"},
+			},
+		},
+		{
+			description:     "no changes",
+			desired:         &DNSZone{Name: "example", DNSName: "example.com.", Type: "primary"},
+			live:            live,
+			isValid:         true,
+			expectedChanges: nil,
+		},
+		{
+			description: "update",
+			desired:     &DNSZone{Name: "example", Description: "new", DefaultTTL: utils.Ptr(int64(60))},
+			live:        live,
+			isValid:     true,
+			expectedChanges: []Change{
+				{Field: "description", Old: `"old"`, New: `"new"`},
+				{Field: "defaultTTL", Old: "3600", New: "60"},
+			},
+		},
+		{
+			description: "dns name changed",
+			desired:     &DNSZone{Name: "example", DNSName: "example.org"},
+			live:        live,
+			isValid:     false,
+		},
+		{
+			description: "type changed",
+			desired:     &DNSZone{Name: "example", Type: "secondary"},
+			live:        live,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes, err := zoneChanges(tt.desired, tt.live)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(changes, tt.expectedChanges)
+			if diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
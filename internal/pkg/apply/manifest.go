@@ -0,0 +1,258 @@
+package apply
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+// States of a resource declared in a manifest
+const (
+	StatePresent = "present"
+	StateAbsent  = "absent"
+)
+
+// Manifest declares resources of a project. Declared resources are created if they don't exist and updated
+// if they differ, resources with the "absent" state are deleted. Resources are identified by their name,
+// other resources of the project are left as they are.
+//
+// Fields that are left out are not managed: they are set to their defaults on creation and not changed afterwards.
+type Manifest struct {
+	Networks              []Network              `yaml:"networks,omitempty"`
+	SecurityGroups        []SecurityGroup        `yaml:"securityGroups,omitempty"`
+	Servers               []Server               `yaml:"servers,omitempty"`
+	DNSZones              []DNSZone              `yaml:"dnsZones,omitempty"`
+	DNSRecordSets         []DNSRecordSet         `yaml:"dnsRecordSets,omitempty"`
+	PostgresFlexInstances []PostgresFlexInstance `yaml:"postgresflexInstances,omitempty"`
+}
+
+type Network struct {
+	Name            string            `yaml:"name"`
+	State           string            `yaml:"state,omitempty"`
+	IPv4Prefix      string            `yaml:"ipv4Prefix,omitempty"`
+	IPv4Nameservers []string          `yaml:"ipv4Nameservers,omitempty"`
+	Labels          map[string]string `yaml:"labels,omitempty"`
+}
+
+type SecurityGroup struct {
+	Name        string            `yaml:"name"`
+	State       string            `yaml:"state,omitempty"`
+	Description string            `yaml:"description,omitempty"`
+	Stateful    *bool             `yaml:"stateful,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	// If set, these are the only rules of the security group, including the default egress rules
+	Rules []SecurityGroupRule `yaml:"rules,omitempty"`
+}
+
+type SecurityGroupRule struct {
+	// "ingress" or "egress"
+	Direction string `yaml:"direction"`
+	// "IPv4" or "IPv6", defaults to "IPv4"
+	EtherType string `yaml:"etherType,omitempty"`
+	// Protocol name, e.g. "tcp". Any protocol if left out
+	Protocol string `yaml:"protocol,omitempty"`
+	// Single port or range of ports, e.g. "22" or "8000-8080"
+	Ports       string `yaml:"ports,omitempty"`
+	IPRange     string `yaml:"ipRange,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type Server struct {
+	Name             string `yaml:"name"`
+	State            string `yaml:"state,omitempty"`
+	MachineType      string `yaml:"machineType,omitempty"`
+	AvailabilityZone string `yaml:"availabilityZone,omitempty"`
+	ImageId          string `yaml:"imageId,omitempty"`
+	// Size of the boot volume created from the image, in GB
+	BootVolumeSize *int64 `yaml:"bootVolumeSize,omitempty"`
+	KeypairName    string `yaml:"keypairName,omitempty"`
+	// Name of a network of the manifest or ID of a network
+	Network string `yaml:"network,omitempty"`
+	// Names of security groups of the manifest or IDs of security groups
+	SecurityGroups []string          `yaml:"securityGroups,omitempty"`
+	Labels         map[string]string `yaml:"labels,omitempty"`
+}
+
+type DNSZone struct {
+	Name  string `yaml:"name"`
+	State string `yaml:"state,omitempty"`
+	// Domain of the zone, e.g. "example.com"
+	DNSName      string `yaml:"dnsName,omitempty"`
+	Description  string `yaml:"description,omitempty"`
+	ContactEmail string `yaml:"contactEmail,omitempty"`
+	DefaultTTL   *int64 `yaml:"defaultTTL,omitempty"`
+	// Comma-separated IP ranges allowed to query the zone
+	ACL string `yaml:"acl,omitempty"`
+	// "primary" or "secondary", defaults to "primary"
+	Type string `yaml:"type,omitempty"`
+	// Primary name servers of a secondary zone
+	Primaries []string `yaml:"primaries,omitempty"`
+}
+
+type DNSRecordSet struct {
+	// Name, DNS name or ID of the zone, which is either declared in the manifest or exists in the project
+	Zone string `yaml:"zone"`
+	// Name of the record set, relative to the zone or fully qualified with a trailing dot
+	Name    string   `yaml:"name"`
+	State   string   `yaml:"state,omitempty"`
+	Type    string   `yaml:"type,omitempty"`
+	TTL     *int64   `yaml:"ttl,omitempty"`
+	Records []string `yaml:"records,omitempty"`
+	Comment string   `yaml:"comment,omitempty"`
+}
+
+type PostgresFlexInstance struct {
+	Name           string   `yaml:"name"`
+	State          string   `yaml:"state,omitempty"`
+	FlavorId       string   `yaml:"flavorId,omitempty"`
+	CPU            *int64   `yaml:"cpu,omitempty"`
+	RAM            *int64   `yaml:"ram,omitempty"`
+	StorageClass   string   `yaml:"storageClass,omitempty"`
+	StorageSize    *int64   `yaml:"storageSize,omitempty"`
+	Version        string   `yaml:"version,omitempty"`
+	Type           string   `yaml:"type,omitempty"`
+	ACL            []string `yaml:"acl,omitempty"`
+	BackupSchedule string   `yaml:"backupSchedule,omitempty"`
+}
+
+// Load reads and validates the manifest in the file. If the path is a directory, the manifests in its YAML files
+// are merged, e.g. the ones written by "stackit project export".
+func Load(path string) (*Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	if !info.IsDir() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest: %w", err)
+		}
+		return Parse(content)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifests: %w", err)
+	}
+	merged := &Manifest{}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read manifest: %w", err)
+		}
+		manifest := &Manifest{}
+		err = yaml.UnmarshalWithOptions(content, manifest, yaml.Strict())
+		if err != nil {
+			return nil, fmt.Errorf("parse manifest %s: %s", entry.Name(), yaml.FormatError(err, false, true))
+		}
+		merged.merge(manifest)
+	}
+	err = merged.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return merged, nil
+}
+
+// Parse parses and validates the YAML content of a manifest
+func Parse(content []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+	err := yaml.UnmarshalWithOptions(content, manifest, yaml.Strict())
+	if err != nil {
+		return nil, fmt.Errorf("parse manifest: %s", yaml.FormatError(err, false, true))
+	}
+	err = manifest.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+func (m *Manifest) merge(other *Manifest) {
+	m.Networks = append(m.Networks, other.Networks...)
+	m.SecurityGroups = append(m.SecurityGroups, other.SecurityGroups...)
+	m.Servers = append(m.Servers, other.Servers...)
+	m.DNSZones = append(m.DNSZones, other.DNSZones...)
+	m.DNSRecordSets = append(m.DNSRecordSets, other.DNSRecordSets...)
+	m.PostgresFlexInstances = append(m.PostgresFlexInstances, other.PostgresFlexInstances...)
+}
+
+func (m *Manifest) validate() error {
+	names := map[string]bool{}
+	check := func(kind, name, state string) error {
+		if name == "" {
+			return fmt.Errorf("a %s has no name", kind)
+		}
+		if state != "" && state != StatePresent && state != StateAbsent {
+			return fmt.Errorf("%s %q: state must be %q or %q, got %q", kind, name, StatePresent, StateAbsent, state)
+		}
+		key := kind + "/" + name
+		if names[key] {
+			return fmt.Errorf("%s %q is declared more than once", kind, name)
+		}
+		names[key] = true
+		return nil
+	}
+
+	for i := range m.Networks {
+		n := &m.Networks[i]
+		if err := check(kindNetwork, n.Name, n.State); err != nil {
+			return err
+		}
+	}
+	for i := range m.SecurityGroups {
+		sg := &m.SecurityGroups[i]
+		if err := check(kindSecurityGroup, sg.Name, sg.State); err != nil {
+			return err
+		}
+		for _, rule := range sg.Rules {
+			if _, err := rule.key(); err != nil {
+				return fmt.Errorf("%s %q: %w", kindSecurityGroup, sg.Name, err)
+			}
+		}
+	}
+	for i := range m.Servers {
+		s := &m.Servers[i]
+		if err := check(kindServer, s.Name, s.State); err != nil {
+			return err
+		}
+	}
+	for i := range m.DNSZones {
+		zone := &m.DNSZones[i]
+		if err := check(kindDNSZone, zone.Name, zone.State); err != nil {
+			return err
+		}
+		if zone.Type != "" && !dns.CreateZonePayloadTypes(zone.Type).IsValid() {
+			return fmt.Errorf("%s %q: invalid type %q", kindDNSZone, zone.Name, zone.Type)
+		}
+	}
+	for i := range m.DNSRecordSets {
+		rs := &m.DNSRecordSets[i]
+		if rs.Zone == "" {
+			return fmt.Errorf("%s %q has no zone", kindDNSRecordSet, rs.Name)
+		}
+		if err := check(kindDNSRecordSet, rs.id(), rs.State); err != nil {
+			return err
+		}
+		if !dns.CreateRecordSetPayloadTypes(rs.recordType()).IsValid() {
+			return fmt.Errorf("%s %q: invalid type %q", kindDNSRecordSet, rs.Name, rs.Type)
+		}
+	}
+	for i := range m.PostgresFlexInstances {
+		instance := &m.PostgresFlexInstances[i]
+		if err := check(kindPostgresFlexInstance, instance.Name, instance.State); err != nil {
+			return err
+		}
+		if instance.FlavorId != "" && (instance.CPU != nil || instance.RAM != nil) {
+			return fmt.Errorf("%s %q: either flavorId or cpu and ram can be set", kindPostgresFlexInstance, instance.Name)
+		}
+	}
+	return nil
+}
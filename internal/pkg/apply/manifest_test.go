@@ -0,0 +1,229 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testManifest = `
+networks:
+  - name: net
+    ipv4Prefix: 10.0.0.0/24
+    labels:
+      env: dev
+securityGroups:
+  - name: web
+    rules:
+      - direction: ingress
+        protocol: tcp
+        ports: "443"
+servers:
+  - name: vm
+    machineType: g1.1
+    imageId: image
+    network: net
+    securityGroups: [web]
+dnsRecordSets:
+  - zone: example.com
+    name: www
+    records: [1.2.3.4]
+postgresflexInstances:
+  - name: db
+    state: absent
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		description string
+		content     string
+		isValid     bool
+		expected    *Manifest
+	}{
+		{
+			description: "base",
+			content:     testManifest,
+			isValid:     true,
+			expected: &Manifest{
+				Networks: []Network{
+					{Name: "net", IPv4Prefix: "10.0.0.0/24", Labels: map[string]string{"env": "dev"}},
+				},
+				SecurityGroups: []SecurityGroup{
+					{Name: "web", Rules: []SecurityGroupRule{{Direction: "ingress", Protocol: "tcp", Ports: "443"}}},
+				},
+				Servers: []Server{
+					{Name: "vm", MachineType: "g1.1", ImageId: "image", Network: "net", SecurityGroups: []string{"web"}},
+				},
+				DNSRecordSets: []DNSRecordSet{
+					{Zone: "example.com", Name: "www", Records: []string{"1.2.3.4"}},
+				},
+				PostgresFlexInstances: []PostgresFlexInstance{
+					{Name: "db", State: StateAbsent},
+				},
+			},
+		},
+		{
+			description: "empty",
+			content:     "",
+			isValid:     true,
+			expected:    &Manifest{},
+		},
+		{
+			description: "unknown field",
+			content:     "networks:\n  - name: net\n    prefix: 10.0.0.0/24\n",
+			isValid:     false,
+		},
+		{
+			description: "unknown kind",
+			content:     "volumes:\n  - name: vol\n",
+			isValid:     false,
+		},
+		{
+			description: "name missing",
+			content:     "networks:\n  - ipv4Prefix: 10.0.0.0/24\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid state",
+			content:     "networks:\n  - name: net\n    state: deleted\n",
+			isValid:     false,
+		},
+		{
+			description: "declared twice",
+			content:     "servers:\n  - name: vm\n  - name: vm\n",
+			isValid:     false,
+		},
+		{
+			description: "same name for different kinds",
+			content:     "networks:\n  - name: app\nsecurityGroups:\n  - name: app\n",
+			isValid:     true,
+			expected: &Manifest{
+				Networks:       []Network{{Name: "app"}},
+				SecurityGroups: []SecurityGroup{{Name: "app"}},
+			},
+		},
+		{
+			description: "invalid rule direction",
+			content:     "securityGroups:\n  - name: web\n    rules:\n      - direction: in\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid rule ports",
+			content:     "securityGroups:\n  - name: web\n    rules:\n      - direction: ingress\n        ports: 443-80\n",
+			isValid:     false,
+		},
+		{
+			description: "record set zone missing",
+			content:     "dnsRecordSets:\n  - name: www\n",
+			isValid:     false,
+		},
+		{
+			description: "record sets with different types",
+			content:     "dnsRecordSets:\n  - zone: z\n    name: www\n  - zone: z\n    name: www\n    type: AAAA\n",
+			isValid:     true,
+			expected: &Manifest{
+				DNSRecordSets: []DNSRecordSet{{Zone: "z", Name: "www"}, {Zone: "z", Name: "www", Type: "AAAA"}},
+			},
+		},
+		{
+			description: "record set declared twice",
+			content:     "dnsRecordSets:\n  - zone: z\n    name: www\n  - zone: z\n    name: www\n    type: a\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid record set type",
+			content:     "dnsRecordSets:\n  - zone: z\n    name: www\n    type: XYZ\n",
+			isValid:     false,
+		},
+		{
+			description: "flavor id with cpu",
+			content:     "postgresflexInstances:\n  - name: db\n    flavorId: xxx\n    cpu: 2\n",
+			isValid:     false,
+		},
+		{
+			description: "postgresflex instance with cpu and ram",
+			content:     "postgresflexInstances:\n  - name: db\n    cpu: 2\n    ram: 4\n",
+			isValid:     true,
+			expected: &Manifest{
+				PostgresFlexInstances: []PostgresFlexInstance{{Name: "db", CPU: utils.Ptr(int64(2)), RAM: utils.Ptr(int64(4))}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			manifest, err := Parse([]byte(tt.content))
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(manifest, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "env.yaml")
+	err := os.WriteFile(path, []byte(testManifest), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	manifest, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if len(manifest.Servers) != 1 {
+		t.Fatalf("expected 1 server, got %d", len(manifest.Servers))
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"network.yaml": "networks:\n  - name: net\n",
+		"server.yml":   "servers:\n  - name: vm\n",
+		"notes.txt":    "not a manifest",
+	}
+	for name, content := range files {
+		err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+		if err != nil {
+			t.Fatalf("write manifest: %v", err)
+		}
+	}
+	manifest, err = Load(dir)
+	if err != nil {
+		t.Fatalf("Load() failed for a directory: %v", err)
+	}
+	if len(manifest.Networks) != 1 || len(manifest.Servers) != 1 {
+		t.Fatalf("expected the manifests of the directory to be merged, got %+v", manifest)
+	}
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err == nil {
+		t.Fatalf("Load() did not fail for a missing file")
+	}
+}
+
+func TestServices(t *testing.T) {
+	manifest, err := Parse([]byte(testManifest))
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	expected := Services{IaaS: true, DNS: true, PostgresFlex: true}
+	if diff := cmp.Diff(manifest.Services(), expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if diff := cmp.Diff((&Manifest{}).Services(), Services{}); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -0,0 +1,159 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+)
+
+func (pl *planner) planNetworks(ctx context.Context, m *Manifest) error {
+	// Servers may reference networks which aren't declared
+	if len(m.Networks) == 0 && len(m.Servers) == 0 {
+		return nil
+	}
+	resp, err := pl.clients.IaaS.ListNetworksExecute(ctx, pl.projectId)
+	if err != nil {
+		return fmt.Errorf("get networks: %w", err)
+	}
+	networks := utils.GetSliceFromPointer(resp.Items)
+	live := map[string]*iaas.Network{}
+	names := []string{}
+	ids := []string{}
+	for i := range networks {
+		live[networks[i].GetNetworkId()] = &networks[i]
+		names = append(names, networks[i].GetName())
+		ids = append(ids, networks[i].GetNetworkId())
+	}
+	declared := []string{}
+	for i := range m.Networks {
+		declared = append(declared, m.Networks[i].Name)
+	}
+	pl.liveNetworks, err = liveIds(kindNetwork, names, ids, declared)
+	if err != nil {
+		return err
+	}
+
+	for i := range m.Networks {
+		desired := &m.Networks[i]
+		id, exists := pl.liveNetworks[desired.Name]
+		switch {
+		case isAbsent(desired.State):
+			if exists {
+				pl.delete(kindNetwork, desired.Name, id, func(ctx context.Context) error {
+					return pl.deleteNetwork(ctx, id)
+				})
+			}
+		case !exists:
+			pl.declaredNetworks[desired.Name] = true
+			changes, _ := networkChanges(desired, nil)
+			pl.create(kindNetwork, desired.Name, changes, func(ctx context.Context) (string, error) {
+				return pl.createNetwork(ctx, desired)
+			})
+		default:
+			pl.declaredNetworks[desired.Name] = true
+			pl.ids[resourceKey(kindNetwork, desired.Name)] = id
+			changes, err := networkChanges(desired, live[id])
+			if err != nil {
+				return err
+			}
+			pl.update(kindNetwork, desired.Name, id, changes, func(ctx context.Context) error {
+				return pl.updateNetwork(ctx, id, desired)
+			})
+		}
+	}
+	return nil
+}
+
+// networkChanges returns the changes of the declared network compared to the live one, which is nil if it doesn't exist
+func networkChanges(desired *Network, live *iaas.Network) ([]Change, error) {
+	if live == nil {
+		changes := changeString(nil, "ipv4Prefix", "", desired.IPv4Prefix)
+		changes = changeSet(changes, "ipv4Nameservers", nil, desired.IPv4Nameservers)
+		return changeLabels(changes, nil, desired.Labels), nil
+	}
+	if desired.IPv4Prefix != "" && !slices.Contains(utils.GetSliceFromPointer(live.Prefixes), desired.IPv4Prefix) {
+		return nil, immutableError(kindNetwork, desired.Name, "ipv4Prefix")
+	}
+	changes := changeSet(nil, "ipv4Nameservers", utils.GetSliceFromPointer(live.Nameservers), desired.IPv4Nameservers)
+	return changeLabels(changes, labelsFromAPI(utils.PtrValue(live.Labels)), desired.Labels), nil
+}
+
+func (pl *planner) createNetwork(ctx context.Context, desired *Network) (string, error) {
+	payload := iaas.CreateNetworkPayload{
+		Name:   utils.Ptr(desired.Name),
+		Labels: utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+	}
+	if desired.IPv4Prefix != "" || desired.IPv4Nameservers != nil {
+		payload.AddressFamily = &iaas.CreateNetworkAddressFamily{
+			Ipv4: &iaas.CreateNetworkIPv4Body{
+				Prefix:      optionalString(desired.IPv4Prefix),
+				Nameservers: optionalSlice(desired.IPv4Nameservers),
+			},
+		}
+	}
+	resp, err := pl.clients.IaaS.CreateNetwork(ctx, pl.projectId).CreateNetworkPayload(payload).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create network: %w", err)
+	}
+	id := resp.GetNetworkId()
+	_, err = wait.CreateNetworkWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("wait for network creation: %w", err)
+	}
+	return id, nil
+}
+
+func (pl *planner) updateNetwork(ctx context.Context, id string, desired *Network) error {
+	payload := iaas.PartialUpdateNetworkPayload{
+		Labels: utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+	}
+	if desired.IPv4Nameservers != nil {
+		payload.AddressFamily = &iaas.UpdateNetworkAddressFamily{
+			Ipv4: &iaas.UpdateNetworkIPv4Body{
+				Nameservers: optionalSlice(desired.IPv4Nameservers),
+			},
+		}
+	}
+	err := pl.clients.IaaS.PartialUpdateNetwork(ctx, pl.projectId, id).PartialUpdateNetworkPayload(payload).Execute()
+	if err != nil {
+		return fmt.Errorf("update network: %w", err)
+	}
+	_, err = wait.UpdateNetworkWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for network update: %w", err)
+	}
+	return nil
+}
+
+func (pl *planner) deleteNetwork(ctx context.Context, id string) error {
+	err := pl.clients.IaaS.DeleteNetworkExecute(ctx, pl.projectId, id)
+	if err != nil {
+		return fmt.Errorf("delete network: %w", err)
+	}
+	_, err = wait.DeleteNetworkWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for network deletion: %w", err)
+	}
+	return nil
+}
+
+// optionalString returns nil for an empty value, which is left out of the payload
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalSlice returns nil for a slice that isn't declared, which is left out of the payload
+func optionalSlice[T any](s []T) *[]T {
+	if s == nil {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,72 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestNetworkChanges(t *testing.T) {
+	live := &iaas.Network{
+		Name:        utils.Ptr("net"),
+		Prefixes:    &[]string{"10.0.0.0/24"},
+		Nameservers: &[]string{"1.1.1.1"},
+	}
+	tests := []struct {
+		description string
+		desired     *Network
+		live        *iaas.Network
+		isValid     bool
+		expected    []Change
+	}{
+		{
+			description: "create",
+			desired:     &Network{Name: "net", IPv4Prefix: "10.0.0.0/24"},
+			isValid:     true,
+			expected:    []Change{{Field: "ipv4Prefix", Old: `""`, New: `"10.0.0.0/24"`}},
+		},
+		{
+			description: "no changes",
+			desired:     &Network{Name: "net", IPv4Prefix: "10.0.0.0/24"},
+			live:        live,
+			isValid:     true,
+		},
+		{
+			description: "nameservers and labels changed",
+			desired:     &Network{Name: "net", IPv4Nameservers: []string{"8.8.8.8"}, Labels: map[string]string{"env": "dev"}},
+			live:        live,
+			isValid:     true,
+			expected: []Change{
+				{Field: "ipv4Nameservers", Old: "[1.1.1.1]", New: "[8.8.8.8]"},
+				{Field: "labels", Old: "[]", New: "[env=dev]"},
+			},
+		},
+		{
+			description: "prefix changed",
+			desired:     &Network{Name: "net", IPv4Prefix: "10.1.0.0/24"},
+			live:        live,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes, err := networkChanges(tt.desired, tt.live)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(changes, tt.expected); diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+// Kinds of resources that can be declared in a manifest
+const (
+	kindNetwork              = "network"
+	kindSecurityGroup        = "security group"
+	kindServer               = "server"
+	kindDNSZone              = "DNS zone"
+	kindDNSRecordSet         = "DNS record set"
+	kindPostgresFlexInstance = "PostgreSQL Flex instance"
+)
+
+// Operation done by an action
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// Action creates, updates or deletes a single resource
+type Action struct {
+	Operation Operation `json:"operation" yaml:"operation"`
+	Kind      string    `json:"kind" yaml:"kind"`
+	Name      string    `json:"name" yaml:"name"`
+	// ID of the resource, empty if it is created
+	Id      string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Changes []Change `json:"changes,omitempty" yaml:"changes,omitempty"`
+
+	run func(ctx context.Context) error
+}
+
+// Change of a field of a resource. The old value is empty if the resource is created
+type Change struct {
+	Field string `json:"field" yaml:"field"`
+	Old   string `json:"old,omitempty" yaml:"old,omitempty"`
+	New   string `json:"new" yaml:"new"`
+}
+
+// Plan holds the actions that make the project match the manifest, in the order they are run
+type Plan struct {
+	Actions []*Action `json:"actions" yaml:"actions"`
+}
+
+// IsEmpty returns whether the project already matches the manifest
+func (pl *Plan) IsEmpty() bool {
+	return len(pl.Actions) == 0
+}
+
+// Summary returns the number of actions per operation, e.g. "1 to create, 2 to update, 0 to delete"
+func (pl *Plan) Summary() string {
+	counts := map[Operation]int{}
+	for _, a := range pl.Actions {
+		counts[a.Operation]++
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete", counts[OperationCreate], counts[OperationUpdate], counts[OperationDelete])
+}
+
+// Diff returns the actions and their changes in a human readable form
+func (pl *Plan) Diff() string {
+	if pl.IsEmpty() {
+		return "No changes, the project matches the manifest.\n"
+	}
+	var sb strings.Builder
+	for _, a := range pl.Actions {
+		symbol := map[Operation]string{OperationCreate: "+", OperationUpdate: "~", OperationDelete: "-"}[a.Operation]
+		fmt.Fprintf(&sb, "%s %s %s %q", symbol, a.Operation, a.Kind, a.Name)
+		if a.Id != "" {
+			fmt.Fprintf(&sb, " (%s)", a.Id)
+		}
+		sb.WriteString("\n")
+		for _, c := range a.Changes {
+			if a.Operation == OperationCreate {
+				fmt.Fprintf(&sb, "    %s: %s\n", c.Field, c.New)
+				continue
+			}
+			fmt.Fprintf(&sb, "    %s: %s -> %s\n", c.Field, c.Old, c.New)
+		}
+	}
+	fmt.Fprintf(&sb, "\nPlan: %s.\n", pl.Summary())
+	return sb.String()
+}
+
+// Apply runs the actions in order, waiting for each of them to finish. It stops at the first action that fails.
+func (pl *Plan) Apply(ctx context.Context, p *print.Printer) error {
+	for i, a := range pl.Actions {
+		s := spinner.New(p)
+		s.Start(fmt.Sprintf("%s %s %q", progressVerb(a.Operation), a.Kind, a.Name))
+		err := a.run(ctx)
+		if err != nil {
+			s.StopWithError()
+			return fmt.Errorf("%s %s %q (%d of %d actions done): %w", a.Operation, a.Kind, a.Name, i, len(pl.Actions), err)
+		}
+		s.Stop()
+	}
+	return nil
+}
+
+func progressVerb(o Operation) string {
+	switch o {
+	case OperationCreate:
+		return "Creating"
+	case OperationUpdate:
+		return "Updating"
+	default:
+		return "Deleting"
+	}
+}
+
+// Helpers to describe the changes of fields
+
+func changeString(changes []Change, field, old, new string) []Change {
+	if old == new {
+		return changes
+	}
+	return append(changes, Change{Field: field, Old: quote(old), New: quote(new)})
+}
+
+func changeInt(changes []Change, field string, old, new *int64) []Change {
+	if new == nil || (old != nil && *old == *new) {
+		return changes
+	}
+	return append(changes, Change{Field: field, Old: formatInt(old), New: formatInt(new)})
+}
+
+// changeSet compares the values ignoring their order
+func changeSet(changes []Change, field string, old, new []string) []Change {
+	if new == nil || equalSets(old, new) {
+		return changes
+	}
+	return append(changes, Change{Field: field, Old: formatList(old), New: formatList(new)})
+}
+
+// changeLabels compares the labels, which are only managed if any are declared
+func changeLabels(changes []Change, old, new map[string]string) []Change {
+	if len(new) == 0 || formatLabels(old) == formatLabels(new) {
+		return changes
+	}
+	return append(changes, Change{Field: "labels", Old: formatLabels(old), New: formatLabels(new)})
+}
+
+func quote(s string) string {
+	if s == "" {
+		return `""`
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+func formatInt(i *int64) string {
+	if i == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *i)
+}
+
+func formatList(values []string) string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return "[" + strings.Join(sorted, ", ") + "]"
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	return formatList(pairs)
+}
+
+func equalSets(a, b []string) bool {
+	return formatList(a) == formatList(b)
+}
+
+// labelsFromAPI converts the labels returned by the API, which are untyped
+func labelsFromAPI(labels map[string]interface{}) map[string]string {
+	converted := map[string]string{}
+	for k, v := range labels {
+		converted[k] = fmt.Sprintf("%v", v)
+	}
+	return converted
+}
+
+// immutableError is returned if a field is changed that can only be set when the resource is created
+func immutableError(kind, name, field string) error {
+	return fmt.Errorf("%s %q: %s can't be changed once the %s is created, delete it first by setting its state to %q", kind, name, field, kind, StateAbsent)
+}
@@ -0,0 +1,141 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        *Plan
+		expected    string
+	}{
+		{
+			description: "empty",
+			plan:        &Plan{},
+			expected:    "No changes, the project matches the manifest.\n",
+		},
+		{
+			description: "all operations",
+			plan: &Plan{Actions: []*Action{
+				{
+					Operation: OperationCreate,
+					Kind:      kindNetwork,
+					Name:      "net",
+					Changes:   []Change{{Field: "ipv4Prefix", New: `"10.0.0.0/24"`}},
+				},
+				{
+					Operation: OperationUpdate,
+					Kind:      kindServer,
+					Name:      "vm",
+					Id:        "server-id",
+					Changes:   []Change{{Field: "machineType", Old: `"g1.1"`, New: `"g1.2"`}},
+				},
+				{
+					Operation: OperationDelete,
+					Kind:      kindSecurityGroup,
+					Name:      "web",
+					Id:        "sg-id",
+				},
+			}},
+			expected: `+ create network "net"
+    ipv4Prefix: "10.0.0.0/24"
+~ update server "vm" (server-id)
+    machineType: "g1.1" -> "g1.2"
+- delete security group "web" (sg-id)
+
+Plan: 1 to create, 1 to update, 1 to delete.
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diff := cmp.Diff(tt.plan.Diff(), tt.expected)
+			if diff != "" {
+				t.Fatalf("Diff does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	p := print.NewPrinter()
+	p.Cmd = &cobra.Command{}
+
+	tests := []struct {
+		description string
+		failAt      int
+		isValid     bool
+		expectedRun []string
+	}{
+		{
+			description: "all actions succeed",
+			failAt:      -1,
+			isValid:     true,
+			expectedRun: []string{"a", "b", "c"},
+		},
+		{
+			description: "stops at the failing action",
+			failAt:      1,
+			isValid:     false,
+			expectedRun: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			run := []string{}
+			plan := &Plan{}
+			for i, name := range []string{"a", "b", "c"} {
+				plan.Actions = append(plan.Actions, &Action{
+					Operation: OperationCreate,
+					Kind:      kindNetwork,
+					Name:      name,
+					run: func(_ context.Context) error {
+						run = append(run, name)
+						if i == tt.failAt {
+							return fmt.Errorf("failed")
+						}
+						return nil
+					},
+				})
+			}
+
+			err := plan.Apply(context.Background(), p)
+			if (err != nil) != !tt.isValid {
+				t.Fatalf("Apply() error = %v, isValid %v", err, tt.isValid)
+			}
+			if diff := cmp.Diff(run, tt.expectedRun); diff != "" {
+				t.Fatalf("Run actions do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeHelpers(t *testing.T) {
+	var changes []Change
+	changes = changeString(changes, "name", "a", "a")
+	changes = changeString(changes, "description", "", "new")
+	changes = changeSet(changes, "records", []string{"b", "a"}, []string{"a", "b"})
+	changes = changeSet(changes, "acl", nil, nil)
+	changes = changeSet(changes, "nameservers", []string{"1.1.1.1"}, []string{"8.8.8.8", "1.1.1.1"})
+	changes = changeLabels(changes, map[string]string{"a": "1"}, nil)
+	changes = changeLabels(changes, map[string]string{"a": "1"}, map[string]string{"a": "2"})
+
+	expected := []Change{
+		{Field: "description", Old: `""`, New: `"new"`},
+		{Field: "nameservers", Old: "[1.1.1.1]", New: "[1.1.1.1, 8.8.8.8]"},
+		{Field: "labels", Old: "[a=1]", New: "[a=2]"},
+	}
+	if diff := cmp.Diff(changes, expected); diff != "" {
+		t.Fatalf("Changes do not match: %s", diff)
+	}
+}
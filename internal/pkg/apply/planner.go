@@ -0,0 +1,230 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	dnsClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/dns/client"
+	iaasClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
+	postgresflexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+// Clients used to read and change the resources. Only the clients of the kinds of resources declared
+// in the manifest are needed, see Manifest.Services.
+type Clients struct {
+	IaaS         *iaas.APIClient
+	DNS          *dns.APIClient
+	PostgresFlex *postgresflex.APIClient
+}
+
+// Services used by the resources declared in the manifest
+type Services struct {
+	IaaS         bool
+	DNS          bool
+	PostgresFlex bool
+}
+
+// Services returns the services whose clients are needed to plan and apply the manifest
+func (m *Manifest) Services() Services {
+	return Services{
+		IaaS:         len(m.Networks) > 0 || len(m.SecurityGroups) > 0 || len(m.Servers) > 0,
+		DNS:          len(m.DNSZones) > 0 || len(m.DNSRecordSets) > 0,
+		PostgresFlex: len(m.PostgresFlexInstances) > 0,
+	}
+}
+
+// ConfigureClients configures the clients of the services used by the manifest
+func ConfigureClients(p *print.Printer, cliVersion string, services Services) (Clients, error) {
+	var clients Clients
+	var err error
+	if services.IaaS {
+		clients.IaaS, err = iaasClient.ConfigureClient(p, cliVersion)
+		if err != nil {
+			return clients, err
+		}
+	}
+	if services.DNS {
+		clients.DNS, err = dnsClient.ConfigureClient(p, cliVersion)
+		if err != nil {
+			return clients, err
+		}
+	}
+	if services.PostgresFlex {
+		clients.PostgresFlex, err = postgresflexClient.ConfigureClient(p, cliVersion)
+		if err != nil {
+			return clients, err
+		}
+	}
+	return clients, nil
+}
+
+// planner compares the manifest with the live resources of the project
+type planner struct {
+	clients   Clients
+	projectId string
+	region    string
+
+	// IDs of the resources by kind and name. Resources created by the plan are added once they are created,
+	// so that the resources depending on them can be created in the same run
+	ids map[string]string
+	// Resources of the manifest which are deleted by the plan
+	deleted map[string]bool
+
+	// Networks and security groups, which are referenced by servers
+	liveNetworks           map[string]string
+	liveSecurityGroups     map[string]string
+	declaredNetworks       map[string]bool
+	declaredSecurityGroups map[string]bool
+
+	// Zones, which are referenced by record sets
+	liveZones []dns.Zone
+	newZones  []*DNSZone
+
+	creates []*Action
+	updates []*Action
+	deletes []*Action
+}
+
+// NewPlan computes the actions that make the project match the manifest. Resources are created and updated
+// in dependency order, e.g. networks before the servers attached to them, and deleted in reverse order.
+func NewPlan(ctx context.Context, clients Clients, projectId, region string, manifest *Manifest) (*Plan, error) {
+	pl := &planner{
+		clients:   clients,
+		projectId: projectId,
+		region:    region,
+		ids:       map[string]string{},
+		deleted:   map[string]bool{},
+
+		liveNetworks:           map[string]string{},
+		liveSecurityGroups:     map[string]string{},
+		declaredNetworks:       map[string]bool{},
+		declaredSecurityGroups: map[string]bool{},
+	}
+	steps := []func(context.Context, *Manifest) error{
+		pl.planNetworks,
+		pl.planSecurityGroups,
+		pl.planServers,
+		pl.planDNSZones,
+		pl.planDNSRecordSets,
+		pl.planPostgresFlexInstances,
+	}
+	for _, step := range steps {
+		err := step(ctx, manifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	actions := []*Action{}
+	// Updates only reference existing resources, so they can run after all resources are created
+	actions = append(actions, pl.creates...)
+	actions = append(actions, pl.updates...)
+	for i := len(pl.deletes) - 1; i >= 0; i-- {
+		actions = append(actions, pl.deletes[i])
+	}
+	return &Plan{Actions: actions}, nil
+}
+
+func (pl *planner) create(kind, name string, changes []Change, run func(ctx context.Context) (string, error)) {
+	// Created resources have no old values
+	for i := range changes {
+		changes[i].Old = ""
+	}
+	pl.creates = append(pl.creates, &Action{
+		Operation: OperationCreate,
+		Kind:      kind,
+		Name:      name,
+		Changes:   changes,
+		run: func(ctx context.Context) error {
+			id, err := run(ctx)
+			if err != nil {
+				return err
+			}
+			pl.ids[resourceKey(kind, name)] = id
+			return nil
+		},
+	})
+}
+
+func (pl *planner) update(kind, name, id string, changes []Change, run func(ctx context.Context) error) {
+	if len(changes) == 0 {
+		return
+	}
+	pl.updates = append(pl.updates, &Action{
+		Operation: OperationUpdate,
+		Kind:      kind,
+		Name:      name,
+		Id:        id,
+		Changes:   changes,
+		run:       run,
+	})
+}
+
+func (pl *planner) delete(kind, name, id string, run func(ctx context.Context) error) {
+	pl.deleted[resourceKey(kind, name)] = true
+	pl.deletes = append(pl.deletes, &Action{
+		Operation: OperationDelete,
+		Kind:      kind,
+		Name:      name,
+		Id:        id,
+		run:       run,
+	})
+}
+
+// reference returns a function returning the ID of the resource with the name or ID, which is either declared
+// in the manifest or exists in the project. The ID of resources created by the plan is only known once they are created.
+func (pl *planner) reference(kind, nameOrId string, declared map[string]bool, live map[string]string) (func() string, error) {
+	key := resourceKey(kind, nameOrId)
+	if pl.deleted[key] {
+		return nil, fmt.Errorf("%s %q is referenced, but deleted by the manifest", kind, nameOrId)
+	}
+	if declared[nameOrId] {
+		return func() string { return pl.ids[key] }, nil
+	}
+	if id, ok := live[nameOrId]; ok {
+		return func() string { return id }, nil
+	}
+	for _, id := range live {
+		if id == nameOrId {
+			return func() string { return id }, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %q is referenced, but neither declared in the manifest nor found in the project", kind, nameOrId)
+}
+
+// liveIds maps the names of the live resources to their IDs. It fails if a resource of the manifest has the name
+// of more than one live resource, as it can't be told which one it is.
+func liveIds(kind string, names, ids []string, declared []string) (map[string]string, error) {
+	byName := map[string]string{}
+	duplicates := map[string][]string{}
+	for i, name := range names {
+		if existing, ok := byName[name]; ok {
+			if len(duplicates[name]) == 0 {
+				duplicates[name] = []string{existing}
+			}
+			duplicates[name] = append(duplicates[name], ids[i])
+			continue
+		}
+		byName[name] = ids[i]
+	}
+	for _, name := range declared {
+		if len(duplicates[name]) > 0 {
+			return nil, &cliErr.ResourceNameAmbiguousError{Resource: kind, Name: name, Ids: duplicates[name]}
+		}
+	}
+	return byName, nil
+}
+
+func resourceKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+func isAbsent(state string) bool {
+	return state == StateAbsent
+}
@@ -0,0 +1,304 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+const (
+	testProjectId       = "00000000-0000-0000-0000-000000000001"
+	testNetworkId       = "00000000-0000-0000-0000-000000000002"
+	testSecurityGroupId = "00000000-0000-0000-0000-000000000003"
+	testDuplicateId1    = "00000000-0000-0000-0000-000000000004"
+	testDuplicateId2    = "00000000-0000-0000-0000-000000000005"
+	testRuleId          = "00000000-0000-0000-0000-000000000006"
+	testServerId        = "00000000-0000-0000-0000-000000000007"
+	testZoneId          = "00000000-0000-0000-0000-000000000008"
+	testRegion          = "eu01"
+)
+
+var testCtx = context.Background()
+
+// fixtureAPI serves the live resources of the project
+func fixtureAPI() map[string]any {
+	return map[string]any{
+		"/v1/projects/" + testProjectId + "/networks": iaas.NetworkListResponse{Items: &[]iaas.Network{
+			{NetworkId: utils.Ptr(testNetworkId), Name: utils.Ptr("existing"), Prefixes: &[]string{"10.1.0.0/24"}},
+		}},
+		"/v1/projects/" + testProjectId + "/security-groups": iaas.SecurityGroupListResponse{Items: &[]iaas.SecurityGroup{
+			{Id: utils.Ptr(testSecurityGroupId), Name: utils.Ptr("web")},
+			{Id: utils.Ptr(testDuplicateId1), Name: utils.Ptr("dup")},
+			{Id: utils.Ptr(testDuplicateId2), Name: utils.Ptr("dup")},
+		}},
+		"/v1/projects/" + testProjectId + "/security-groups/" + testSecurityGroupId + "/rules": iaas.SecurityGroupRuleListResponse{Items: &[]iaas.SecurityGroupRule{
+			{Id: utils.Ptr(testRuleId), Direction: utils.Ptr("egress"), Ethertype: utils.Ptr("IPv4")},
+		}},
+		"/v1/projects/" + testProjectId + "/servers": iaas.ServerListResponse{Items: &[]iaas.Server{
+			{Id: utils.Ptr(testServerId), Name: utils.Ptr("old"), MachineType: utils.Ptr("g1.1")},
+		}},
+		"/v1/projects/" + testProjectId + "/zones": dns.ListZonesResponse{Zones: &[]dns.Zone{
+			{Id: utils.Ptr(testZoneId), Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com")},
+		}},
+		"/v1/projects/" + testProjectId + "/zones/" + testZoneId + "/rrsets": dns.ListRecordSetsResponse{RrSets: &[]dns.RecordSet{}},
+	}
+}
+
+func fixtureClients(t *testing.T, responses map[string]any) Clients {
+	t.Helper()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request while planning: %s %s", r.Method, r.URL.Path)
+		}
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(resp)
+		if err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	})
+	mockedServer := httptest.NewServer(handler)
+	t.Cleanup(mockedServer.Close)
+
+	iaasClient, err := iaas.NewAPIClient(sdkConfig.WithEndpoint(mockedServer.URL), sdkConfig.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	dnsClient, err := dns.NewAPIClient(sdkConfig.WithEndpoint(mockedServer.URL), sdkConfig.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	return Clients{IaaS: iaasClient, DNS: dnsClient}
+}
+
+type actionSummary struct {
+	Operation Operation
+	Kind      string
+	Name      string
+	Id        string
+}
+
+func TestNewPlan(t *testing.T) {
+	tests := []struct {
+		description     string
+		manifest        string
+		isValid         bool
+		expectedActions []actionSummary
+	}{
+		{
+			description: "base",
+			manifest: `
+networks:
+  - name: net
+    ipv4Prefix: 10.0.0.0/24
+securityGroups:
+  - name: web
+    rules:
+      - direction: ingress
+        protocol: tcp
+        ports: "443"
+servers:
+  - name: vm
+    machineType: g1.1
+    imageId: image
+    network: net
+    securityGroups: [web]
+  - name: old
+    state: absent
+dnsRecordSets:
+  - zone: example
+    name: www
+    records: [1.2.3.4]
+`,
+			isValid: true,
+			expectedActions: []actionSummary{
+				{Operation: OperationCreate, Kind: kindNetwork, Name: "net"},
+				{Operation: OperationCreate, Kind: kindServer, Name: "vm"},
+				{Operation: OperationCreate, Kind: kindDNSRecordSet, Name: "www.example.com. A"},
+				{Operation: OperationUpdate, Kind: kindSecurityGroup, Name: "web", Id: testSecurityGroupId},
+				{Operation: OperationDelete, Kind: kindServer, Name: "old", Id: testServerId},
+			},
+		},
+		{
+			description: "no changes",
+			manifest: `
+networks:
+  - name: existing
+    ipv4Prefix: 10.1.0.0/24
+servers:
+  - name: old
+    machineType: g1.1
+`,
+			isValid:         true,
+			expectedActions: []actionSummary{},
+		},
+		{
+			description: "deletes in reverse order",
+			manifest: `
+networks:
+  - name: existing
+    state: absent
+servers:
+  - name: old
+    state: absent
+`,
+			isValid: true,
+			expectedActions: []actionSummary{
+				{Operation: OperationDelete, Kind: kindServer, Name: "old", Id: testServerId},
+				{Operation: OperationDelete, Kind: kindNetwork, Name: "existing", Id: testNetworkId},
+			},
+		},
+		{
+			description: "absent resources that don't exist",
+			manifest: `
+networks:
+  - name: missing
+    state: absent
+`,
+			isValid:         true,
+			expectedActions: []actionSummary{},
+		},
+		{
+			description: "immutable field changed",
+			manifest: `
+networks:
+  - name: existing
+    ipv4Prefix: 10.2.0.0/24
+`,
+			isValid: false,
+		},
+		{
+			description: "ambiguous name",
+			manifest: `
+securityGroups:
+  - name: dup
+`,
+			isValid: false,
+		},
+		{
+			description: "referenced network not found",
+			manifest: `
+servers:
+  - name: vm
+    machineType: g1.1
+    imageId: image
+    network: missing
+`,
+			isValid: false,
+		},
+		{
+			description: "referenced network deleted",
+			manifest: `
+networks:
+  - name: existing
+    state: absent
+servers:
+  - name: vm
+    machineType: g1.1
+    imageId: image
+    network: existing
+`,
+			isValid: false,
+		},
+		{
+			description: "server to create without image",
+			manifest: `
+servers:
+  - name: vm
+    machineType: g1.1
+`,
+			isValid: false,
+		},
+		{
+			description: "zones",
+			manifest: `
+dnsZones:
+  - name: new
+    dnsName: new.com
+  - name: example
+    description: example zone
+dnsRecordSets:
+  - zone: new.com
+    name: www
+    records: [1.2.3.4]
+`,
+			isValid: true,
+			expectedActions: []actionSummary{
+				{Operation: OperationCreate, Kind: kindDNSZone, Name: "new"},
+				{Operation: OperationCreate, Kind: kindDNSRecordSet, Name: "www.new.com. A"},
+				{Operation: OperationUpdate, Kind: kindDNSZone, Name: "example", Id: testZoneId},
+			},
+		},
+		{
+			description: "record set of deleted zone",
+			manifest: `
+dnsZones:
+  - name: example
+    state: absent
+dnsRecordSets:
+  - zone: example
+    name: www
+`,
+			isValid: false,
+		},
+		{
+			description: "zone to create without dns name",
+			manifest: `
+dnsZones:
+  - name: new
+`,
+			isValid: false,
+		},
+		{
+			description: "zone not found",
+			manifest: `
+dnsRecordSets:
+  - zone: missing
+    name: www
+`,
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			manifest, err := Parse([]byte(tt.manifest))
+			if err != nil {
+				t.Fatalf("Parse() failed: %v", err)
+			}
+			clients := fixtureClients(t, fixtureAPI())
+
+			plan, err := NewPlan(testCtx, clients, testProjectId, testRegion, manifest)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			actions := []actionSummary{}
+			for _, a := range plan.Actions {
+				actions = append(actions, actionSummary{Operation: a.Operation, Kind: a.Kind, Name: a.Name, Id: a.Id})
+			}
+			diff := cmp.Diff(actions, tt.expectedActions)
+			if diff != "" {
+				t.Fatalf("Actions do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	postgresflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/wait"
+)
+
+// Defaults of instances, which are the same as the ones of the "postgresflex instance create" command
+const (
+	defaultPostgresFlexBackupSchedule = "0 0 * * *"
+	defaultPostgresFlexStorageClass   = "premium-perf2-stackit"
+	defaultPostgresFlexStorageSize    = 10
+	defaultPostgresFlexType           = "Replica"
+)
+
+func (pl *planner) planPostgresFlexInstances(ctx context.Context, m *Manifest) error {
+	if len(m.PostgresFlexInstances) == 0 {
+		return nil
+	}
+	resp, err := pl.clients.PostgresFlex.ListInstancesExecute(ctx, pl.projectId, pl.region)
+	if err != nil {
+		return fmt.Errorf("get PostgreSQL Flex instances: %w", err)
+	}
+	names := []string{}
+	ids := []string{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Items) {
+		// Instances in delayed deletion can only be restored or force deleted
+		if instance.GetStatus() == wait.InstanceStateDeleted {
+			continue
+		}
+		names = append(names, instance.GetName())
+		ids = append(ids, instance.GetId())
+	}
+	declared := []string{}
+	for i := range m.PostgresFlexInstances {
+		declared = append(declared, m.PostgresFlexInstances[i].Name)
+	}
+	liveInstances, err := liveIds(kindPostgresFlexInstance, names, ids, declared)
+	if err != nil {
+		return err
+	}
+
+	var flavors *[]postgresflex.Flavor
+	for i := range m.PostgresFlexInstances {
+		desired := &m.PostgresFlexInstances[i]
+		id, exists := liveInstances[desired.Name]
+		if isAbsent(desired.State) {
+			if exists {
+				pl.delete(kindPostgresFlexInstance, desired.Name, id, func(ctx context.Context) error {
+					return pl.deletePostgresFlexInstance(ctx, id)
+				})
+			}
+			continue
+		}
+
+		if flavors == nil && (desired.FlavorId != "" || desired.CPU != nil || desired.RAM != nil) {
+			flavorsResp, err := pl.clients.PostgresFlex.ListFlavorsExecute(ctx, pl.projectId, pl.region)
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex flavors: %w", err)
+			}
+			flavors = flavorsResp.Flavors
+		}
+		flavorId, err := postgresFlexFlavorId(desired, flavors)
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", kindPostgresFlexInstance, desired.Name, err)
+		}
+
+		if !exists {
+			if flavorId == "" || len(desired.ACL) == 0 {
+				return fmt.Errorf("%s %q: a flavor, either flavorId or cpu and ram, and acl are required to create it", kindPostgresFlexInstance, desired.Name)
+			}
+			if _, err := postgresflexUtils.GetInstanceReplicas(postgresFlexType(desired)); err != nil {
+				return fmt.Errorf("%s %q: %w", kindPostgresFlexInstance, desired.Name, err)
+			}
+			changes, _ := postgresFlexInstanceChanges(desired, nil, flavorId)
+			pl.create(kindPostgresFlexInstance, desired.Name, changes, func(ctx context.Context) (string, error) {
+				return pl.createPostgresFlexInstance(ctx, desired, flavorId)
+			})
+			continue
+		}
+
+		instanceResp, err := pl.clients.PostgresFlex.GetInstanceExecute(ctx, pl.projectId, pl.region, id)
+		if err != nil {
+			return fmt.Errorf("get PostgreSQL Flex instance: %w", err)
+		}
+		changes, err := postgresFlexInstanceChanges(desired, instanceResp.Item, flavorId)
+		if err != nil {
+			return err
+		}
+		pl.update(kindPostgresFlexInstance, desired.Name, id, changes, func(ctx context.Context) error {
+			return pl.updatePostgresFlexInstance(ctx, id, desired, instanceResp.Item, flavorId)
+		})
+	}
+	return nil
+}
+
+// postgresFlexFlavorId returns the ID of the declared flavor, empty if none is declared
+func postgresFlexFlavorId(desired *PostgresFlexInstance, flavors *[]postgresflex.Flavor) (string, error) {
+	if desired.FlavorId != "" {
+		err := postgresflexUtils.ValidateFlavorId(desired.FlavorId, flavors)
+		if err != nil {
+			return "", err
+		}
+		return desired.FlavorId, nil
+	}
+	if desired.CPU == nil && desired.RAM == nil {
+		return "", nil
+	}
+	if desired.CPU == nil || desired.RAM == nil {
+		return "", fmt.Errorf("both cpu and ram must be set")
+	}
+	flavorId, err := postgresflexUtils.LoadFlavorId(*desired.CPU, *desired.RAM, flavors)
+	if err != nil {
+		return "", err
+	}
+	return *flavorId, nil
+}
+
+func postgresFlexType(desired *PostgresFlexInstance) string {
+	if desired.Type == "" {
+		return defaultPostgresFlexType
+	}
+	return desired.Type
+}
+
+// postgresFlexInstanceChanges returns the changes of the declared instance compared to the live one,
+// which is nil if it doesn't exist
+func postgresFlexInstanceChanges(desired *PostgresFlexInstance, live *postgresflex.Instance, flavorId string) ([]Change, error) {
+	if live == nil {
+		changes := changeString(nil, "flavorId", "", flavorId)
+		changes = changeString(changes, "storageClass", "", valueOrDefault(desired.StorageClass, defaultPostgresFlexStorageClass))
+		changes = changeInt(changes, "storageSize", nil, postgresFlexStorageSize(desired))
+		changes = changeString(changes, "version", "", valueOrDefault(desired.Version, "latest"))
+		changes = changeString(changes, "type", "", postgresFlexType(desired))
+		changes = changeSet(changes, "acl", nil, desired.ACL)
+		return changeString(changes, "backupSchedule", "", valueOrDefault(desired.BackupSchedule, defaultPostgresFlexBackupSchedule)), nil
+	}
+
+	var liveStorageClass string
+	var liveStorageSize *int64
+	if live.Storage != nil {
+		liveStorageClass = live.Storage.GetClass()
+		liveStorageSize = live.Storage.Size
+	}
+	liveType, _ := postgresflexUtils.GetInstanceType(live.GetReplicas())
+	immutable := []struct {
+		field   string
+		desired string
+		live    string
+	}{
+		{"storageClass", desired.StorageClass, liveStorageClass},
+		{"version", desired.Version, live.GetVersion()},
+		{"type", desired.Type, liveType},
+	}
+	for _, f := range immutable {
+		if f.desired != "" && f.desired != f.live {
+			return nil, immutableError(kindPostgresFlexInstance, desired.Name, f.field)
+		}
+	}
+
+	var changes []Change
+	if flavorId != "" && live.Flavor != nil {
+		changes = changeString(changes, "flavorId", live.Flavor.GetId(), flavorId)
+	}
+	changes = changeInt(changes, "storageSize", liveStorageSize, desired.StorageSize)
+	var liveACL []string
+	if live.Acl != nil {
+		liveACL = utils.GetSliceFromPointer(live.Acl.Items)
+	}
+	changes = changeSet(changes, "acl", liveACL, desired.ACL)
+	if desired.BackupSchedule != "" {
+		changes = changeString(changes, "backupSchedule", live.GetBackupSchedule(), desired.BackupSchedule)
+	}
+	return changes, nil
+}
+
+func postgresFlexStorageSize(desired *PostgresFlexInstance) *int64 {
+	if desired.StorageSize == nil {
+		return utils.Ptr(int64(defaultPostgresFlexStorageSize))
+	}
+	return desired.StorageSize
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func (pl *planner) createPostgresFlexInstance(ctx context.Context, desired *PostgresFlexInstance, flavorId string) (string, error) {
+	version := desired.Version
+	if version == "" {
+		latest, err := postgresflexUtils.GetLatestPostgreSQLVersion(ctx, pl.clients.PostgresFlex, pl.projectId, pl.region)
+		if err != nil {
+			return "", fmt.Errorf("get latest PostgreSQL version: %w", err)
+		}
+		version = latest
+	}
+	instanceType := postgresFlexType(desired)
+	replicas, err := postgresflexUtils.GetInstanceReplicas(instanceType)
+	if err != nil {
+		return "", fmt.Errorf("get PostgreSQL Flex instance type: %w", err)
+	}
+	resp, err := pl.clients.PostgresFlex.CreateInstance(ctx, pl.projectId, pl.region).CreateInstancePayload(postgresflex.CreateInstancePayload{
+		Name:           utils.Ptr(desired.Name),
+		Acl:            &postgresflex.ACL{Items: utils.Ptr(desired.ACL)},
+		BackupSchedule: utils.Ptr(valueOrDefault(desired.BackupSchedule, defaultPostgresFlexBackupSchedule)),
+		FlavorId:       utils.Ptr(flavorId),
+		Replicas:       &replicas,
+		Storage: &postgresflex.Storage{
+			Class: utils.Ptr(valueOrDefault(desired.StorageClass, defaultPostgresFlexStorageClass)),
+			Size:  postgresFlexStorageSize(desired),
+		},
+		Version: utils.Ptr(version),
+		Options: utils.Ptr(map[string]string{
+			"type": instanceType,
+		}),
+	}).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create PostgreSQL Flex instance: %w", err)
+	}
+	id := resp.GetId()
+	_, err = wait.CreateInstanceWaitHandler(ctx, pl.clients.PostgresFlex, pl.projectId, pl.region, id).WaitWithContext(ctx)
+	if err != nil {
+		return id, fmt.Errorf("wait for PostgreSQL Flex instance creation: %w", err)
+	}
+	return id, nil
+}
+
+func (pl *planner) updatePostgresFlexInstance(ctx context.Context, id string, desired *PostgresFlexInstance, live *postgresflex.Instance, flavorId string) error {
+	payload := postgresflex.PartialUpdateInstancePayload{
+		BackupSchedule: optionalString(desired.BackupSchedule),
+		FlavorId:       optionalString(flavorId),
+	}
+	if desired.ACL != nil {
+		payload.Acl = &postgresflex.ACL{Items: utils.Ptr(desired.ACL)}
+	}
+	if desired.StorageSize != nil {
+		payload.Storage = &postgresflex.Storage{Size: desired.StorageSize}
+		if live.Storage != nil {
+			payload.Storage.Class = live.Storage.Class
+		}
+	}
+	_, err := pl.clients.PostgresFlex.PartialUpdateInstance(ctx, pl.projectId, pl.region, id).PartialUpdateInstancePayload(payload).Execute()
+	if err != nil {
+		return fmt.Errorf("update PostgreSQL Flex instance: %w", err)
+	}
+	_, err = wait.PartialUpdateInstanceWaitHandler(ctx, pl.clients.PostgresFlex, pl.projectId, pl.region, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for PostgreSQL Flex instance update: %w", err)
+	}
+	return nil
+}
+
+func (pl *planner) deletePostgresFlexInstance(ctx context.Context, id string) error {
+	err := pl.clients.PostgresFlex.DeleteInstanceExecute(ctx, pl.projectId, pl.region, id)
+	if err != nil {
+		return fmt.Errorf("delete PostgreSQL Flex instance: %w", err)
+	}
+	_, err = wait.DeleteInstanceWaitHandler(ctx, pl.clients.PostgresFlex, pl.projectId, pl.region, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for PostgreSQL Flex instance deletion: %w", err)
+	}
+	return nil
+}
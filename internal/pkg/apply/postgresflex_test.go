@@ -0,0 +1,151 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestPostgresFlexFlavorId(t *testing.T) {
+	flavors := &[]postgresflex.Flavor{
+		{Id: utils.Ptr("flavor-1"), Cpu: utils.Ptr(int64(2)), Memory: utils.Ptr(int64(4))},
+	}
+	tests := []struct {
+		description string
+		desired     *PostgresFlexInstance
+		isValid     bool
+		expected    string
+	}{
+		{
+			description: "not declared",
+			desired:     &PostgresFlexInstance{},
+			isValid:     true,
+		},
+		{
+			description: "flavor id",
+			desired:     &PostgresFlexInstance{FlavorId: "flavor-1"},
+			isValid:     true,
+			expected:    "flavor-1",
+		},
+		{
+			description: "cpu and ram",
+			desired:     &PostgresFlexInstance{CPU: utils.Ptr(int64(2)), RAM: utils.Ptr(int64(4))},
+			isValid:     true,
+			expected:    "flavor-1",
+		},
+		{
+			description: "cpu without ram",
+			desired:     &PostgresFlexInstance{CPU: utils.Ptr(int64(2))},
+			isValid:     false,
+		},
+		{
+			description: "unknown flavor",
+			desired:     &PostgresFlexInstance{FlavorId: "flavor-2"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			flavorId, err := postgresFlexFlavorId(tt.desired, flavors)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if flavorId != tt.expected {
+				t.Fatalf("expected flavor %q, got %q", tt.expected, flavorId)
+			}
+		})
+	}
+}
+
+func TestPostgresFlexInstanceChanges(t *testing.T) {
+	live := &postgresflex.Instance{
+		Acl:            &postgresflex.ACL{Items: &[]string{"0.0.0.0/0"}},
+		BackupSchedule: utils.Ptr(defaultPostgresFlexBackupSchedule),
+		Flavor:         &postgresflex.Flavor{Id: utils.Ptr("flavor-1")},
+		Replicas:       utils.Ptr(int64(3)),
+		Storage:        &postgresflex.Storage{Class: utils.Ptr(defaultPostgresFlexStorageClass), Size: utils.Ptr(int64(10))},
+		Version:        utils.Ptr("16"),
+	}
+	tests := []struct {
+		description string
+		desired     *PostgresFlexInstance
+		live        *postgresflex.Instance
+		flavorId    string
+		isValid     bool
+		expected    []Change
+	}{
+		{
+			description: "create with defaults",
+			desired:     &PostgresFlexInstance{Name: "db", ACL: []string{"0.0.0.0/0"}},
+			flavorId:    "flavor-1",
+			isValid:     true,
+			expected: []Change{
+				{Field: "flavorId", Old: `""`, New: `"flavor-1"`},
+				{Field: "storageClass", Old: `""`, New: `"premium-perf2-stackit"`},
+				{Field: "storageSize", Old: "-", New: "10"},
+				{Field: "version", Old: `""`, New: `"latest"`},
+				{Field: "type", Old: `""`, New: `"Replica"`},
+				{Field: "acl", Old: "[]", New: "[0.0.0.0/0]"},
+				{Field: "backupSchedule", Old: `""`, New: `"0 0 * * *"`},
+			},
+		},
+		{
+			description: "no changes",
+			desired:     &PostgresFlexInstance{Name: "db", Version: "16", Type: "Replica", ACL: []string{"0.0.0.0/0"}},
+			live:        live,
+			flavorId:    "flavor-1",
+			isValid:     true,
+		},
+		{
+			description: "flavor and storage size changed",
+			desired:     &PostgresFlexInstance{Name: "db", StorageSize: utils.Ptr(int64(20))},
+			live:        live,
+			flavorId:    "flavor-2",
+			isValid:     true,
+			expected: []Change{
+				{Field: "flavorId", Old: `"flavor-1"`, New: `"flavor-2"`},
+				{Field: "storageSize", Old: "10", New: "20"},
+			},
+		},
+		{
+			description: "version changed",
+			desired:     &PostgresFlexInstance{Name: "db", Version: "17"},
+			live:        live,
+			isValid:     false,
+		},
+		{
+			description: "type changed",
+			desired:     &PostgresFlexInstance{Name: "db", Type: "Single"},
+			live:        live,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes, err := postgresFlexInstanceChanges(tt.desired, tt.live, tt.flavorId)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(changes, tt.expected); diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+const (
+	directionIngress = "ingress"
+	directionEgress  = "egress"
+	defaultEtherType = "IPv4"
+	anyProtocol      = "any"
+)
+
+// key identifies the rule by all its fields except for the description, as rules can't be updated
+func (r *SecurityGroupRule) key() (string, error) {
+	if r.Direction != directionIngress && r.Direction != directionEgress {
+		return "", fmt.Errorf("rule direction must be %q or %q, got %q", directionIngress, directionEgress, r.Direction)
+	}
+	if _, _, err := r.portRange(); err != nil {
+		return "", err
+	}
+	return ruleKey(r.Direction, r.EtherType, r.Protocol, r.Ports, r.IPRange), nil
+}
+
+// portRange parses the ports of the rule, which are either a single port or a range, e.g. "8000-8080"
+func (r *SecurityGroupRule) portRange() (minPort, maxPort *int64, err error) {
+	if r.Ports == "" {
+		return nil, nil, nil
+	}
+	first, last, isRange := strings.Cut(r.Ports, "-")
+	if !isRange {
+		last = first
+	}
+	minValue, err := strconv.ParseInt(strings.TrimSpace(first), 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid rule ports %q", r.Ports)
+	}
+	maxValue, err := strconv.ParseInt(strings.TrimSpace(last), 10, 64)
+	if err != nil || maxValue < minValue {
+		return nil, nil, fmt.Errorf("invalid rule ports %q", r.Ports)
+	}
+	return &minValue, &maxValue, nil
+}
+
+func ruleKey(direction, etherType, protocol, ports, ipRange string) string {
+	if etherType == "" {
+		etherType = defaultEtherType
+	}
+	if protocol == "" {
+		protocol = anyProtocol
+	}
+	key := fmt.Sprintf("%s %s %s", direction, etherType, strings.ToLower(protocol))
+	if ports != "" {
+		key += " " + ports
+	}
+	if ipRange != "" {
+		key += " " + ipRange
+	}
+	return key
+}
+
+// liveRuleKey identifies the live rule the same way as rules of the manifest
+func liveRuleKey(rule *iaas.SecurityGroupRule) string {
+	ports := ""
+	if rule.PortRange != nil {
+		ports = strconv.FormatInt(rule.PortRange.GetMin(), 10)
+		if rule.PortRange.GetMax() != rule.PortRange.GetMin() {
+			ports += "-" + strconv.FormatInt(rule.PortRange.GetMax(), 10)
+		}
+	}
+	protocol := ""
+	if rule.Protocol != nil {
+		protocol = rule.Protocol.GetName()
+	}
+	return ruleKey(rule.GetDirection(), rule.GetEthertype(), protocol, ports, rule.GetIpRange())
+}
+
+// ruleChanges returns the rules to create and the IDs of the rules to delete so that the security group
+// has the declared rules only
+func ruleChanges(desired []SecurityGroupRule, live []iaas.SecurityGroupRule) (create []SecurityGroupRule, deleteIds []string) {
+	liveKeys := map[string]bool{}
+	for i := range live {
+		liveKeys[liveRuleKey(&live[i])] = true
+	}
+	desiredKeys := map[string]bool{}
+	for _, rule := range desired {
+		key, _ := rule.key()
+		desiredKeys[key] = true
+		if !liveKeys[key] {
+			create = append(create, rule)
+		}
+	}
+	for i := range live {
+		if !desiredKeys[liveRuleKey(&live[i])] {
+			deleteIds = append(deleteIds, live[i].GetId())
+		}
+	}
+	return create, deleteIds
+}
+
+func (pl *planner) planSecurityGroups(ctx context.Context, m *Manifest) error {
+	// Servers may reference security groups which aren't declared
+	if len(m.SecurityGroups) == 0 && len(m.Servers) == 0 {
+		return nil
+	}
+	resp, err := pl.clients.IaaS.ListSecurityGroupsExecute(ctx, pl.projectId)
+	if err != nil {
+		return fmt.Errorf("get security groups: %w", err)
+	}
+	groups := utils.GetSliceFromPointer(resp.Items)
+	live := map[string]*iaas.SecurityGroup{}
+	names := []string{}
+	ids := []string{}
+	for i := range groups {
+		live[groups[i].GetId()] = &groups[i]
+		names = append(names, groups[i].GetName())
+		ids = append(ids, groups[i].GetId())
+	}
+	declared := []string{}
+	for i := range m.SecurityGroups {
+		declared = append(declared, m.SecurityGroups[i].Name)
+	}
+	pl.liveSecurityGroups, err = liveIds(kindSecurityGroup, names, ids, declared)
+	if err != nil {
+		return err
+	}
+
+	for i := range m.SecurityGroups {
+		desired := &m.SecurityGroups[i]
+		id, exists := pl.liveSecurityGroups[desired.Name]
+		switch {
+		case isAbsent(desired.State):
+			if exists {
+				pl.delete(kindSecurityGroup, desired.Name, id, func(ctx context.Context) error {
+					err := pl.clients.IaaS.DeleteSecurityGroupExecute(ctx, pl.projectId, id)
+					if err != nil {
+						return fmt.Errorf("delete security group: %w", err)
+					}
+					return nil
+				})
+			}
+		case !exists:
+			pl.declaredSecurityGroups[desired.Name] = true
+			changes, _ := securityGroupChanges(desired, nil, nil)
+			pl.create(kindSecurityGroup, desired.Name, changes, func(ctx context.Context) (string, error) {
+				return pl.createSecurityGroup(ctx, desired)
+			})
+		default:
+			pl.declaredSecurityGroups[desired.Name] = true
+			pl.ids[resourceKey(kindSecurityGroup, desired.Name)] = id
+			rules, err := pl.listRules(ctx, id)
+			if err != nil {
+				return err
+			}
+			changes, err := securityGroupChanges(desired, live[id], rules)
+			if err != nil {
+				return err
+			}
+			pl.update(kindSecurityGroup, desired.Name, id, changes, func(ctx context.Context) error {
+				return pl.updateSecurityGroup(ctx, id, desired, live[id], rules)
+			})
+		}
+	}
+	return nil
+}
+
+// securityGroupChanges returns the changes of the declared security group compared to the live one and its rules.
+// The live security group is nil if it doesn't exist.
+func securityGroupChanges(desired *SecurityGroup, live *iaas.SecurityGroup, liveRules []iaas.SecurityGroupRule) ([]Change, error) {
+	desiredRules := []string{}
+	for _, rule := range desired.Rules {
+		key, _ := rule.key()
+		desiredRules = append(desiredRules, key)
+	}
+
+	if live == nil {
+		changes := changeString(nil, "description", "", desired.Description)
+		if desired.Stateful != nil {
+			changes = changeString(changes, "stateful", "", strconv.FormatBool(*desired.Stateful))
+		}
+		changes = changeLabels(changes, nil, desired.Labels)
+		if desired.Rules != nil {
+			changes = changeSet(changes, "rules", nil, desiredRules)
+		}
+		return changes, nil
+	}
+
+	if desired.Stateful != nil && live.Stateful != nil && *desired.Stateful != *live.Stateful {
+		return nil, immutableError(kindSecurityGroup, desired.Name, "stateful")
+	}
+	var changes []Change
+	if desired.Description != "" {
+		changes = changeString(changes, "description", live.GetDescription(), desired.Description)
+	}
+	changes = changeLabels(changes, labelsFromAPI(utils.PtrValue(live.Labels)), desired.Labels)
+	if desired.Rules != nil {
+		liveKeys := []string{}
+		for i := range liveRules {
+			liveKeys = append(liveKeys, liveRuleKey(&liveRules[i]))
+		}
+		changes = changeSet(changes, "rules", liveKeys, desiredRules)
+	}
+	return changes, nil
+}
+
+func (pl *planner) listRules(ctx context.Context, securityGroupId string) ([]iaas.SecurityGroupRule, error) {
+	resp, err := pl.clients.IaaS.ListSecurityGroupRulesExecute(ctx, pl.projectId, securityGroupId)
+	if err != nil {
+		return nil, fmt.Errorf("get security group rules: %w", err)
+	}
+	return utils.GetSliceFromPointer(resp.Items), nil
+}
+
+func (pl *planner) createSecurityGroup(ctx context.Context, desired *SecurityGroup) (string, error) {
+	resp, err := pl.clients.IaaS.CreateSecurityGroup(ctx, pl.projectId).CreateSecurityGroupPayload(iaas.CreateSecurityGroupPayload{
+		Name:        utils.Ptr(desired.Name),
+		Description: optionalString(desired.Description),
+		Labels:      utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+		Stateful:    desired.Stateful,
+	}).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create security group: %w", err)
+	}
+	id := resp.GetId()
+	if desired.Rules == nil {
+		return id, nil
+	}
+	// The default rules created with the security group are replaced by the declared ones
+	rules, err := pl.listRules(ctx, id)
+	if err != nil {
+		return id, err
+	}
+	return id, pl.syncRules(ctx, id, desired.Rules, rules)
+}
+
+func (pl *planner) updateSecurityGroup(ctx context.Context, id string, desired *SecurityGroup, live *iaas.SecurityGroup, liveRules []iaas.SecurityGroupRule) error {
+	descriptionChanged := desired.Description != "" && desired.Description != live.GetDescription()
+	labelsChanged := len(changeLabels(nil, labelsFromAPI(utils.PtrValue(live.Labels)), desired.Labels)) > 0
+	if descriptionChanged || labelsChanged {
+		_, err := pl.clients.IaaS.UpdateSecurityGroup(ctx, pl.projectId, id).UpdateSecurityGroupPayload(iaas.UpdateSecurityGroupPayload{
+			Description: optionalString(desired.Description),
+			Labels:      utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("update security group: %w", err)
+		}
+	}
+	if desired.Rules == nil {
+		return nil
+	}
+	return pl.syncRules(ctx, id, desired.Rules, liveRules)
+}
+
+// syncRules creates the missing rules and deletes the ones that aren't declared
+func (pl *planner) syncRules(ctx context.Context, securityGroupId string, desired []SecurityGroupRule, live []iaas.SecurityGroupRule) error {
+	create, deleteIds := ruleChanges(desired, live)
+	for _, rule := range create {
+		minPort, maxPort, _ := rule.portRange()
+		payload := iaas.CreateSecurityGroupRulePayload{
+			Direction:   utils.Ptr(rule.Direction),
+			Description: optionalString(rule.Description),
+			Ethertype:   optionalString(rule.EtherType),
+			IpRange:     optionalString(rule.IPRange),
+		}
+		if minPort != nil {
+			payload.PortRange = &iaas.PortRange{Min: minPort, Max: maxPort}
+		}
+		if rule.Protocol != "" {
+			payload.Protocol = &iaas.CreateProtocol{String: utils.Ptr(rule.Protocol)}
+		}
+		_, err := pl.clients.IaaS.CreateSecurityGroupRule(ctx, pl.projectId, securityGroupId).CreateSecurityGroupRulePayload(payload).Execute()
+		if err != nil {
+			return fmt.Errorf("create security group rule: %w", err)
+		}
+	}
+	for _, ruleId := range deleteIds {
+		err := pl.clients.IaaS.DeleteSecurityGroupRuleExecute(ctx, pl.projectId, securityGroupId, ruleId)
+		if err != nil {
+			return fmt.Errorf("delete security group rule: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestRuleKey(t *testing.T) {
+	tests := []struct {
+		description string
+		rule        SecurityGroupRule
+		isValid     bool
+		expected    string
+	}{
+		{
+			description: "defaults",
+			rule:        SecurityGroupRule{Direction: "egress"},
+			isValid:     true,
+			expected:    "egress IPv4 any",
+		},
+		{
+			description: "all fields",
+			rule:        SecurityGroupRule{Direction: "ingress", EtherType: "IPv6", Protocol: "TCP", Ports: "8000-8080", IPRange: "::/0", Description: "ignored"},
+			isValid:     true,
+			expected:    "ingress IPv6 tcp 8000-8080 ::/0",
+		},
+		{
+			description: "invalid direction",
+			rule:        SecurityGroupRule{Direction: "inbound"},
+			isValid:     false,
+		},
+		{
+			description: "invalid ports",
+			rule:        SecurityGroupRule{Direction: "ingress", Ports: "http"},
+			isValid:     false,
+		},
+		{
+			description: "reversed port range",
+			rule:        SecurityGroupRule{Direction: "ingress", Ports: "443-80"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			key, err := tt.rule.key()
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if key != tt.expected {
+				t.Fatalf("expected key %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestRuleChanges(t *testing.T) {
+	live := []iaas.SecurityGroupRule{
+		{Id: utils.Ptr("egress"), Direction: utils.Ptr("egress"), Ethertype: utils.Ptr("IPv4")},
+		{
+			Id:        utils.Ptr("ssh"),
+			Direction: utils.Ptr("ingress"),
+			Ethertype: utils.Ptr("IPv4"),
+			Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp"), Number: utils.Ptr(int64(6))},
+			PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+		},
+	}
+	desired := []SecurityGroupRule{
+		{Direction: "egress"},
+		{Direction: "ingress", Protocol: "tcp", Ports: "443"},
+	}
+
+	create, deleteIds := ruleChanges(desired, live)
+	if diff := cmp.Diff(create, []SecurityGroupRule{{Direction: "ingress", Protocol: "tcp", Ports: "443"}}); diff != "" {
+		t.Fatalf("Rules to create do not match: %s", diff)
+	}
+	if diff := cmp.Diff(deleteIds, []string{"ssh"}); diff != "" {
+		t.Fatalf("Rules to delete do not match: %s", diff)
+	}
+}
+
+func TestSecurityGroupChanges(t *testing.T) {
+	live := &iaas.SecurityGroup{
+		Name:        utils.Ptr("web"),
+		Description: utils.Ptr("old"),
+		Stateful:    utils.Ptr(true),
+		Labels:      &map[string]interface{}{"env": "dev"},
+	}
+	tests := []struct {
+		description string
+		desired     *SecurityGroup
+		live        *iaas.SecurityGroup
+		isValid     bool
+		expected    []Change
+	}{
+		{
+			description: "create",
+			desired:     &SecurityGroup{Name: "web", Stateful: utils.Ptr(false), Rules: []SecurityGroupRule{{Direction: "egress"}}},
+			isValid:     true,
+			expected: []Change{
+				{Field: "stateful", Old: `""`, New: `"false"`},
+				{Field: "rules", Old: "[]", New: "[egress IPv4 any]"},
+			},
+		},
+		{
+			description: "no changes",
+			desired:     &SecurityGroup{Name: "web", Labels: map[string]string{"env": "dev"}},
+			live:        live,
+			isValid:     true,
+		},
+		{
+			description: "description changed",
+			desired:     &SecurityGroup{Name: "web", Description: "new", Rules: []SecurityGroupRule{}},
+			live:        live,
+			isValid:     true,
+			expected: []Change{
+				{Field: "description", Old: `"old"`, New: `"new"`},
+			},
+		},
+		{
+			description: "stateful changed",
+			desired:     &SecurityGroup{Name: "web", Stateful: utils.Ptr(false)},
+			live:        live,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes, err := securityGroupChanges(tt.desired, tt.live, nil)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(changes, tt.expected); diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+)
+
+const bootVolumeSourceImage = "image"
+
+func (pl *planner) planServers(ctx context.Context, m *Manifest) error {
+	if len(m.Servers) == 0 {
+		return nil
+	}
+	resp, err := pl.clients.IaaS.ListServers(ctx, pl.projectId).Details(true).Execute()
+	if err != nil {
+		return fmt.Errorf("get servers: %w", err)
+	}
+	servers := utils.GetSliceFromPointer(resp.Items)
+	live := map[string]*iaas.Server{}
+	names := []string{}
+	ids := []string{}
+	for i := range servers {
+		live[servers[i].GetId()] = &servers[i]
+		names = append(names, servers[i].GetName())
+		ids = append(ids, servers[i].GetId())
+	}
+	declared := []string{}
+	for i := range m.Servers {
+		declared = append(declared, m.Servers[i].Name)
+	}
+	liveServers, err := liveIds(kindServer, names, ids, declared)
+	if err != nil {
+		return err
+	}
+
+	for i := range m.Servers {
+		desired := &m.Servers[i]
+		id, exists := liveServers[desired.Name]
+		if isAbsent(desired.State) {
+			if exists {
+				pl.delete(kindServer, desired.Name, id, func(ctx context.Context) error {
+					return pl.deleteServer(ctx, id)
+				})
+			}
+			continue
+		}
+
+		refs, err := pl.serverReferences(desired)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if desired.MachineType == "" || desired.ImageId == "" {
+				return fmt.Errorf("%s %q: machineType and imageId are required to create it", kindServer, desired.Name)
+			}
+			changes, _ := serverChanges(desired, nil, "", nil)
+			pl.create(kindServer, desired.Name, changes, func(ctx context.Context) (string, error) {
+				return pl.createServer(ctx, desired, refs)
+			})
+			continue
+		}
+		networkId := ""
+		if refs.network != nil {
+			networkId = refs.network()
+		}
+		groups := serverSecurityGroupChanges(desired, refs, live[id], pl.liveSecurityGroups)
+		changes, err := serverChanges(desired, live[id], networkId, groups)
+		if err != nil {
+			return err
+		}
+		pl.update(kindServer, desired.Name, id, changes, func(ctx context.Context) error {
+			return pl.updateServer(ctx, id, desired, live[id], groups)
+		})
+	}
+	return nil
+}
+
+// serverRefs returns the IDs of the network and security groups referenced by a server
+type serverRefs struct {
+	network        func() string
+	securityGroups []func() string
+}
+
+func (pl *planner) serverReferences(desired *Server) (*serverRefs, error) {
+	refs := &serverRefs{}
+	if desired.Network != "" {
+		ref, err := pl.reference(kindNetwork, desired.Network, pl.declaredNetworks, pl.liveNetworks)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", kindServer, desired.Name, err)
+		}
+		refs.network = ref
+	}
+	for _, securityGroup := range desired.SecurityGroups {
+		ref, err := pl.reference(kindSecurityGroup, securityGroup, pl.declaredSecurityGroups, pl.liveSecurityGroups)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", kindServer, desired.Name, err)
+		}
+		refs.securityGroups = append(refs.securityGroups, ref)
+	}
+	return refs, nil
+}
+
+// serverSecurityGroups are the changes of the security groups of a live server
+type serverSecurityGroups struct {
+	// Names of the security groups of the live server, or their IDs if the names are unknown
+	live []string
+	// References to the declared security groups to add, as the IDs of those created by the plan aren't known yet
+	add []func() string
+	// IDs of the security groups to remove
+	remove []string
+}
+
+// serverSecurityGroupChanges compares the declared security groups of the server with those of the live one by their IDs.
+// The security groups are only managed if any are declared. The live security groups are mapped from their names to their IDs.
+func serverSecurityGroupChanges(desired *Server, refs *serverRefs, live *iaas.Server, liveSecurityGroups map[string]string) *serverSecurityGroups {
+	names := map[string]string{}
+	for name, id := range liveSecurityGroups {
+		names[id] = name
+	}
+	groups := &serverSecurityGroups{}
+	liveIds := []string{}
+	for _, group := range utils.GetSliceFromPointer(live.SecurityGroups) {
+		// The server may list its security groups by name
+		id := group
+		if groupId, ok := liveSecurityGroups[group]; ok {
+			id = groupId
+		}
+		liveIds = append(liveIds, id)
+		if name, ok := names[id]; ok {
+			group = name
+		}
+		groups.live = append(groups.live, group)
+	}
+	if len(desired.SecurityGroups) == 0 {
+		return groups
+	}
+
+	desiredIds := map[string]bool{}
+	for _, ref := range refs.securityGroups {
+		id := ref()
+		// Security groups created by the plan have no ID yet
+		if id == "" || !slices.Contains(liveIds, id) {
+			groups.add = append(groups.add, ref)
+		}
+		desiredIds[id] = true
+	}
+	for _, id := range liveIds {
+		if !desiredIds[id] {
+			groups.remove = append(groups.remove, id)
+		}
+	}
+	return groups
+}
+
+// serverChanges returns the changes of the declared server compared to the live one, which is nil if it doesn't exist.
+// The ID of the declared network is empty if it doesn't exist yet, the changes of the security groups are nil then.
+func serverChanges(desired *Server, live *iaas.Server, networkId string, groups *serverSecurityGroups) ([]Change, error) {
+	if live == nil {
+		changes := changeString(nil, "machineType", "", desired.MachineType)
+		changes = changeString(changes, "availabilityZone", "", desired.AvailabilityZone)
+		changes = changeString(changes, "imageId", "", desired.ImageId)
+		changes = changeInt(changes, "bootVolumeSize", nil, desired.BootVolumeSize)
+		changes = changeString(changes, "keypairName", "", desired.KeypairName)
+		changes = changeString(changes, "network", "", desired.Network)
+		changes = changeSet(changes, "securityGroups", nil, desired.SecurityGroups)
+		return changeLabels(changes, nil, desired.Labels), nil
+	}
+
+	immutable := []struct {
+		field   string
+		desired string
+		live    string
+	}{
+		{"availabilityZone", desired.AvailabilityZone, live.GetAvailabilityZone()},
+		{"imageId", desired.ImageId, live.GetImageId()},
+		{"keypairName", desired.KeypairName, live.GetKeypairName()},
+	}
+	for _, f := range immutable {
+		if f.desired != "" && f.live != "" && f.desired != f.live {
+			return nil, immutableError(kindServer, desired.Name, f.field)
+		}
+	}
+	if desired.Network != "" && !isAttached(live, networkId) {
+		return nil, immutableError(kindServer, desired.Name, "network")
+	}
+
+	var changes []Change
+	if desired.MachineType != "" {
+		changes = changeString(changes, "machineType", live.GetMachineType(), desired.MachineType)
+	}
+	if groups != nil && (len(groups.add) > 0 || len(groups.remove) > 0) {
+		changes = append(changes, Change{Field: "securityGroups", Old: formatList(groups.live), New: formatList(desired.SecurityGroups)})
+	}
+	return changeLabels(changes, labelsFromAPI(utils.PtrValue(live.Labels)), desired.Labels), nil
+}
+
+func isAttached(server *iaas.Server, networkId string) bool {
+	if networkId == "" {
+		return false
+	}
+	nics := utils.GetSliceFromPointer(server.Nics)
+	if len(nics) == 0 {
+		// The network interfaces are unknown
+		return true
+	}
+	for i := range nics {
+		if nics[i].GetNetworkId() == networkId {
+			return true
+		}
+	}
+	return false
+}
+
+func (pl *planner) createServer(ctx context.Context, desired *Server, refs *serverRefs) (string, error) {
+	payload := iaas.CreateServerPayload{
+		Name:             utils.Ptr(desired.Name),
+		MachineType:      utils.Ptr(desired.MachineType),
+		AvailabilityZone: optionalString(desired.AvailabilityZone),
+		KeypairName:      optionalString(desired.KeypairName),
+		Labels:           utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+	}
+	if desired.BootVolumeSize != nil {
+		payload.BootVolume = &iaas.CreateServerPayloadBootVolume{
+			Size:                desired.BootVolumeSize,
+			DeleteOnTermination: utils.Ptr(true),
+			Source: &iaas.BootVolumeSource{
+				Id:   utils.Ptr(desired.ImageId),
+				Type: utils.Ptr(bootVolumeSourceImage),
+			},
+		}
+	} else {
+		payload.ImageId = utils.Ptr(desired.ImageId)
+	}
+	if refs.network != nil {
+		payload.Networking = &iaas.CreateServerPayloadNetworking{
+			CreateServerNetworking: &iaas.CreateServerNetworking{
+				NetworkId: utils.Ptr(refs.network()),
+			},
+		}
+	}
+
+	resp, err := pl.clients.IaaS.CreateServer(ctx, pl.projectId).CreateServerPayload(payload).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create server: %w", err)
+	}
+	id := resp.GetId()
+	_, err = wait.CreateServerWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return id, fmt.Errorf("wait for server creation: %w", err)
+	}
+	for _, securityGroup := range refs.securityGroups {
+		err = pl.clients.IaaS.AddSecurityGroupToServerExecute(ctx, pl.projectId, id, securityGroup())
+		if err != nil {
+			return id, fmt.Errorf("add security group to server: %w", err)
+		}
+	}
+	return id, nil
+}
+
+func (pl *planner) updateServer(ctx context.Context, id string, desired *Server, live *iaas.Server, groups *serverSecurityGroups) error {
+	if len(changeLabels(nil, labelsFromAPI(utils.PtrValue(live.Labels)), desired.Labels)) > 0 {
+		_, err := pl.clients.IaaS.UpdateServer(ctx, pl.projectId, id).UpdateServerPayload(iaas.UpdateServerPayload{
+			Labels: utils.ConvertStringMapToInterfaceMap(&desired.Labels),
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("update server: %w", err)
+		}
+	}
+	if desired.MachineType != "" && desired.MachineType != live.GetMachineType() {
+		err := pl.clients.IaaS.ResizeServer(ctx, pl.projectId, id).ResizeServerPayload(iaas.ResizeServerPayload{
+			MachineType: utils.Ptr(desired.MachineType),
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("resize server: %w", err)
+		}
+		_, err = wait.ResizeServerWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for server resize: %w", err)
+		}
+	}
+	for _, securityGroup := range groups.add {
+		err := pl.clients.IaaS.AddSecurityGroupToServerExecute(ctx, pl.projectId, id, securityGroup())
+		if err != nil {
+			return fmt.Errorf("add security group to server: %w", err)
+		}
+	}
+	for _, securityGroupId := range groups.remove {
+		err := pl.clients.IaaS.RemoveSecurityGroupFromServerExecute(ctx, pl.projectId, id, securityGroupId)
+		if err != nil {
+			return fmt.Errorf("remove security group from server: %w", err)
+		}
+	}
+	return nil
+}
+
+func (pl *planner) deleteServer(ctx context.Context, id string) error {
+	err := pl.clients.IaaS.DeleteServerExecute(ctx, pl.projectId, id)
+	if err != nil {
+		return fmt.Errorf("delete server: %w", err)
+	}
+	_, err = wait.DeleteServerWaitHandler(ctx, pl.clients.IaaS, pl.projectId, id).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for server deletion: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestServerChanges(t *testing.T) {
+	live := &iaas.Server{
+		Name:             utils.Ptr("vm"),
+		MachineType:      utils.Ptr("g1.1"),
+		AvailabilityZone: utils.Ptr("eu01-1"),
+		ImageId:          utils.Ptr("image"),
+		Labels:           &map[string]interface{}{"env": "dev"},
+		Nics:             &[]iaas.ServerNetwork{{NetworkId: utils.Ptr("net")}},
+	}
+	tests := []struct {
+		description string
+		desired     *Server
+		live        *iaas.Server
+		networkId   string
+		groups      *serverSecurityGroups
+		isValid     bool
+		expected    []Change
+	}{
+		{
+			description: "create",
+			desired:     &Server{Name: "vm", MachineType: "g1.1", ImageId: "image", BootVolumeSize: utils.Ptr(int64(20))},
+			isValid:     true,
+			expected: []Change{
+				{Field: "machineType", Old: `""`, New: `"g1.1"`},
+				{Field: "imageId", Old: `""`, New: `"image"`},
+				{Field: "bootVolumeSize", Old: "-", New: "20"},
+			},
+		},
+		{
+			description: "no changes",
+			desired:     &Server{Name: "vm", MachineType: "g1.1", AvailabilityZone: "eu01-1", Network: "net"},
+			live:        live,
+			networkId:   "net",
+			isValid:     true,
+		},
+		{
+			description: "machine type and labels changed",
+			desired:     &Server{Name: "vm", MachineType: "g1.2", Labels: map[string]string{"env": "prod"}},
+			live:        live,
+			isValid:     true,
+			expected: []Change{
+				{Field: "machineType", Old: `"g1.1"`, New: `"g1.2"`},
+				{Field: "labels", Old: "[env=dev]", New: "[env=prod]"},
+			},
+		},
+		{
+			description: "security groups changed",
+			desired:     &Server{Name: "vm", SecurityGroups: []string{"web", "new"}},
+			live:        live,
+			groups: &serverSecurityGroups{
+				live:   []string{"default", "web"},
+				add:    []func() string{func() string { return "" }},
+				remove: []string{"default-id"},
+			},
+			isValid: true,
+			expected: []Change{
+				{Field: "securityGroups", Old: "[default, web]", New: "[new, web]"},
+			},
+		},
+		{
+			description: "security groups unchanged",
+			desired:     &Server{Name: "vm", SecurityGroups: []string{"web"}},
+			live:        live,
+			groups:      &serverSecurityGroups{live: []string{"web"}},
+			isValid:     true,
+		},
+		{
+			description: "image changed",
+			desired:     &Server{Name: "vm", ImageId: "other"},
+			live:        live,
+			isValid:     false,
+		},
+		{
+			description: "network changed",
+			desired:     &Server{Name: "vm", Network: "other"},
+			live:        live,
+			networkId:   "other",
+			isValid:     false,
+		},
+		{
+			description: "network created by the plan",
+			desired:     &Server{Name: "vm", Network: "new"},
+			live:        live,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changes, err := serverChanges(tt.desired, tt.live, tt.networkId, tt.groups)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(changes, tt.expected); diff != "" {
+				t.Fatalf("Changes do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerSecurityGroupChanges(t *testing.T) {
+	liveSecurityGroups := map[string]string{"default": "default-id", "web": "web-id", "db": "db-id"}
+	ref := func(id string) func() string { return func() string { return id } }
+
+	tests := []struct {
+		description    string
+		desired        *Server
+		refs           *serverRefs
+		live           []string
+		expectedLive   []string
+		expectedAdd    []string
+		expectedRemove []string
+	}{
+		{
+			description:  "not declared",
+			desired:      &Server{Name: "vm"},
+			refs:         &serverRefs{},
+			live:         []string{"default-id"},
+			expectedLive: []string{"default"},
+		},
+		{
+			description:  "unchanged",
+			desired:      &Server{Name: "vm", SecurityGroups: []string{"web", "db-id"}},
+			refs:         &serverRefs{securityGroups: []func() string{ref("web-id"), ref("db-id")}},
+			live:         []string{"db-id", "web-id"},
+			expectedLive: []string{"db", "web"},
+		},
+		{
+			description:    "added and removed",
+			desired:        &Server{Name: "vm", SecurityGroups: []string{"web", "new"}},
+			refs:           &serverRefs{securityGroups: []func() string{ref("web-id"), ref("")}},
+			live:           []string{"default-id", "web-id"},
+			expectedLive:   []string{"default", "web"},
+			expectedAdd:    []string{""},
+			expectedRemove: []string{"default-id"},
+		},
+		{
+			description:    "live security groups listed by name",
+			desired:        &Server{Name: "vm", SecurityGroups: []string{"db"}},
+			refs:           &serverRefs{securityGroups: []func() string{ref("db-id")}},
+			live:           []string{"web", "unknown-id"},
+			expectedLive:   []string{"web", "unknown-id"},
+			expectedAdd:    []string{"db-id"},
+			expectedRemove: []string{"web-id", "unknown-id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			live := &iaas.Server{Name: utils.Ptr("vm"), SecurityGroups: &tt.live}
+			groups := serverSecurityGroupChanges(tt.desired, tt.refs, live, liveSecurityGroups)
+			if diff := cmp.Diff(groups.live, tt.expectedLive); diff != "" {
+				t.Errorf("Live security groups do not match: %s", diff)
+			}
+			var add []string
+			for _, ref := range groups.add {
+				add = append(add, ref())
+			}
+			if diff := cmp.Diff(add, tt.expectedAdd); diff != "" {
+				t.Errorf("Added security groups do not match: %s", diff)
+			}
+			if diff := cmp.Diff(groups.remove, tt.expectedRemove); diff != "" {
+				t.Errorf("Removed security groups do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package args
 
 import (
+	"fmt"
+
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 
 	"github.com/spf13/cobra"
@@ -67,3 +69,38 @@ func SingleOptionalArg(argName string, validate func(value string) error) cobra.
 		return nil
 	}
 }
+
+// MinimumArgs checks if a non-empty argument was provided for each of the
+// argument names. Any number of additional arguments is accepted, so the last
+// argument name can describe a variadic argument.
+func MinimumArgs(argNames ...string) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		for i, argName := range argNames {
+			if i >= len(args) || args[i] == "" {
+				return &errors.ArgMissingError{
+					Cmd:      cmd,
+					Expected: argName,
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// SingleArgUnlessFlag behaves like SingleArg, unless the flag is set, e.g. a selector
+// that replaces the argument. In that case no arguments are accepted.
+func SingleArgUnlessFlag(argName, flagName string, validate func(value string) error) cobra.PositionalArgs {
+	singleArg := SingleArg(argName, validate)
+	return func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed(flagName) {
+			return singleArg(cmd, args)
+		}
+		if len(args) > 0 {
+			return &errors.FlagValidationError{
+				Flag:    flagName,
+				Details: fmt.Sprintf("it can't be combined with the argument %s", argName),
+			}
+		}
+		return nil
+	}
+}
@@ -178,3 +178,122 @@ func TestSingleOptionalArg(t *testing.T) {
 		})
 	}
 }
+
+func TestMinimumArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		isValid     bool
+	}{
+		{
+			description: "valid",
+			args:        []string{"arg", "arg2"},
+			isValid:     true,
+		},
+		{
+			description: "additional_args",
+			args:        []string{"arg", "arg2", "arg3"},
+			isValid:     true,
+		},
+		{
+			description: "no_arg",
+			args:        []string{},
+			isValid:     false,
+		},
+		{
+			description: "missing_arg",
+			args:        []string{"arg"},
+			isValid:     false,
+		},
+		{
+			description: "empty_arg",
+			args:        []string{"arg", ""},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use:   "test",
+				Short: "Test command",
+			}
+
+			argFunction := MinimumArgs("test", "test2")
+			err := argFunction(cmd, tt.args)
+
+			if tt.isValid && err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("should have failed")
+			}
+		})
+	}
+}
+
+func TestSingleArgUnlessFlag(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		flagValue   string
+		isValid     bool
+	}{
+		{
+			description: "arg",
+			args:        []string{"arg"},
+			isValid:     true,
+		},
+		{
+			description: "no_arg",
+			args:        []string{},
+			isValid:     false,
+		},
+		{
+			description: "flag",
+			args:        []string{},
+			flagValue:   "env=test",
+			isValid:     true,
+		},
+		{
+			description: "arg_and_flag",
+			args:        []string{"arg"},
+			flagValue:   "env=test",
+			isValid:     false,
+		},
+		{
+			description: "invalid_arg",
+			args:        []string{"invalid"},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use:   "test",
+				Short: "Test command",
+			}
+			cmd.Flags().String("selector", "", "")
+			if tt.flagValue != "" {
+				err := cmd.Flags().Set("selector", tt.flagValue)
+				if err != nil {
+					t.Fatalf("set flag: %v", err)
+				}
+			}
+
+			argFunction := SingleArgUnlessFlag("test", "selector", func(value string) error {
+				if value == "invalid" {
+					return fmt.Errorf("invalid value")
+				}
+				return nil
+			})
+			err := argFunction(cmd, tt.args)
+
+			if tt.isValid && err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("should have failed")
+			}
+		})
+	}
+}
@@ -108,6 +108,38 @@ func GetAccessToken() (string, error) {
 	return accessToken, nil
 }
 
+// GetAccessTokenFromEnv returns the access token set in the environment variable STACKIT_ACCESS_TOKEN, if any
+func GetAccessTokenFromEnv() (string, bool) {
+	accessToken := os.Getenv(envAccessTokenName)
+	return accessToken, accessToken != ""
+}
+
+// GetTokenTimes returns when the access token was issued and when it expires.
+// The times are zero if the token doesn't contain them.
+func GetTokenTimes(token string) (issuedAt, expiresAt time.Time, err error) {
+	// We can safely use ParseUnverified because we are not authenticating the user at this point.
+	// We're just reading the timestamps
+	tokenParsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("parse access token: %w", err)
+	}
+	issuedAtNumeric, err := tokenParsed.Claims.GetIssuedAt()
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("get issued at timestamp from access token: %w", err)
+	}
+	if issuedAtNumeric != nil {
+		issuedAt = issuedAtNumeric.Time
+	}
+	expiresAtNumeric, err := tokenParsed.Claims.GetExpirationTime()
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("get expiration timestamp from access token: %w", err)
+	}
+	if expiresAtNumeric != nil {
+		expiresAt = expiresAtNumeric.Time
+	}
+	return issuedAt, expiresAt, nil
+}
+
 func getStartingSessionExpiresAtUnix() (string, error) {
 	sessionStart := time.Now()
 	sessionTimeLimitString := viper.GetString(config.SessionTimeLimitKey)
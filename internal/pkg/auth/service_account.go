@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/clients"
@@ -127,6 +129,7 @@ func initKeyFlowWithStorage() (*keyFlowWithStorage, error) {
 		ServiceAccountKey: serviceAccountKey,
 		PrivateKey:        authFields[PRIVATE_KEY],
 		TokenUrl:          authFields[TOKEN_CUSTOM_ENDPOINT],
+		HTTPTransport:     har.Transport(cassette.Transport(http.DefaultTransport)),
 	}
 
 	keyFlow := &clients.KeyFlow{}
@@ -316,6 +316,51 @@ func createEncodedTextFile(activeProfile string) error {
 	return nil
 }
 
+// Storages of the credentials
+const (
+	StorageKeyring  = "keyring"
+	StorageTextFile = "text file"
+)
+
+// StorageStatus describes where the credentials of a profile are stored
+type StorageStatus struct {
+	// Error of accessing the keyring of the system, nil if it is available
+	KeyringErr error
+	// Storage holding the credentials of the profile, empty if it holds none
+	Storage string
+	// Path of the encoded text file used as fallback if the keyring is not available
+	TextFilePath string
+}
+
+// GetStorageStatus returns whether the keyring is available and where the credentials of the profile are stored.
+// Unlike reading an auth field, it doesn't create the encoded text file.
+func GetStorageStatus(profile string) (*StorageStatus, error) {
+	status := &StorageStatus{
+		TextFilePath: filepath.Join(config.GetProfileFolderPath(profile), textFileName),
+	}
+	_, err := getAuthFieldFromKeyring(profile, authFlowType)
+	if err == nil {
+		status.Storage = StorageKeyring
+		return status, nil
+	}
+	if !errors.Is(err, keyring.ErrNotFound) {
+		status.KeyringErr = err
+	}
+
+	_, err = os.Stat(status.TextFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return status, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("check encoded text file: %w", err)
+	}
+	_, err = getAuthFieldFromEncodedTextFile(profile, authFlowType)
+	if err == nil {
+		status.Storage = StorageTextFile
+	}
+	return status, nil
+}
+
 // GetProfileEmail returns the email of the user or service account associated with the given profile.
 // If the profile is not authenticated or the email can't be obtained, it returns an empty string.
 func GetProfileEmail(profile string) string {
@@ -326,26 +326,40 @@ func cleanup(server *http.Server) {
 
 func openBrowser(pageUrl string) error {
 	var err error
+	switch BrowserCommand() {
+	case "xdg-open":
+		err = exec.Command("xdg-open", pageUrl).Start()
+	case "rundll32.exe":
+		err = exec.Command("rundll32.exe", "url.dll,FileProtocolHandler", pageUrl).Start()
+	case "open":
+		err = exec.Command("open", pageUrl).Start()
+	default:
+		err = fmt.Errorf("unsupported platform")
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// BrowserCommand returns the command used to open pages in the browser, e.g. for the login.
+// It returns an empty string if the platform is not supported.
+func BrowserCommand() string {
 	switch runtime.GOOS {
 	case "linux":
 		// We need to use the windows way on WSL, otherwise we do not pass query
 		// parameters correctly. https://github.com/microsoft/WSL/issues/3832
 		if _, ok := os.LookupEnv("WSL_DISTRO_NAME"); !ok {
-			err = exec.Command("xdg-open", pageUrl).Start()
-			break
+			return "xdg-open"
 		}
-		fallthrough
+		return "rundll32.exe"
 	case "windows":
-		err = exec.Command("rundll32.exe", "url.dll,FileProtocolHandler", pageUrl).Start()
+		return "rundll32.exe"
 	case "darwin":
-		err = exec.Command("open", pageUrl).Start()
+		return "open"
 	default:
-		err = fmt.Errorf("unsupported platform")
+		return ""
 	}
-	if err != nil {
-		return err
-	}
-	return nil
 }
 
 // parseWellKnownConfiguration gets the well-known OpenID configuration from the provided URL and returns it as a JSON
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 )
 
@@ -30,7 +32,7 @@ func UserTokenFlow(p *print.Printer) *userTokenFlow {
 	return &userTokenFlow{
 		printer:                p,
 		reauthorizeUserRoutine: AuthorizeUser,
-		client:                 &http.Client{},
+		client:                 &http.Client{Transport: har.Transport(cassette.Transport(http.DefaultTransport))},
 	}
 }
 
@@ -0,0 +1,217 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	LabelSelectorFlag = "label-selector"
+	ConcurrencyFlag   = "concurrency"
+
+	// DefaultConcurrency is the default number of items the operation is run for at the same time
+	DefaultConcurrency = 5
+
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Item is a resource matched by a selector, which the operation is run for
+type Item struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (i Item) label() string {
+	if i.Name == "" {
+		return i.Id
+	}
+	return fmt.Sprintf("%s (%s)", i.Name, i.Id)
+}
+
+// Result is the outcome of the operation for an item
+type Result struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Operation runs the API call for an item and waits for it to complete
+type Operation func(ctx context.Context, item Item) error
+
+// ListFunc lists the items matched by the label selector
+type ListFunc func(ctx context.Context) ([]Item, error)
+
+// Selection is an action run for all resources matched by a label selector, e.g. deleting servers
+type Selection struct {
+	// Action and resource as used in the confirmation prompt, e.g. "delete" and "server"
+	Action   string
+	Resource string
+	// Message of the spinner while the operation runs, e.g. "Deleting"
+	Progress      string
+	LabelSelector string
+	Concurrency   int64
+}
+
+// FailedError is returned if the operation failed for some of the items, so that the command exits with an error
+type FailedError struct {
+	Failed int
+	Total  int
+}
+
+func (e *FailedError) Error() string {
+	return fmt.Sprintf("the operation failed for %d of %d items", e.Failed, e.Total)
+}
+
+// ConfigureFlags adds the flags selecting the resources the action is run for instead of a single resource
+func ConfigureFlags(cmd *cobra.Command, action, resource string) {
+	cmd.Flags().String(LabelSelectorFlag, "", fmt.Sprintf("%s all %ss matching the label selector instead of a single %s, e.g. \"env=test\"", strings.ToUpper(action[:1])+action[1:], resource, resource))
+	cmd.Flags().Int64(ConcurrencyFlag, DefaultConcurrency, fmt.Sprintf("Maximum number of %ss to %s at the same time, used with --label-selector", resource, action))
+}
+
+// ParseFlags returns the label selector, which is nil if the flag isn't set, and the concurrency of the flags added by ConfigureFlags
+func ParseFlags(p *print.Printer, cmd *cobra.Command) (labelSelector *string, concurrency int64, err error) {
+	labelSelector = flags.FlagToStringPointer(p, cmd, LabelSelectorFlag)
+	if labelSelector != nil && strings.TrimSpace(*labelSelector) == "" {
+		return nil, 0, &errors.FlagValidationError{
+			Flag:    LabelSelectorFlag,
+			Details: "must not be empty, as it would match all resources",
+		}
+	}
+	concurrency = flags.FlagWithDefaultToInt64Value(p, cmd, ConcurrencyFlag)
+	if concurrency < 1 {
+		return nil, 0, &errors.FlagValidationError{
+			Flag:    ConcurrencyFlag,
+			Details: "must be at least 1",
+		}
+	}
+	return labelSelector, concurrency, nil
+}
+
+// RunSelected lists the resources matched by the label selector and runs the operation for them after a single confirmation.
+// The result for each resource is printed and a FailedError returned if the operation failed for any of them.
+func RunSelected(ctx context.Context, p *print.Printer, globalFlags *globalflags.GlobalFlagModel, selection Selection, list ListFunc, operation Operation) error {
+	items, err := list(ctx)
+	if err != nil {
+		return fmt.Errorf("list %ss: %w", selection.Resource, err)
+	}
+	confirmed, err := Confirm(p, selection.Action, selection.Resource, selection.LabelSelector, items, globalFlags.AssumeYes)
+	if err != nil || !confirmed {
+		return err
+	}
+
+	s := spinner.New(p)
+	s.Start(fmt.Sprintf("%s %d %s(s)", selection.Progress, len(items), selection.Resource))
+	results := Run(ctx, items, int(selection.Concurrency), operation)
+	s.Stop()
+
+	err = OutputResults(p, globalFlags.OutputFormat, results)
+	if err != nil {
+		return err
+	}
+	return Err(results)
+}
+
+// Confirm prints the items matched by the selector and asks for a single confirmation of the action,
+// e.g. "delete", unless assumeYes is set. It returns false if there are no items to run the action for.
+func Confirm(p *print.Printer, action, resource, selector string, items []Item, assumeYes bool) (bool, error) {
+	if len(items) == 0 {
+		p.Info("No %ss match the selector %q\n", resource, selector)
+		return false, nil
+	}
+	p.Info("The selector %q matches %d %s(s):\n", selector, len(items), resource)
+	for _, item := range items {
+		p.Info("  - %s\n", item.label())
+	}
+	if assumeYes {
+		return true, nil
+	}
+	prompt := fmt.Sprintf("Are you sure you want to %s %d %s(s)?", action, len(items), resource)
+	err := p.PromptForConfirmation(prompt)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Run runs the operation for the items, with at most concurrency operations at the same time.
+// The results are in the order of the items.
+func Run(ctx context.Context, items []Item, concurrency int, operation Operation) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]Result, len(items))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = Result{Id: items[i].Id, Name: items[i].Name, Status: StatusSucceeded}
+			err := operation(ctx, items[i])
+			if err != nil {
+				results[i].Status = StatusFailed
+				results[i].Error = strings.TrimSpace(err.Error())
+			}
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// Err returns a FailedError if the operation failed for any of the items
+func Err(results []Result) error {
+	// In a dry run, the operations fail as the requests are not sent, which are printed instead
+	if dryrun.IsActive() {
+		return nil
+	}
+	failed := 0
+	for _, r := range results {
+		if r.Status == StatusFailed {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return &FailedError{Failed: failed, Total: len(results)}
+}
+
+var resultFields = []tables.Field[Result]{
+	{Header: "ID", Value: func(r *Result) any { return r.Id }},
+	{Header: "Name", Value: func(r *Result) any { return r.Name }},
+	{Header: "Status", Value: func(r *Result) any { return r.Status }},
+	{Header: "Error", Value: func(r *Result) any { return r.Error }},
+}
+
+// OutputResults prints the result of the operation for each item
+func OutputResults(p *print.Printer, outputFormat string, results []Result) error {
+	if dryrun.IsActive() {
+		return nil
+	}
+	return p.OutputResult(outputFormat, results, func() error {
+		table := tables.NewTableFromFields(resultFields, results)
+		err := table.Display(p, outputFormat)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,251 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/cobra"
+)
+
+var testItems = []Item{
+	{Id: "id-1", Name: "vm-1"},
+	{Id: "id-2", Name: "vm-2"},
+	{Id: "id-3"},
+	{Id: "id-4", Name: "vm-4"},
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		description     string
+		concurrency     int
+		failedIds       map[string]bool
+		expectedResults []Result
+		expectedErr     bool
+	}{
+		{
+			description: "base",
+			concurrency: 2,
+			expectedResults: []Result{
+				{Id: "id-1", Name: "vm-1", Status: StatusSucceeded},
+				{Id: "id-2", Name: "vm-2", Status: StatusSucceeded},
+				{Id: "id-3", Status: StatusSucceeded},
+				{Id: "id-4", Name: "vm-4", Status: StatusSucceeded},
+			},
+		},
+		{
+			description: "failed items",
+			concurrency: 4,
+			failedIds:   map[string]bool{"id-2": true, "id-3": true},
+			expectedResults: []Result{
+				{Id: "id-1", Name: "vm-1", Status: StatusSucceeded},
+				{Id: "id-2", Name: "vm-2", Status: StatusFailed, Error: "failed id-2"},
+				{Id: "id-3", Status: StatusFailed, Error: "failed id-3"},
+				{Id: "id-4", Name: "vm-4", Status: StatusSucceeded},
+			},
+			expectedErr: true,
+		},
+		{
+			description: "sequential",
+			concurrency: 0,
+			expectedResults: []Result{
+				{Id: "id-1", Name: "vm-1", Status: StatusSucceeded},
+				{Id: "id-2", Name: "vm-2", Status: StatusSucceeded},
+				{Id: "id-3", Status: StatusSucceeded},
+				{Id: "id-4", Name: "vm-4", Status: StatusSucceeded},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var mu sync.Mutex
+			running, maxRunning := 0, 0
+			results := Run(context.Background(), testItems, tt.concurrency, func(_ context.Context, item Item) error {
+				mu.Lock()
+				running++
+				maxRunning = max(maxRunning, running)
+				mu.Unlock()
+				time.Sleep(10 * time.Millisecond)
+				mu.Lock()
+				running--
+				mu.Unlock()
+
+				if tt.failedIds[item.Id] {
+					return fmt.Errorf("failed %s", item.Id)
+				}
+				return nil
+			})
+
+			diff := cmp.Diff(results, tt.expectedResults)
+			if diff != "" {
+				t.Fatalf("Results do not match: %s", diff)
+			}
+			if maxRunning > max(tt.concurrency, 1) {
+				t.Fatalf("expected at most %d concurrent operations, got %d", tt.concurrency, maxRunning)
+			}
+			err := Err(results)
+			if tt.expectedErr && err == nil {
+				t.Fatalf("expected an error for the failed items")
+			}
+			if !tt.expectedErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		description           string
+		flagValues            map[string]string
+		isValid               bool
+		expectedLabelSelector *string
+		expectedConcurrency   int64
+	}{
+		{
+			description:         "no selector",
+			flagValues:          map[string]string{},
+			isValid:             true,
+			expectedConcurrency: DefaultConcurrency,
+		},
+		{
+			description: "selector and concurrency",
+			flagValues: map[string]string{
+				LabelSelectorFlag: "env=test",
+				ConcurrencyFlag:   "10",
+			},
+			isValid:               true,
+			expectedLabelSelector: utils.Ptr("env=test"),
+			expectedConcurrency:   10,
+		},
+		{
+			description: "empty selector",
+			flagValues: map[string]string{
+				LabelSelectorFlag: "",
+			},
+			isValid: false,
+		},
+		{
+			description: "whitespace selector",
+			flagValues: map[string]string{
+				LabelSelectorFlag: "  ",
+			},
+			isValid: false,
+		},
+		{
+			description: "concurrency invalid",
+			flagValues: map[string]string{
+				LabelSelectorFlag: "env=test",
+				ConcurrencyFlag:   "0",
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			cmd := &cobra.Command{}
+			ConfigureFlags(cmd, "delete", "server")
+			for flag, value := range tt.flagValues {
+				err := cmd.Flags().Set(flag, value)
+				if err != nil {
+					t.Fatalf("setting flag --%s=%s: %v", flag, value, err)
+				}
+			}
+
+			labelSelector, concurrency, err := ParseFlags(p, cmd)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(labelSelector, tt.expectedLabelSelector); diff != "" {
+				t.Fatalf("Label selector does not match: %s", diff)
+			}
+			if concurrency != tt.expectedConcurrency {
+				t.Fatalf("expected concurrency %d, got %d", tt.expectedConcurrency, concurrency)
+			}
+		})
+	}
+}
+
+func TestRunSelected(t *testing.T) {
+	tests := []struct {
+		description string
+		items       []Item
+		listErr     error
+		failedIds   map[string]bool
+		expectedIds []string
+		isValid     bool
+	}{
+		{
+			description: "base",
+			items:       testItems,
+			expectedIds: []string{"id-1", "id-2", "id-3", "id-4"},
+			isValid:     true,
+		},
+		{
+			description: "no items",
+			items:       []Item{},
+			isValid:     true,
+		},
+		{
+			description: "list fails",
+			listErr:     fmt.Errorf("list failed"),
+			isValid:     false,
+		},
+		{
+			description: "operation fails",
+			items:       testItems,
+			failedIds:   map[string]bool{"id-2": true},
+			expectedIds: []string{"id-1", "id-2", "id-3", "id-4"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			p.Cmd = &cobra.Command{}
+			globalFlags := &globalflags.GlobalFlagModel{AssumeYes: true, OutputFormat: print.JSONOutputFormat}
+			selection := Selection{Action: "delete", Resource: "server", Progress: "Deleting", LabelSelector: "env=test", Concurrency: 2}
+
+			var mu sync.Mutex
+			ids := []string{}
+			err := RunSelected(context.Background(), p, globalFlags, selection, func(context.Context) ([]Item, error) {
+				return tt.items, tt.listErr
+			}, func(_ context.Context, item Item) error {
+				mu.Lock()
+				ids = append(ids, item.Id)
+				mu.Unlock()
+				if tt.failedIds[item.Id] {
+					return fmt.Errorf("failed %s", item.Id)
+				}
+				return nil
+			})
+			if tt.isValid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected an error")
+			}
+			if diff := cmp.Diff(ids, tt.expectedIds, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("Operated items do not match: %s", diff)
+			}
+		})
+	}
+}
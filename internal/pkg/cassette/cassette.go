@@ -0,0 +1,343 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/redact"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileExtension = ".json"
+	filePerm      = 0o600
+	dirPerm       = 0o700
+)
+
+type mode int
+
+const (
+	modeRecord mode = iota + 1
+	modeReplay
+)
+
+// Interaction is a sanitized HTTP exchange, stored as one JSON file of a cassette directory
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassette struct {
+	p    *print.Printer
+	mode mode
+	dir  string
+
+	mu sync.Mutex
+	// Number of the next interaction file, when recording
+	next int
+	// Interactions loaded from the directory and whether they were served already, when replaying
+	interactions []*Interaction
+	served       []bool
+}
+
+// Cassette of the current invocation, nil if API traffic is neither recorded nor replayed
+var active *cassette
+
+// Key of the context value set on the requests handled by the middleware, so that they are not handled again by the transport
+type handledKey struct{}
+
+// Start records or replays the API traffic of the command, according to the --record-dir and --replay-dir flags
+func Start(p *print.Printer, cmd *cobra.Command) error {
+	// A command run before in the interactive shell may have left a cassette active
+	Stop()
+
+	recordDir := flags.FlagToStringValue(p, cmd, globalflags.RecordFlag)
+	replayDir := flags.FlagToStringValue(p, cmd, globalflags.ReplayFlag)
+	switch {
+	case recordDir != "" && replayDir != "":
+		return &errors.FlagValidationError{
+			Flag:    globalflags.RecordFlag,
+			Details: fmt.Sprintf("cannot be used together with --%s", globalflags.ReplayFlag),
+		}
+	case recordDir != "":
+		return Record(p, recordDir)
+	case replayDir != "":
+		return Replay(p, replayDir)
+	}
+	return nil
+}
+
+// Record saves the API traffic of the invocation to the directory, after the interactions already stored there
+func Record(p *print.Printer, dir string) error {
+	err := os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return fmt.Errorf("create cassette directory: %w", err)
+	}
+	files, err := listFiles(dir)
+	if err != nil {
+		return err
+	}
+	p.Debug(print.DebugLevel, "recording API traffic to %q", dir)
+	active = &cassette{
+		p:    p,
+		mode: modeRecord,
+		dir:  dir,
+		next: len(files) + 1,
+	}
+	return nil
+}
+
+// Replay serves the API traffic of the invocation from the interactions stored in the directory, without calling the API
+func Replay(p *print.Printer, dir string) error {
+	files, err := listFiles(dir)
+	if err != nil {
+		return err
+	}
+	interactions := make([]*Interaction, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read cassette: %w", err)
+		}
+		interaction := &Interaction{}
+		err = json.Unmarshal(content, interaction)
+		if err != nil {
+			return fmt.Errorf("parse cassette %q: %w", file, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+	p.Debug(print.DebugLevel, "replaying %d recorded interactions from %q", len(interactions), dir)
+	active = &cassette{
+		p:            p,
+		mode:         modeReplay,
+		dir:          dir,
+		interactions: interactions,
+		served:       make([]bool, len(interactions)),
+	}
+	return nil
+}
+
+// Stop ends the recording or replaying of the API traffic
+func Stop() {
+	active = nil
+}
+
+// IsActive returns whether the API traffic is recorded or replayed
+func IsActive() bool {
+	return active != nil
+}
+
+// IsReplaying returns whether the API traffic is replayed, in which case no credentials are needed
+func IsReplaying() bool {
+	return active != nil && active.mode == modeReplay
+}
+
+// Middleware is an SDK middleware that records or replays the requests of an API client.
+// It must be the innermost middleware, so that it sees each retry, but not the authentication.
+func Middleware(rt http.RoundTripper) http.RoundTripper {
+	return &roundTripperWithCassette{transport: rt}
+}
+
+// Transport wraps the transport of HTTP clients that are not created by the SDK, such as the ones of the token flows,
+// so that their requests are recorded or replayed too. Requests already handled by the middleware are passed through.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if active == nil {
+		return rt
+	}
+	return &roundTripperWithCassette{transport: rt, skipHandled: true}
+}
+
+type roundTripperWithCassette struct {
+	transport   http.RoundTripper
+	skipHandled bool
+}
+
+func (rt *roundTripperWithCassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := rt.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	c := active
+	if c == nil || (rt.skipHandled && req.Context().Value(handledKey{}) != nil) {
+		return transport.RoundTrip(req)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), handledKey{}, true))
+
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recordedReq := Request{
+		Method: req.Method,
+		URL:    redact.URL(req.URL),
+		Header: withoutCredentials(req.Header),
+		Body:   requestBody(reqBody, req.Header.Get("Content-Type")),
+	}
+
+	if c.mode == modeReplay {
+		return c.replay(req, recordedReq)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	err = c.record(&Interaction{
+		Request: recordedReq,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     withoutCredentials(resp.Header),
+			Body:       redact.Body(respBody, resp.Header.Get("Content-Type")),
+		},
+	})
+	if err != nil {
+		c.p.Debug(print.ErrorLevel, "record %s %s: %v", req.Method, recordedReq.URL, err)
+	}
+	return resp, nil
+}
+
+func (c *cassette) record(interaction *Interaction) error {
+	content, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal interaction: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	file := filepath.Join(c.dir, fmt.Sprintf("%04d%s", c.next, fileExtension))
+	err = os.WriteFile(file, content, filePerm)
+	if err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	c.next++
+	return nil
+}
+
+// replay serves the first matching interaction that was not served yet.
+// Once all matching interactions were served, the last one is served again, e.g. when polling a resource.
+func (c *cassette) replay(req *http.Request, recordedReq Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last := -1
+	for i, interaction := range c.interactions {
+		if !matches(&interaction.Request, &recordedReq) {
+			continue
+		}
+		last = i
+		if !c.served[i] {
+			break
+		}
+	}
+	if last == -1 {
+		return nil, fmt.Errorf("no recorded interaction in %q matches the request %s %s", c.dir, recordedReq.Method, recordedReq.URL)
+	}
+	c.served[last] = true
+
+	recordedResp := c.interactions[last].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResp.StatusCode, http.StatusText(recordedResp.StatusCode)),
+		StatusCode:    recordedResp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recordedResp.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(recordedResp.Body)),
+		ContentLength: int64(len(recordedResp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Binary request bodies, such as uploaded images, are stored as their digest,
+// as they can be large and are not kept as they are in JSON strings
+func requestBody(body []byte, contentType string) string {
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+	}
+	return redact.Body(body, contentType)
+}
+
+// Headers carrying credentials are not stored at all
+func withoutCredentials(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	stored := header.Clone()
+	for name := range stored {
+		if redact.IsSensitiveHeader(name) {
+			stored.Del(name)
+		}
+	}
+	return stored
+}
+
+func matches(recorded, req *Request) bool {
+	return recorded.Method == req.Method && recorded.URL == req.URL && recorded.Body == req.Body
+}
+
+// Reads the request body and sets a fresh copy of it on the request
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Returns the interaction files of the directory, sorted in recording order
+func listFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fileExtension {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,231 @@
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+func doRequest(t *testing.T, client *http.Client, method, requestURL, body string) (status int, respBody string) {
+	t.Helper()
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // only used in tests
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response body: %v", err)
+	}
+	return resp.StatusCode, string(content)
+}
+
+// Not valid UTF-8, as the content of an uploaded image
+const binaryBody = "\x89PNG\r\n\x1a\n\x00\xff\xfe"
+
+func TestRecordAndReplay(t *testing.T) {
+	defer Stop()
+	dir := t.TempDir()
+	p := print.NewPrinter()
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":"xxx","password":"p4ssw0rd"}`)
+		case r.Method == http.MethodPut:
+			fmt.Fprint(w, `{"uploaded":true}`)
+		case r.Method == http.MethodGet:
+			polls++
+			fmt.Fprintf(w, `{"id":"xxx","status":"poll-%d"}`, polls)
+		}
+	}))
+	client := &http.Client{Transport: Middleware(http.DefaultTransport)}
+
+	err := Record(p, dir)
+	if err != nil {
+		t.Fatalf("start recording: %v", err)
+	}
+	doRequest(t, client, http.MethodPost, server.URL+"/instances", `{"name":"test"}`)
+	doRequest(t, client, http.MethodGet, server.URL+"/instances/xxx", "")
+	doRequest(t, client, http.MethodGet, server.URL+"/instances/xxx", "")
+	doRequest(t, client, http.MethodPut, server.URL+"/upload", binaryBody)
+	server.Close()
+
+	files, err := listFiles(dir)
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+	if len(files) != 4 {
+		t.Fatalf("expected 4 recorded interactions, got %d", len(files))
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read file: %v", err)
+		}
+		if strings.Contains(string(content), "secret-token") || strings.Contains(string(content), "p4ssw0rd") {
+			t.Fatalf("recorded interaction %q contains credentials: %s", filepath.Base(file), content)
+		}
+	}
+
+	err = Replay(p, dir)
+	if err != nil {
+		t.Fatalf("start replaying: %v", err)
+	}
+	if !IsReplaying() {
+		t.Fatalf("expected to be replaying")
+	}
+
+	tests := []struct {
+		description    string
+		method         string
+		path           string
+		body           string
+		isValid        bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			description:    "create",
+			method:         http.MethodPost,
+			path:           "/instances",
+			body:           `{"name":"test"}`,
+			isValid:        true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"xxx","password":"REDACTED"}`,
+		},
+		{
+			description:    "first poll",
+			method:         http.MethodGet,
+			path:           "/instances/xxx",
+			isValid:        true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"xxx","status":"poll-1"}`,
+		},
+		{
+			description:    "second poll",
+			method:         http.MethodGet,
+			path:           "/instances/xxx",
+			isValid:        true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"xxx","status":"poll-2"}`,
+		},
+		{
+			description:    "last poll is repeated",
+			method:         http.MethodGet,
+			path:           "/instances/xxx",
+			isValid:        true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"xxx","status":"poll-2"}`,
+		},
+		{
+			description:    "binary upload",
+			method:         http.MethodPut,
+			path:           "/upload",
+			body:           binaryBody,
+			isValid:        true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"uploaded":true}`,
+		},
+		{
+			description: "other binary upload",
+			method:      http.MethodPut,
+			path:        "/upload",
+			body:        binaryBody + "\xff",
+			isValid:     false,
+		},
+		{
+			description: "not recorded",
+			method:      http.MethodDelete,
+			path:        "/instances/xxx",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := client.Do(req)
+			if !tt.isValid {
+				if err == nil {
+					resp.Body.Close() //nolint:errcheck // only used in tests
+					t.Fatalf("did not fail on request that was not recorded")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on recorded request: %v", err)
+			}
+			defer resp.Body.Close() //nolint:errcheck // only used in tests
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read response body: %v", err)
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if string(body) != tt.expectedBody {
+				t.Errorf("expected body %q, got %q", tt.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	defer Stop()
+	dir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	err := Record(print.NewPrinter(), dir)
+	if err != nil {
+		t.Fatalf("start recording: %v", err)
+	}
+
+	// A request made by an authentication flow inside an API client is only recorded once, by the middleware
+	tokenFlowClient := &http.Client{Transport: Transport(http.DefaultTransport)}
+	authenticated := &http.Client{Transport: Middleware(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return tokenFlowClient.Transport.RoundTrip(req)
+	}))}
+	doRequest(t, authenticated, http.MethodGet, server.URL+"/resources", "")
+
+	// A request of the token flow itself is recorded by the transport
+	doRequest(t, tokenFlowClient, http.MethodPost, server.URL+"/token", "")
+
+	files, err := listFiles(dir)
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 recorded interactions, got %d", len(files))
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
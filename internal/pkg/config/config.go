@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,11 +14,17 @@ import (
 
 // Supported config keys
 const (
+	AliasesKey          = "aliases"
 	AsyncKey            = "async"
+	LogFileKey          = "log_file"
+	LogFormatKey        = "log_format"
 	OutputFormatKey     = "output_format"
 	ProjectIdKey        = "project_id"
 	RegionKey           = "region"
+	RetriesKey          = "retries"
+	RetryBackoffKey     = "retry_backoff"
 	SessionTimeLimitKey = "session_time_limit"
+	TimeoutKey          = "timeout"
 	VerbosityKey        = "verbosity"
 
 	IdentityProviderCustomWellKnownConfigurationKey = "identity_provider_custom_well_known_configuration"
@@ -52,8 +61,12 @@ const (
 	DefaultProfileName = "default"
 
 	AsyncDefault            = false
+	LogFormatDefault        = print.TextLogFormat
 	RegionDefault           = "eu01"
+	RetriesDefault          = 3
+	RetryBackoffDefault     = time.Second
 	SessionTimeLimitDefault = "2h"
+	TimeoutDefault          = time.Duration(0)
 
 	AllowedUrlDomainDefault = "stackit.cloud"
 )
@@ -71,10 +84,15 @@ const (
 
 var ConfigKeys = []string{
 	AsyncKey,
+	LogFileKey,
+	LogFormatKey,
 	OutputFormatKey,
 	ProjectIdKey,
 	RegionKey,
+	RetriesKey,
+	RetryBackoffKey,
 	SessionTimeLimitKey,
+	TimeoutKey,
 	VerbosityKey,
 
 	IdentityProviderCustomWellKnownConfigurationKey,
@@ -113,16 +131,44 @@ var defaultConfigFolderPath string
 var configFolderPath string
 var profileFilePath string
 
+// Error of reading the configuration, nil if it is valid
+var initErr error
+
 func InitConfig() {
+	initErr = nil
+	// The local config file may select the profile, so it is read before the profile configuration
+	workDir, err := os.Getwd()
+	cobra.CheckErr(err)
+	err = loadLocalConfig(workDir)
+	if err != nil {
+		setInitError(err)
+	}
+
 	initConfig(getInitialConfigDir())
 }
 
+// GetInitError returns the error of reading the configuration, if it is invalid.
+// Commands fail with it, apart from the ones diagnosing the configuration.
+func GetInitError() error {
+	return initErr
+}
+
+// setInitError keeps the first error of reading the configuration, which is continued with the valid parts of it
+func setInitError(err error) {
+	if initErr == nil {
+		initErr = err
+	}
+}
+
 func initConfig(configPath string) {
 	defaultConfigFolderPath = configPath
 	profileFilePath = getInitialProfileFilePath() // Profile file path is in the default config folder
 
 	configProfile, err := GetProfile()
-	cobra.CheckErr(err)
+	if err != nil {
+		setInitError(fmt.Errorf("get profile: %w", err))
+		configProfile = DefaultProfileName
+	}
 
 	configFolderPath = GetProfileFolderPath(configProfile)
 
@@ -136,7 +182,7 @@ func initConfig(configPath string) {
 	f, err := os.Open(configFilePath)
 	if !os.IsNotExist(err) {
 		if err := viper.ReadConfig(f); err != nil {
-			cobra.CheckErr(err)
+			setInitError(fmt.Errorf("read config file %q: %w", configFilePath, err))
 		}
 	}
 	defer func() {
@@ -147,6 +193,11 @@ func initConfig(configPath string) {
 		}
 	}()
 
+	err = mergeLocalConfig()
+	if err != nil {
+		setInitError(fmt.Errorf("merge local config file: %w", err))
+	}
+
 	setConfigDefaults()
 
 	viper.AutomaticEnv()
@@ -159,6 +210,10 @@ func Write() error {
 	if err != nil {
 		return fmt.Errorf("create config directory: %w", err)
 	}
+	// The values of the local config file belong to its directory, not to the profile
+	if local != nil {
+		return writeWithoutLocalConfig()
+	}
 	return viper.WriteConfig()
 }
 
@@ -166,10 +221,15 @@ func Write() error {
 // They will not show in the config list if they are empty
 func setConfigDefaults() {
 	viper.SetDefault(AsyncKey, AsyncDefault)
+	viper.SetDefault(LogFileKey, "")
+	viper.SetDefault(LogFormatKey, LogFormatDefault)
 	viper.SetDefault(OutputFormatKey, "")
 	viper.SetDefault(ProjectIdKey, "")
 	viper.SetDefault(RegionKey, RegionDefault)
+	viper.SetDefault(RetriesKey, RetriesDefault)
+	viper.SetDefault(RetryBackoffKey, RetryBackoffDefault)
 	viper.SetDefault(SessionTimeLimitKey, SessionTimeLimitDefault)
+	viper.SetDefault(TimeoutKey, TimeoutDefault)
 	viper.SetDefault(IdentityProviderCustomWellKnownConfigurationKey, "")
 	viper.SetDefault(IdentityProviderCustomClientIdKey, "")
 	viper.SetDefault(AllowedUrlDomainKey, AllowedUrlDomainDefault)
@@ -0,0 +1,222 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/viper"
+)
+
+const (
+	// LocalConfigFileName is the name of the configuration file of a directory, e.g. of a repository
+	LocalConfigFileName = ".stackit.yaml"
+
+	// Key of the local config file selecting the profile, which is not a config key of the profile
+	localProfileKey = "profile"
+)
+
+// Config keys that can be set in a local config file.
+// Custom endpoints and authentication settings are left out on purpose: the file comes with the repository,
+// and must not be able to send the credentials of the user elsewhere.
+var localConfigKeys = []string{
+	AsyncKey,
+	OutputFormatKey,
+	ProjectIdKey,
+	RegionKey,
+	RetriesKey,
+	RetryBackoffKey,
+	TimeoutKey,
+	VerbosityKey,
+}
+
+// Sources of the configuration values, in order of precedence
+const (
+	SourceFlag            = "flag"
+	SourceEnv             = "environment variable"
+	SourceLocalConfigFile = "local config file"
+	SourceProfile         = "profile"
+	SourceDefault         = "default"
+)
+
+type localConfig struct {
+	path    string
+	profile string
+	values  map[string]any
+	// Values of the profile configuration overridden by the local config file, which are kept when it is written
+	profileValues map[string]any
+}
+
+// Local config file of the current directory, nil if there is none
+var local *localConfig
+
+// GetLocalConfigFilePath returns the path of the local config file in use, or an empty string if there is none
+func GetLocalConfigFilePath() string {
+	if local == nil {
+		return ""
+	}
+	return local.path
+}
+
+// GetProfileFromLocalConfig returns the profile set in the local config file, if any
+func GetProfileFromLocalConfig() (string, bool) {
+	if local == nil || local.profile == "" {
+		return "", false
+	}
+	return local.profile, true
+}
+
+// IsSetInLocalConfig returns whether the value of the config key is set in the local config file
+func IsSetInLocalConfig(key string) bool {
+	if local == nil {
+		return false
+	}
+	_, ok := local.values[key]
+	return ok
+}
+
+// GetSource returns where the value of the config key comes from, apart from flags, which the caller checks.
+// Environment variables take precedence over the local config file, which takes precedence over the profile.
+func GetSource(key string) string {
+	if _, ok := os.LookupEnv(envVarName(key)); ok {
+		return SourceEnv
+	}
+	if IsSetInLocalConfig(key) {
+		return SourceLocalConfigFile
+	}
+	if viper.InConfig(key) {
+		return SourceProfile
+	}
+	return SourceDefault
+}
+
+func envVarName(key string) string {
+	return "STACKIT_" + strings.ToUpper(key)
+}
+
+// findLocalConfigFile returns the path of the nearest local config file, searched from the directory up to the root
+func findLocalConfigFile(dir string) (string, error) {
+	for {
+		path := filepath.Join(dir, LocalConfigFileName)
+		_, err := os.Stat(path)
+		if err == nil {
+			return path, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("check local config file %q: %w", path, err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// readLocalConfig reads the local config file. The keys may be written like the flags, e.g. "project-id".
+func readLocalConfig(path string) (*localConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read local config file: %w", err)
+	}
+	data := map[string]any{}
+	err = yaml.Unmarshal(content, &data)
+	if err != nil {
+		return nil, fmt.Errorf("parse local config file %q: %w", path, err)
+	}
+
+	localCfg := &localConfig{
+		path:          path,
+		values:        map[string]any{},
+		profileValues: map[string]any{},
+	}
+	for name, value := range data {
+		key := strings.ReplaceAll(name, "-", "_")
+		switch {
+		case key == localProfileKey:
+			profile, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("local config file %q: the profile must be a string", path)
+			}
+			err = ValidateProfile(profile)
+			if err != nil {
+				return nil, fmt.Errorf("local config file %q: %w", path, err)
+			}
+			localCfg.profile = profile
+		case slices.Contains(localConfigKeys, key):
+			kind := reflect.ValueOf(value).Kind()
+			if kind == reflect.Map || kind == reflect.Slice {
+				return nil, fmt.Errorf("local config file %q: the value of %q must be a single value", path, name)
+			}
+			localCfg.values[key] = value
+		default:
+			return nil, fmt.Errorf("local config file %q: %q can't be set, supported are %q and %s", path, name, localProfileKey, strings.Join(flagNames(localConfigKeys), ", "))
+		}
+	}
+	return localCfg, nil
+}
+
+func flagNames(keys []string) []string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, fmt.Sprintf("%q", strings.ReplaceAll(key, "_", "-")))
+	}
+	return names
+}
+
+// loadLocalConfig finds and reads the local config file of the directory, if there is one
+func loadLocalConfig(dir string) error {
+	local = nil
+	path, err := findLocalConfigFile(dir)
+	if err != nil || path == "" {
+		return err
+	}
+	local, err = readLocalConfig(path)
+	return err
+}
+
+// mergeLocalConfig adds the values of the local config file to the configuration read from the profile,
+// so that they take precedence over it, but not over flags and environment variables.
+// It must be called before the environment variables are bound, so that the values of the profile are the ones recorded.
+func mergeLocalConfig() error {
+	if local == nil {
+		return nil
+	}
+	for key := range local.values {
+		if viper.InConfig(key) {
+			local.profileValues[key] = viper.Get(key)
+		}
+	}
+	return viper.MergeConfigMap(local.values)
+}
+
+// writeWithoutLocalConfig writes the configuration to the profile config file, without the values of the local config file.
+// A value is only written if it was changed, e.g. by "stackit config set", to something else than the local config file sets.
+func writeWithoutLocalConfig() error {
+	settings := viper.AllSettings()
+	for key, value := range local.values {
+		if !reflect.DeepEqual(settings[key], value) {
+			continue
+		}
+		profileValue, ok := local.profileValues[key]
+		if !ok {
+			delete(settings, key)
+			continue
+		}
+		settings[key] = profileValue
+	}
+
+	v := viper.New()
+	v.SetConfigFile(viper.ConfigFileUsed())
+	v.SetConfigType(configFileExtension)
+	err := v.MergeConfigMap(settings)
+	if err != nil {
+		return fmt.Errorf("merge config: %w", err)
+	}
+	return v.WriteConfig()
+}
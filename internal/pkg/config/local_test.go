@@ -0,0 +1,215 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/viper"
+)
+
+func TestReadLocalConfig(t *testing.T) {
+	tests := []struct {
+		description     string
+		content         string
+		isValid         bool
+		expectedProfile string
+		expectedValues  map[string]any
+	}{
+		{
+			description:     "base",
+			content:         "profile: dev\nproject-id: xxx\nregion: eu02\nasync: true\n",
+			isValid:         true,
+			expectedProfile: "dev",
+			expectedValues:  map[string]any{ProjectIdKey: "xxx", RegionKey: "eu02", AsyncKey: true},
+		},
+		{
+			description:    "keys like the config keys",
+			content:        "project_id: xxx\noutput_format: json\n",
+			isValid:        true,
+			expectedValues: map[string]any{ProjectIdKey: "xxx", OutputFormatKey: "json"},
+		},
+		{
+			description:    "empty",
+			content:        "",
+			isValid:        true,
+			expectedValues: map[string]any{},
+		},
+		{
+			description: "custom endpoint not allowed",
+			content:     "project-id: xxx\ntoken-custom-endpoint: https://example.com\n",
+			isValid:     false,
+		},
+		{
+			description: "unknown key",
+			content:     "project: xxx\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid profile",
+			content:     "profile: Dev Profile\n",
+			isValid:     false,
+		},
+		{
+			description: "list value",
+			content:     "region: [eu01, eu02]\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid yaml",
+			content:     "project-id: [xxx\n",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), LocalConfigFileName)
+			err := os.WriteFile(path, []byte(tt.content), 0o600)
+			if err != nil {
+				t.Fatalf("write local config file: %v", err)
+			}
+
+			localCfg, err := readLocalConfig(path)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if localCfg.profile != tt.expectedProfile {
+				t.Fatalf("expected profile %q, got %q", tt.expectedProfile, localCfg.profile)
+			}
+			diff := cmp.Diff(localCfg.values, tt.expectedValues)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindLocalConfigFile(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	subDir := filepath.Join(repo, "deploy", "prod")
+	err := os.MkdirAll(subDir, 0o750)
+	if err != nil {
+		t.Fatalf("create directories: %v", err)
+	}
+
+	path, err := findLocalConfigFile(subDir)
+	if err != nil {
+		t.Fatalf("findLocalConfigFile() failed: %v", err)
+	}
+	// A file higher up than the temporary directory would be found as well
+	if strings.HasPrefix(path, root) {
+		t.Fatalf("expected no local config file, got %q", path)
+	}
+
+	expected := filepath.Join(repo, LocalConfigFileName)
+	err = os.WriteFile(expected, []byte("project-id: xxx\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write local config file: %v", err)
+	}
+	for _, dir := range []string{repo, subDir} {
+		path, err = findLocalConfigFile(dir)
+		if err != nil {
+			t.Fatalf("findLocalConfigFile() failed: %v", err)
+		}
+		if path != expected {
+			t.Fatalf("expected local config file %q from %q, got %q", expected, dir, path)
+		}
+	}
+}
+
+func TestLocalConfig(t *testing.T) {
+	t.Cleanup(func() {
+		local = nil
+		viper.Reset()
+	})
+	testDir := t.TempDir()
+	configFolderPath := filepath.Join(testDir, "config")
+	err := os.MkdirAll(configFolderPath, 0o750)
+	if err != nil {
+		t.Fatalf("create config directory: %v", err)
+	}
+	configFilePath := getConfigFilePath(configFolderPath)
+	err = os.WriteFile(configFilePath, []byte(`{"project_id": "profile-project", "async": true}`), 0o600)
+	if err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	repo := filepath.Join(testDir, "repo")
+	err = os.MkdirAll(repo, 0o750)
+	if err != nil {
+		t.Fatalf("create repository directory: %v", err)
+	}
+	err = os.WriteFile(filepath.Join(repo, LocalConfigFileName), []byte("project-id: local-project\nregion: eu02\nasync: true\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write local config file: %v", err)
+	}
+
+	t.Setenv(envVarName(RegionKey), "eu03")
+	err = loadLocalConfig(repo)
+	if err != nil {
+		t.Fatalf("loadLocalConfig() failed: %v", err)
+	}
+	initConfig(configFolderPath)
+
+	// The local config file takes precedence over the profile, but not over environment variables
+	if viper.GetString(ProjectIdKey) != "local-project" {
+		t.Fatalf("expected the project ID of the local config file, got %q", viper.GetString(ProjectIdKey))
+	}
+	if viper.GetString(RegionKey) != "eu03" {
+		t.Fatalf("expected the region of the environment variable, got %q", viper.GetString(RegionKey))
+	}
+	sources := map[string]string{
+		ProjectIdKey:    GetSource(ProjectIdKey),
+		RegionKey:       GetSource(RegionKey),
+		AsyncKey:        GetSource(AsyncKey),
+		TimeoutKey:      GetSource(TimeoutKey),
+		VerbosityKey:    GetSource(VerbosityKey),
+		LogFormatKey:    GetSource(LogFormatKey),
+		OutputFormatKey: GetSource(OutputFormatKey),
+	}
+	expectedSources := map[string]string{
+		ProjectIdKey:    SourceLocalConfigFile,
+		RegionKey:       SourceEnv,
+		AsyncKey:        SourceLocalConfigFile,
+		TimeoutKey:      SourceDefault,
+		VerbosityKey:    SourceDefault,
+		LogFormatKey:    SourceDefault,
+		OutputFormatKey: SourceDefault,
+	}
+	if diff := cmp.Diff(sources, expectedSources); diff != "" {
+		t.Fatalf("Sources do not match: %s", diff)
+	}
+
+	// Writing the configuration keeps the values of the profile, apart from the changed ones
+	viper.Set(SessionTimeLimitKey, "1h")
+	viper.Set(AsyncKey, false)
+	err = Write()
+	if err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	content, err := os.ReadFile(configFilePath)
+	if err != nil {
+		t.Fatalf("read config file: %v", err)
+	}
+	written := map[string]any{}
+	err = json.Unmarshal(content, &written)
+	if err != nil {
+		t.Fatalf("parse config file: %v", err)
+	}
+	if written[ProjectIdKey] != "profile-project" {
+		t.Fatalf("expected the project ID of the profile to be kept, got %v", written[ProjectIdKey])
+	}
+	if written[SessionTimeLimitKey] != "1h" || written[AsyncKey] != false {
+		t.Fatalf("expected the changed values to be written, got %v", written)
+	}
+}
@@ -16,8 +16,8 @@ const ProfileEnvVar = "STACKIT_CLI_PROFILE"
 
 // GetProfile returns the current profile to be used by the CLI.
 // The profile is determined by the value of the STACKIT_CLI_PROFILE environment variable, or, if not set,
-// by the contents of the profile file in the CLI config folder.
-// If the profile is not set (env var or profile file) or is set but does not exist, it falls back to the default profile.
+// by the local config file of the current directory, or by the contents of the profile file in the CLI config folder.
+// If the profile is not set (env var, local config file or profile file) or is set but does not exist, it falls back to the default profile.
 // If the profile is not valid, it returns an error.
 func GetProfile() (string, error) {
 	_, profile, _, err := GetConfiguredProfile()
@@ -30,26 +30,31 @@ func GetProfile() (string, error) {
 
 // GetConfiguredProfile returns the profile configured by the user, the profile to be used by the CLI and the method used to configure the profile.
 // The profile is determined by the value of the STACKIT_CLI_PROFILE environment variable, or, if not set,
-// by the contents of the profile file in the CLI config folder.
-// If the configured profile is not set (env var or profile file) or is set but does not exist, it falls back to the default profile.
-// The configuration method can be environment variable, profile file or empty if profile is not configured.
+// by the local config file of the current directory, or by the contents of the profile file in the CLI config folder.
+// If the configured profile is not set (env var, local config file or profile file) or is set but does not exist, it falls back to the default profile.
+// The configuration method can be environment variable, local config file, profile file or empty if profile is not configured.
 // If the profile is not valid, it returns an error.
 func GetConfiguredProfile() (configuredProfile, activeProfile, configurationMethod string, err error) {
 	var configMethod string
 	profile, profileSetInEnv := GetProfileFromEnv()
-	if !profileSetInEnv {
+	localProfile, profileSetInLocalConfig := GetProfileFromLocalConfig()
+	switch {
+	case profileSetInEnv:
+		configMethod = "environment variable"
+	case profileSetInLocalConfig:
+		profile = localProfile
+		configMethod = fmt.Sprintf("local config file %q", GetLocalConfigFilePath())
+	default:
 		contents, exists, err := fileutils.ReadFileIfExists(profileFilePath)
 		if err != nil {
 			return "", "", "", fmt.Errorf("read profile from file: %w", err)
 		}
 		if !exists {
-			// No profile set in env or file
+			// No profile set in env, local config file or profile file
 			return DefaultProfileName, DefaultProfileName, "", nil
 		}
 		profile = contents
 		configMethod = "profile file"
-	} else {
-		configMethod = "environment variable"
 	}
 
 	// Make sure the profile exists
@@ -380,7 +385,7 @@ func ImportProfile(p *print.Printer, profileName, config string, setAsActive boo
 		return fmt.Errorf("write config file: %w", err)
 	}
 
-	if p.IsVerbosityDebug() {
+	if p.IsDebugLogged() {
 		p.Debug(print.DebugLevel, "profile %q imported", profileName)
 	}
 
@@ -391,7 +396,7 @@ func ImportProfile(p *print.Printer, profileName, config string, setAsActive boo
 		}
 	}
 
-	if p.IsVerbosityDebug() {
+	if p.IsDebugLogged() {
 		p.Debug(print.DebugLevel, "active profile %q is now active", profileName)
 	}
 
@@ -0,0 +1,189 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+)
+
+// Annotation set on the commands that support the --dry-run flag
+const annotation = "dry-run"
+
+// ErrNotSent is returned by the API clients instead of sending a request that changes resources,
+// which aborts the command before it waits for the change or makes further requests
+var ErrNotSent = errors.New("request not sent in dry run")
+
+// Request is a request that would have been sent, printed instead of the output of the command
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	// JSON payload of the request, or its content as a string if it's not JSON
+	Body any `json:"body,omitempty"`
+}
+
+type dryRun struct {
+	mu       sync.Mutex
+	requests []Request
+}
+
+// Dry run of the current invocation, nil if the --dry-run flag is not set
+var active *dryRun
+
+// Enable adds support for the --dry-run flag to the command, unless it was disabled for it
+func Enable(cmd *cobra.Command) {
+	if cmd.RunE == nil || cmd.Annotations[annotation] == "false" {
+		return
+	}
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[annotation] = "true"
+}
+
+// Disable marks the command as not supporting the --dry-run flag, e.g. as it makes several changes that depend on each other
+func Disable(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[annotation] = "false"
+}
+
+// IsEnabled returns whether the command supports the --dry-run flag
+func IsEnabled(cmd *cobra.Command) bool {
+	return cmd.Annotations[annotation] == "true"
+}
+
+// Start captures the requests that change resources instead of sending them, if the --dry-run flag is set.
+// It returns an error if the command doesn't support the flag.
+func Start(p *print.Printer, cmd *cobra.Command) error {
+	// A command run before in the interactive shell may have left a dry run active
+	Stop()
+
+	if !flags.FlagToBoolValue(p, cmd, globalflags.DryRunFlag) {
+		return nil
+	}
+	if !IsEnabled(cmd) {
+		return &cliErr.FlagValidationError{
+			Flag:    globalflags.DryRunFlag,
+			Details: fmt.Sprintf("not supported by %q, only commands that change resources with a single request can be run in dry run", cmd.CommandPath()),
+		}
+	}
+	p.Debug(print.DebugLevel, "dry run, requests that change resources are not sent")
+	active = &dryRun{}
+	return nil
+}
+
+// Stop ends the dry run
+func Stop() {
+	active = nil
+}
+
+// IsActive returns whether requests that change resources are captured instead of being sent
+func IsActive() bool {
+	return active != nil
+}
+
+// IsNotSent returns whether the error was caused by a request that was not sent in dry run
+func IsNotSent(err error) bool {
+	return errors.Is(err, ErrNotSent)
+}
+
+// Requests returns the requests that would have been sent, in the order they were made
+func Requests() []Request {
+	if active == nil {
+		return nil
+	}
+	active.mu.Lock()
+	defer active.mu.Unlock()
+	return append([]Request{}, active.requests...)
+}
+
+// Middleware is an SDK middleware that captures the requests changing resources instead of sending them.
+// Requests that only read resources, e.g. to resolve names, are sent as usual.
+func Middleware(rt http.RoundTripper) http.RoundTripper {
+	return &roundTripperWithDryRun{transport: rt}
+}
+
+type roundTripperWithDryRun struct {
+	transport http.RoundTripper
+}
+
+func (rt *roundTripperWithDryRun) RoundTrip(req *http.Request) (*http.Response, error) {
+	d := active
+	if d == nil || isReadOnly(req.Method) {
+		return rt.transport.RoundTrip(req)
+	}
+
+	request := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		request.Body = parseBody(body)
+	}
+
+	d.mu.Lock()
+	d.requests = append(d.requests, request)
+	d.mu.Unlock()
+	return nil, ErrNotSent
+}
+
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func parseBody(body []byte) any {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+	var value any
+	err := json.Unmarshal(body, &value)
+	if err != nil {
+		return string(body)
+	}
+	return value
+}
+
+// Output prints the requests that would have been sent
+func Output(p *print.Printer, outputFormat string) error {
+	requests := Requests()
+	if len(requests) == 0 {
+		p.Info("Dry run, no request would be sent\n")
+		return nil
+	}
+	return p.OutputResult(outputFormat, requests, func() error {
+		p.Info("Dry run, the following request(s) would be sent:\n")
+		for i, r := range requests {
+			if i > 0 {
+				p.Outputln("")
+			}
+			p.Outputf("%s %s\n", r.Method, r.URL)
+			if r.Body == nil {
+				continue
+			}
+			body, err := json.MarshalIndent(r.Body, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshal request body: %w", err)
+			}
+			p.Outputln(string(body))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,183 @@
+package dryrun
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+func TestStart(t *testing.T) {
+	tests := []struct {
+		description    string
+		enabled        bool
+		disabled       bool
+		dryRun         bool
+		isValid        bool
+		expectedActive bool
+	}{
+		{
+			description: "dry run not set",
+			isValid:     true,
+		},
+		{
+			description:    "enabled command",
+			enabled:        true,
+			dryRun:         true,
+			isValid:        true,
+			expectedActive: true,
+		},
+		{
+			description: "command does not support dry run",
+			dryRun:      true,
+			isValid:     false,
+		},
+		{
+			description: "disabled command",
+			enabled:     true,
+			disabled:    true,
+			dryRun:      true,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Cleanup(Stop)
+			p := print.NewPrinter()
+			cmd := &cobra.Command{
+				Use:  "create",
+				RunE: func(*cobra.Command, []string) error { return nil },
+			}
+			p.Cmd = cmd
+			err := globalflags.Configure(cmd.Flags())
+			if err != nil {
+				t.Fatalf("failed to configure global flags: %v", err)
+			}
+			if tt.disabled {
+				Disable(cmd)
+			}
+			if tt.enabled {
+				Enable(cmd)
+			}
+			if tt.dryRun {
+				err = cmd.Flags().Set(globalflags.DryRunFlag, "true")
+				if err != nil {
+					t.Fatalf("failed to set flag: %v", err)
+				}
+			}
+
+			err = Start(p, cmd)
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if IsActive() != tt.expectedActive {
+				t.Errorf("expected active %t", tt.expectedActive)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Cleanup(Stop)
+	sent := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: Middleware(http.DefaultTransport)}
+
+	active = &dryRun{}
+	resp, err := client.Get(server.URL + "/servers")
+	if err != nil {
+		t.Fatalf("GET request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	_, err = client.Post(server.URL+"/servers?details=true", "application/json", strings.NewReader(`{"name": "vm", "labels": {"env": "test"}}`))
+	if !errors.Is(err, ErrNotSent) || !IsNotSent(err) {
+		t.Fatalf("expected the POST request not to be sent, got error %v", err)
+	}
+	req, err := http.NewRequest(http.MethodDelete, server.URL+"/servers/xxx", http.NoBody)
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	_, err = client.Do(req)
+	if !IsNotSent(err) {
+		t.Fatalf("expected the DELETE request not to be sent, got error %v", err)
+	}
+	req, err = http.NewRequest(http.MethodPut, server.URL+"/upload", bytes.NewReader([]byte("raw content")))
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	_, err = client.Do(req)
+	if !IsNotSent(err) {
+		t.Fatalf("expected the PUT request not to be sent, got error %v", err)
+	}
+
+	if diff := cmp.Diff(sent, []string{http.MethodGet}); diff != "" {
+		t.Fatalf("Sent requests do not match: %s", diff)
+	}
+	expected := []Request{
+		{
+			Method: http.MethodPost,
+			URL:    server.URL + "/servers?details=true",
+			Body:   map[string]any{"name": "vm", "labels": map[string]any{"env": "test"}},
+		},
+		{Method: http.MethodDelete, URL: server.URL + "/servers/xxx"},
+		{Method: http.MethodPut, URL: server.URL + "/upload", Body: "raw content"},
+	}
+	if diff := cmp.Diff(Requests(), expected); diff != "" {
+		t.Fatalf("Requests do not match: %s", diff)
+	}
+
+	// Without a dry run, all requests are sent
+	Stop()
+	resp, err = client.Post(server.URL+"/servers", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("POST request failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if len(sent) != 2 {
+		t.Fatalf("expected the POST request to be sent without a dry run")
+	}
+}
+
+func TestOutput(t *testing.T) {
+	t.Cleanup(Stop)
+	p := print.NewPrinter()
+	cmd := &cobra.Command{}
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	p.Cmd = cmd
+
+	active = &dryRun{requests: []Request{
+		{Method: http.MethodPost, URL: "https://iaas.api.stackit.cloud/v1/projects/xxx/servers", Body: map[string]any{"name": "vm"}},
+		{Method: http.MethodDelete, URL: "https://iaas.api.stackit.cloud/v1/projects/xxx/servers/yyy"},
+	}}
+	err := Output(p, print.PrettyOutputFormat)
+	if err != nil {
+		t.Fatalf("Output() failed: %v", err)
+	}
+	expected := `POST https://iaas.api.stackit.cloud/v1/projects/xxx/servers
+{
+  "name": "vm"
+}
+
+DELETE https://iaas.api.stackit.cloud/v1/projects/xxx/servers/yyy
+`
+	if diff := cmp.Diff(buf.String(), expected); diff != "" {
+		t.Fatalf("Output does not match: %s", diff)
+	}
+}
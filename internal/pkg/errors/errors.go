@@ -178,6 +178,18 @@ To list all profiles, run:
   $ stackit config profile list`
 
 	FILE_ALREADY_EXISTS = `file %q already exists in the export path. Delete the existing file or define a different export path`
+
+	DIRECTORY_NOT_EMPTY = `directory %q is not empty. Delete the files in it or define a different directory`
+
+	PLUGIN_EXIT = `plugin %q exited with code %d`
+
+	SHELL_COMMAND_EXIT = `command on line %d exited with code %d`
+
+	RESOURCE_NAME_NOT_FOUND = `no %s named %q was found in the project`
+
+	RESOURCE_NAME_AMBIGUOUS = `the name %[2]q matches more than one %[1]s: %[3]s.
+
+Use the ID of the %[1]s instead.`
 )
 
 type ServerNicAttachMissingNicIdError struct {
@@ -419,6 +431,16 @@ func (e *SingleArgExpectedError) Error() string {
 	return AppendUsageTip(err, e.Cmd).Error()
 }
 
+type ArgMissingError struct {
+	Cmd      *cobra.Command
+	Expected string
+}
+
+func (e *ArgMissingError) Error() string {
+	err := fmt.Errorf(ARG_MISSING, e.Expected)
+	return AppendUsageTip(err, e.Cmd).Error()
+}
+
 type SingleOptionalArgExpectedError struct {
 	Cmd      *cobra.Command
 	Expected string
@@ -499,3 +521,65 @@ type FileAlreadyExistsError struct {
 }
 
 func (e *FileAlreadyExistsError) Error() string { return fmt.Sprintf(FILE_ALREADY_EXISTS, e.Filename) }
+
+type DirectoryNotEmptyError struct {
+	Dir string
+}
+
+func (e *DirectoryNotEmptyError) Error() string { return fmt.Sprintf(DIRECTORY_NOT_EMPTY, e.Dir) }
+
+// PluginExitError is returned when a plugin fails. Its exit code is passed on as the exit code of the CLI
+type PluginExitError struct {
+	Plugin   string
+	ExitCode int
+}
+
+func (e *PluginExitError) Error() string {
+	return fmt.Sprintf(PLUGIN_EXIT, e.Plugin, e.ExitCode)
+}
+
+// ShellCommandExitError is returned when a command run by the shell from a script fails.
+// The command has printed its error already, its exit code is passed on as the exit code of the CLI
+type ShellCommandExitError struct {
+	Line     int
+	ExitCode int
+}
+
+func (e *ShellCommandExitError) Error() string {
+	return fmt.Sprintf(SHELL_COMMAND_EXIT, e.Line, e.ExitCode)
+}
+
+// TimeoutError is returned when a command fails because its deadline was exceeded.
+// It is needed for the waits of the SDK, which don't wrap the error of the context
+type TimeoutError struct {
+	Err error
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// ResourceNameNotFoundError is returned when a resource referenced by its name doesn't exist
+type ResourceNameNotFoundError struct {
+	Resource string
+	Name     string
+}
+
+func (e *ResourceNameNotFoundError) Error() string {
+	return fmt.Sprintf(RESOURCE_NAME_NOT_FOUND, e.Resource, e.Name)
+}
+
+// ResourceNameAmbiguousError is returned when more than one resource has the name a resource is referenced by
+type ResourceNameAmbiguousError struct {
+	Resource string
+	Name     string
+	Ids      []string
+}
+
+func (e *ResourceNameAmbiguousError) Error() string {
+	return fmt.Sprintf(RESOURCE_NAME_AMBIGUOUS, e.Resource, e.Name, strings.Join(e.Ids, ", "))
+}
@@ -552,6 +552,36 @@ func TestSingleArgExpectedError(t *testing.T) {
 	}
 }
 
+func TestArgMissingError(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+		expectedMsg string
+	}{
+		{
+			description: "base",
+			expected:    "expected",
+			expectedMsg: fmt.Sprintf(ARG_MISSING, "expected"),
+		},
+	}
+
+	setupCmd()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := &ArgMissingError{
+				Expected: tt.expected,
+				Cmd:      operation,
+			}
+
+			appendedErr := AppendUsageTip(errors.New(tt.expectedMsg), operation)
+
+			if err.Error() != appendedErr.Error() {
+				t.Fatalf("expected error to be %s, got %s", tt.expectedMsg, err.Error())
+			}
+		})
+	}
+}
+
 func TestSingleOptionalArgExpectedError(t *testing.T) {
 	tests := []struct {
 		description string
@@ -0,0 +1,178 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// Exit codes of the CLI, documented in the README. Scripts rely on them, so existing values must not be changed.
+const (
+	ExitCodeGeneric         = 1
+	ExitCodeUsage           = 2
+	ExitCodeUnauthenticated = 3
+	ExitCodeForbidden       = 4
+	ExitCodeNotFound        = 5
+	ExitCodeConflict        = 6
+	ExitCodeServiceDisabled = 7
+	ExitCodeRateLimited     = 8
+	ExitCodeServerError     = 9
+	ExitCodeTimeout         = 10
+	ExitCodeAborted         = 11
+	ExitCodeInvalidRequest  = 12
+)
+
+var exitCodeNames = map[int]string{
+	ExitCodeGeneric:         "ERROR",
+	ExitCodeUsage:           "USAGE",
+	ExitCodeUnauthenticated: "UNAUTHENTICATED",
+	ExitCodeForbidden:       "FORBIDDEN",
+	ExitCodeNotFound:        "NOT_FOUND",
+	ExitCodeConflict:        "CONFLICT",
+	ExitCodeServiceDisabled: "SERVICE_DISABLED",
+	ExitCodeRateLimited:     "RATE_LIMITED",
+	ExitCodeServerError:     "SERVER_ERROR",
+	ExitCodeTimeout:         "TIMEOUT",
+	ExitCodeAborted:         "ABORTED",
+	ExitCodeInvalidRequest:  "INVALID_REQUEST",
+}
+
+// Code of the errors of plugins, whose exit codes are passed on as they are
+const pluginErrorCode = "PLUGIN"
+
+// Prefixes of the errors returned by Cobra when parsing the command line
+var cobraUsageErrorPrefixes = []string{
+	"unknown command",
+	"unknown flag",
+	"unknown shorthand flag",
+	"required flag(s)",
+	"invalid argument",
+	"flag needs an argument",
+	"bad flag syntax",
+	"if any flags in the group",
+}
+
+// ErrorDetails is the machine-readable form of an error, printed to stderr with "--output-format json"
+type ErrorDetails struct {
+	Code       string `json:"code"`
+	ExitCode   int    `json:"exitCode"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+	RequestId  string `json:"requestId,omitempty"`
+	Message    string `json:"message"`
+}
+
+// Details classifies the error returned by a command.
+// The request ID is not known to the error, it is set by the caller.
+func Details(err error) ErrorDetails {
+	details := ErrorDetails{
+		ExitCode: ExitCodeGeneric,
+		Message:  strings.TrimSpace(err.Error()),
+	}
+
+	var pluginErr *PluginExitError
+	if errors.As(err, &pluginErr) {
+		details.ExitCode = pluginErr.ExitCode
+		details.Code = pluginErrorCode
+		return details
+	}
+
+	var shellErr *ShellCommandExitError
+	if errors.As(err, &shellErr) {
+		details.ExitCode = shellErr.ExitCode
+		details.Code = exitCodeNames[shellErr.ExitCode]
+		return details
+	}
+
+	var oapiErr *oapierror.GenericOpenAPIError
+	if errors.As(err, &oapiErr) {
+		details.HTTPStatus = oapiErr.StatusCode
+		details.ExitCode = httpStatusExitCode(oapiErr.StatusCode)
+	} else {
+		details.ExitCode = exitCode(err)
+	}
+	details.Code = exitCodeNames[details.ExitCode]
+	return details
+}
+
+// ExitCode returns the exit code of the CLI for the error returned by a command
+func ExitCode(err error) int {
+	return Details(err).ExitCode
+}
+
+func httpStatusExitCode(status int) int {
+	switch {
+	case status == http.StatusUnauthorized:
+		return ExitCodeUnauthenticated
+	case status == http.StatusForbidden:
+		return ExitCodeForbidden
+	case status == http.StatusNotFound || status == http.StatusGone:
+		return ExitCodeNotFound
+	case status == http.StatusConflict || status == http.StatusPreconditionFailed:
+		return ExitCodeConflict
+	case status == http.StatusTooManyRequests:
+		return ExitCodeRateLimited
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ExitCodeInvalidRequest
+	case status >= http.StatusInternalServerError:
+		return ExitCodeServerError
+	}
+	return ExitCodeGeneric
+}
+
+func exitCode(err error) int {
+	var (
+		authErr                *AuthError
+		sessionExpiredErr      *SessionExpiredError
+		accessTokenExpiredErr  *AccessTokenExpiredError
+		activateSAErr          *ActivateServiceAccountError
+		serviceDisabledErr     *ServiceDisabledError
+		projectIdErr           *ProjectIdError
+		emptyUpdateErr         *EmptyUpdateError
+		flagValidationErr      *FlagValidationError
+		mutuallyExclusiveErr   *RequiredMutuallyExclusiveFlagsError
+		argValidationErr       *ArgValidationError
+		singleArgErr           *SingleArgExpectedError
+		argMissingErr          *ArgMissingError
+		singleOptionalArgErr   *SingleOptionalArgExpectedError
+		inputUnknownErr        *InputUnknownError
+		subcommandMissingErr   *SubcommandMissingError
+		invalidProfileNameErr  *InvalidProfileNameError
+		profileDoesNotExistErr *ProfileDoesNotExistError
+		nameNotFoundErr        *ResourceNameNotFoundError
+		nameAmbiguousErr       *ResourceNameAmbiguousError
+		timeoutErr             *TimeoutError
+	)
+	switch {
+	case errors.As(err, &authErr), errors.As(err, &sessionExpiredErr), errors.As(err, &accessTokenExpiredErr), errors.As(err, &activateSAErr):
+		return ExitCodeUnauthenticated
+	case errors.As(err, &serviceDisabledErr):
+		return ExitCodeServiceDisabled
+	case errors.As(err, &profileDoesNotExistErr), errors.As(err, &nameNotFoundErr):
+		return ExitCodeNotFound
+	case errors.As(err, &projectIdErr), errors.As(err, &emptyUpdateErr), errors.As(err, &flagValidationErr),
+		errors.As(err, &mutuallyExclusiveErr), errors.As(err, &argValidationErr), errors.As(err, &singleArgErr),
+		errors.As(err, &argMissingErr), errors.As(err, &singleOptionalArgErr), errors.As(err, &inputUnknownErr),
+		errors.As(err, &subcommandMissingErr), errors.As(err, &invalidProfileNameErr), errors.As(err, &nameAmbiguousErr),
+		isCobraUsageError(err):
+		return ExitCodeUsage
+	case errors.Is(err, print.ErrAborted):
+		return ExitCodeAborted
+	case errors.As(err, &timeoutErr), errors.Is(err, context.DeadlineExceeded):
+		return ExitCodeTimeout
+	}
+	return ExitCodeGeneric
+}
+
+func isCobraUsageError(err error) bool {
+	for _, prefix := range cobraUsageErrorPrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func TestDetails(t *testing.T) {
+	tests := []struct {
+		description        string
+		err                error
+		expectedExitCode   int
+		expectedCode       string
+		expectedHTTPStatus int
+	}{
+		{
+			description:      "generic error",
+			err:              fmt.Errorf("something went wrong"),
+			expectedExitCode: ExitCodeGeneric,
+			expectedCode:     "ERROR",
+		},
+		{
+			description:        "not found",
+			err:                fmt.Errorf("get instance: %w", oapierror.NewError(http.StatusNotFound, "Not Found")),
+			expectedExitCode:   ExitCodeNotFound,
+			expectedCode:       "NOT_FOUND",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			description:        "forbidden",
+			err:                oapierror.NewError(http.StatusForbidden, "Forbidden"),
+			expectedExitCode:   ExitCodeForbidden,
+			expectedCode:       "FORBIDDEN",
+			expectedHTTPStatus: http.StatusForbidden,
+		},
+		{
+			description:        "conflict",
+			err:                oapierror.NewError(http.StatusConflict, "Conflict"),
+			expectedExitCode:   ExitCodeConflict,
+			expectedCode:       "CONFLICT",
+			expectedHTTPStatus: http.StatusConflict,
+		},
+		{
+			description:        "unauthorized",
+			err:                oapierror.NewError(http.StatusUnauthorized, "Unauthorized"),
+			expectedExitCode:   ExitCodeUnauthenticated,
+			expectedCode:       "UNAUTHENTICATED",
+			expectedHTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			description:        "rate limited",
+			err:                oapierror.NewError(http.StatusTooManyRequests, "Too Many Requests"),
+			expectedExitCode:   ExitCodeRateLimited,
+			expectedCode:       "RATE_LIMITED",
+			expectedHTTPStatus: http.StatusTooManyRequests,
+		},
+		{
+			description:        "bad request",
+			err:                oapierror.NewError(http.StatusBadRequest, "Bad Request"),
+			expectedExitCode:   ExitCodeInvalidRequest,
+			expectedCode:       "INVALID_REQUEST",
+			expectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			description:        "server error",
+			err:                oapierror.NewError(http.StatusBadGateway, "Bad Gateway"),
+			expectedExitCode:   ExitCodeServerError,
+			expectedCode:       "SERVER_ERROR",
+			expectedHTTPStatus: http.StatusBadGateway,
+		},
+		{
+			description:      "not authenticated",
+			err:              &AuthError{},
+			expectedExitCode: ExitCodeUnauthenticated,
+			expectedCode:     "UNAUTHENTICATED",
+		},
+		{
+			description:      "session expired",
+			err:              fmt.Errorf("authentication failed: %w", &SessionExpiredError{}),
+			expectedExitCode: ExitCodeUnauthenticated,
+			expectedCode:     "UNAUTHENTICATED",
+		},
+		{
+			description:      "service disabled",
+			err:              &ServiceDisabledError{Service: "secrets-manager"},
+			expectedExitCode: ExitCodeServiceDisabled,
+			expectedCode:     "SERVICE_DISABLED",
+		},
+		{
+			description:      "project ID missing",
+			err:              &ProjectIdError{},
+			expectedExitCode: ExitCodeUsage,
+			expectedCode:     "USAGE",
+		},
+		{
+			description:      "invalid flag",
+			err:              &FlagValidationError{Flag: "name", Details: "must not be empty"},
+			expectedExitCode: ExitCodeUsage,
+			expectedCode:     "USAGE",
+		},
+		{
+			description:      "cobra usage error",
+			err:              fmt.Errorf(`required flag(s) "name" not set`),
+			expectedExitCode: ExitCodeUsage,
+			expectedCode:     "USAGE",
+		},
+		{
+			description:      "resource name not found",
+			err:              &ResourceNameNotFoundError{Resource: "server", Name: "web-01"},
+			expectedExitCode: ExitCodeNotFound,
+			expectedCode:     "NOT_FOUND",
+		},
+		{
+			description:      "resource name ambiguous",
+			err:              &ResourceNameAmbiguousError{Resource: "server", Name: "web-01", Ids: []string{"xxx", "yyy"}},
+			expectedExitCode: ExitCodeUsage,
+			expectedCode:     "USAGE",
+		},
+		{
+			description:      "plugin error",
+			err:              &PluginExitError{Plugin: "cost", ExitCode: 42},
+			expectedExitCode: 42,
+			expectedCode:     "PLUGIN",
+		},
+		{
+			description:      "shell command error",
+			err:              &ShellCommandExitError{Line: 3, ExitCode: ExitCodeNotFound},
+			expectedExitCode: ExitCodeNotFound,
+			expectedCode:     "NOT_FOUND",
+		},
+		{
+			description:      "aborted",
+			err:              print.ErrAborted,
+			expectedExitCode: ExitCodeAborted,
+			expectedCode:     "ABORTED",
+		},
+		{
+			description:      "timeout",
+			err:              fmt.Errorf("list instances: %w", context.DeadlineExceeded),
+			expectedExitCode: ExitCodeTimeout,
+			expectedCode:     "TIMEOUT",
+		},
+		{
+			description:      "wait timeout",
+			err:              &TimeoutError{Err: fmt.Errorf("wait for instance creation: %w", fmt.Errorf("WaitWithContext() has timed out"))},
+			expectedExitCode: ExitCodeTimeout,
+			expectedCode:     "TIMEOUT",
+		},
+		{
+			description:      "wait failed",
+			err:              fmt.Errorf("wait for instance creation: %w", fmt.Errorf("create failed")),
+			expectedExitCode: ExitCodeGeneric,
+			expectedCode:     "ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			details := Details(tt.err)
+			if details.ExitCode != tt.expectedExitCode {
+				t.Errorf("expected exit code %d, got %d", tt.expectedExitCode, details.ExitCode)
+			}
+			if details.Code != tt.expectedCode {
+				t.Errorf("expected code %q, got %q", tt.expectedCode, details.Code)
+			}
+			if details.HTTPStatus != tt.expectedHTTPStatus {
+				t.Errorf("expected HTTP status %d, got %d", tt.expectedHTTPStatus, details.HTTPStatus)
+			}
+			if details.Message == "" {
+				t.Errorf("expected a message")
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+const (
+	dnsDeleteSucceededState = "DELETE_SUCCEEDED"
+	dnsPageSize             = 100
+)
+
+func (c *collector) collectDNS(ctx context.Context) ([]Resource, error) {
+	zones := []dns.Zone{}
+	for page := int32(1); ; page++ {
+		resp, err := c.clients.DNS.ListZones(ctx, c.projectId).StateNeq(dnsDeleteSucceededState).Page(page).PageSize(dnsPageSize).Execute()
+		if err != nil && page == 1 {
+			err = probeError(err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get DNS zones: %w", err)
+		}
+		zones = append(zones, utils.GetSliceFromPointer(resp.Zones)...)
+		if int64(page) >= resp.GetTotalPages() {
+			break
+		}
+	}
+
+	resources := []Resource{}
+	for i := range zones {
+		zone := &zones[i]
+		zoneResource := c.zoneResource(zone)
+		resources = append(resources, zoneResource)
+
+		for page := int32(1); ; page++ {
+			resp, err := c.clients.DNS.ListRecordSets(ctx, c.projectId, zone.GetId()).StateNeq(dnsDeleteSucceededState).Page(page).PageSize(dnsPageSize).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("get record sets of DNS zone %q: %w", zone.GetName(), err)
+			}
+			for _, recordSet := range utils.GetSliceFromPointer(resp.RrSets) {
+				if isDefaultRecordSet(zone, &recordSet) {
+					continue
+				}
+				resources = append(resources, c.recordSetResource(zone, zoneResource.Terraform[0], &recordSet))
+			}
+			if int64(page) >= resp.GetTotalPages() {
+				break
+			}
+		}
+	}
+	return resources, nil
+}
+
+// isDefaultRecordSet returns whether the record set is created together with the zone, i.e. its SOA and NS records
+func isDefaultRecordSet(zone *dns.Zone, recordSet *dns.RecordSet) bool {
+	switch recordSet.GetType() {
+	case dns.RECORDSETTYPE_SOA:
+		return true
+	case dns.RECORDSETTYPE_NS:
+		return strings.TrimSuffix(recordSet.GetName(), ".") == strings.TrimSuffix(zone.GetDnsName(), ".")
+	}
+	return false
+}
+
+func (c *collector) zoneResource(zone *dns.Zone) Resource {
+	zoneType := string(zone.GetType())
+	manifest := apply.DNSZone{
+		Name:         zone.GetName(),
+		DNSName:      zone.GetDnsName(),
+		Description:  zone.GetDescription(),
+		ContactEmail: zone.GetContactEmail(),
+		DefaultTTL:   zone.DefaultTTL,
+		ACL:          zone.GetAcl(),
+		Type:         zoneType,
+		Primaries:    utils.GetSliceFromPointer(zone.Primaries),
+	}
+
+	resourceType := "stackit_dns_zone"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, zone.GetName())).
+		Set("project_id", projectIdReference).
+		Set("name", zone.GetName()).
+		Set("dns_name", zone.GetDnsName()).
+		Set("description", zone.Description).
+		Set("contact_email", zone.ContactEmail).
+		Set("default_ttl", zone.DefaultTTL).
+		Set("acl", zone.Acl).
+		Set("type", zoneType).
+		Set("primaries", zone.Primaries)
+
+	return Resource{
+		Service:   ServiceDNS,
+		Kind:      KindDNSZone,
+		Name:      zone.GetName(),
+		Id:        zone.GetId(),
+		Manifest:  &apply.Manifest{DNSZones: []apply.DNSZone{manifest}},
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, zone.GetId()}},
+	}
+}
+
+// recordSetResource returns the record set of the zone, whose Terraform resource is referenced by the one of the record set
+func (c *collector) recordSetResource(zone *dns.Zone, zoneBlock *terraform.Block, recordSet *dns.RecordSet) Resource {
+	recordType := string(recordSet.GetType())
+	records := []string{}
+	for _, r := range utils.GetSliceFromPointer(recordSet.Records) {
+		records = append(records, r.GetContent())
+	}
+	manifest := apply.DNSRecordSet{
+		// The DNS name identifies the zone, as zone names don't have to be unique
+		Zone:    zone.GetDnsName(),
+		Name:    recordSet.GetName(),
+		Type:    recordType,
+		TTL:     recordSet.Ttl,
+		Records: records,
+		Comment: recordSet.GetComment(),
+	}
+
+	name := fmt.Sprintf("%s %s", strings.TrimSuffix(recordSet.GetName(), "."), recordType)
+	resourceType := "stackit_dns_record_set"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, name)).
+		Set("project_id", projectIdReference).
+		Set("zone_id", terraform.Reference(fmt.Sprintf("%s.%s.zone_id", zoneBlock.Labels[0], zoneBlock.Labels[1]))).
+		Set("name", strings.TrimSuffix(recordSet.GetName(), ".")).
+		Set("type", recordType).
+		Set("ttl", recordSet.Ttl).
+		Set("records", records).
+		Set("comment", recordSet.Comment)
+
+	return Resource{
+		Service:   ServiceDNS,
+		Kind:      KindDNSRecordSet,
+		Name:      name,
+		Id:        recordSet.GetId(),
+		Manifest:  &apply.Manifest{DNSRecordSets: []apply.DNSRecordSet{manifest}},
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, zone.GetId(), recordSet.GetId()}},
+	}
+}
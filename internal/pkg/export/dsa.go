@@ -0,0 +1,105 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+// dsaInstance is an instance of one of the Data Service Access (DSA) services, e.g. a Redis instance.
+// The APIs of the services only differ in their types.
+type dsaInstance interface {
+	GetInstanceId() string
+	GetName() string
+	GetOfferingVersion() string
+	GetPlanName() string
+}
+
+func (c *collector) collectLogMe(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.LogMe.ListInstancesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get LogMe instances: %w", probeError(err))
+	}
+	items := utils.GetSliceFromPointer(resp.Instances)
+	instances := []dsaInstance{}
+	for i := range items {
+		instances = append(instances, &items[i])
+	}
+	return c.dsaInstanceResources(ServiceLogMe, "stackit_logme_instance", instances), nil
+}
+
+func (c *collector) collectMariaDB(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.MariaDB.ListInstancesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get MariaDB instances: %w", probeError(err))
+	}
+	items := utils.GetSliceFromPointer(resp.Instances)
+	instances := []dsaInstance{}
+	for i := range items {
+		instances = append(instances, &items[i])
+	}
+	return c.dsaInstanceResources(ServiceMariaDB, "stackit_mariadb_instance", instances), nil
+}
+
+func (c *collector) collectOpenSearch(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.OpenSearch.ListInstancesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get OpenSearch instances: %w", probeError(err))
+	}
+	items := utils.GetSliceFromPointer(resp.Instances)
+	instances := []dsaInstance{}
+	for i := range items {
+		instances = append(instances, &items[i])
+	}
+	return c.dsaInstanceResources(ServiceOpenSearch, "stackit_opensearch_instance", instances), nil
+}
+
+func (c *collector) collectRabbitMQ(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.RabbitMQ.ListInstancesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get RabbitMQ instances: %w", probeError(err))
+	}
+	items := utils.GetSliceFromPointer(resp.Instances)
+	instances := []dsaInstance{}
+	for i := range items {
+		instances = append(instances, &items[i])
+	}
+	return c.dsaInstanceResources(ServiceRabbitMQ, "stackit_rabbitmq_instance", instances), nil
+}
+
+func (c *collector) collectRedis(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.Redis.ListInstancesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get Redis instances: %w", probeError(err))
+	}
+	items := utils.GetSliceFromPointer(resp.Instances)
+	instances := []dsaInstance{}
+	for i := range items {
+		instances = append(instances, &items[i])
+	}
+	return c.dsaInstanceResources(ServiceRedis, "stackit_redis_instance", instances), nil
+}
+
+// dsaInstanceResources returns the instances, which can only be exported as Terraform resources.
+// Their parameters are left out, as they differ per service.
+func (c *collector) dsaInstanceResources(service, resourceType string, instances []dsaInstance) []Resource {
+	resources := []Resource{}
+	for _, instance := range instances {
+		block := terraform.NewResource(resourceType, c.names.Unique(resourceType, instance.GetName())).
+			Set("project_id", projectIdReference).
+			Set("name", instance.GetName()).
+			Set("version", instance.GetOfferingVersion()).
+			Set("plan_name", instance.GetPlanName())
+		resources = append(resources, Resource{
+			Service:   service,
+			Kind:      service + "-instance",
+			Name:      instance.GetName(),
+			Id:        instance.GetInstanceId(),
+			Terraform: []*terraform.Block{block},
+			ImportIds: [][]string{{c.projectId, instance.GetInstanceId()}},
+		})
+	}
+	return resources
+}
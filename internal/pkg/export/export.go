@@ -0,0 +1,362 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	dnsClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/dns/client"
+	iaasClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
+	logmeClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/logme/client"
+	mariadbClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/mariadb/client"
+	opensearchClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/client"
+	postgresflexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	rabbitmqClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/rabbitmq/client"
+	redisClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/redis/client"
+	skeClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/logme"
+	"github.com/stackitcloud/stackit-sdk-go/services/mariadb"
+	"github.com/stackitcloud/stackit-sdk-go/services/opensearch"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/stackit-sdk-go/services/rabbitmq"
+	"github.com/stackitcloud/stackit-sdk-go/services/redis"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+// Formats of the exported files
+const (
+	FormatYAML      = "yaml"
+	FormatTerraform = "terraform"
+)
+
+// Services whose resources can be exported
+const (
+	ServiceIaaS         = "iaas"
+	ServiceDNS          = "dns"
+	ServicePostgresFlex = "postgresflex"
+	ServiceSKE          = "ske"
+	ServiceLogMe        = "logme"
+	ServiceMariaDB      = "mariadb"
+	ServiceOpenSearch   = "opensearch"
+	ServiceRabbitMQ     = "rabbitmq"
+	ServiceRedis        = "redis"
+)
+
+// Kinds of exported resources, which are also the prefixes of the file names
+const (
+	KindNetwork              = "network"
+	KindSecurityGroup        = "security-group"
+	KindServer               = "server"
+	KindVolume               = "volume"
+	KindDNSZone              = "dns-zone"
+	KindDNSRecordSet         = "dns-record-set"
+	KindPostgresFlexInstance = "postgresflex-instance"
+	KindSKECluster           = "ske-cluster"
+)
+
+const (
+	projectIdVariable  = "project_id"
+	providerSource     = "stackitcloud/stackit"
+	variablesFileName  = "variables.tf"
+	terraformFileName  = "terraform.tf"
+	projectIdReference = terraform.Reference("var." + projectIdVariable)
+)
+
+var invalidFileNameCharacters = regexp.MustCompile(`[^a-z0-9_.-]+`)
+
+// Services returns the services whose resources can be exported
+func Services() []string {
+	return []string{ServiceIaaS, ServiceDNS, ServicePostgresFlex, ServiceSKE, ServiceLogMe, ServiceMariaDB, ServiceOpenSearch, ServiceRabbitMQ, ServiceRedis}
+}
+
+// Formats returns the formats resources can be exported in
+func Formats() []string {
+	return []string{FormatYAML, FormatTerraform}
+}
+
+// Resource of a project, without its read-only fields
+type Resource struct {
+	Service string
+	Kind    string
+	Name    string
+	Id      string
+
+	// Declaration of the resource in a manifest, which can be applied with "stackit apply".
+	// Nil if the kind of resource can't be declared in a manifest.
+	Manifest *apply.Manifest
+	// Payload creating the resource, for kinds of resources that can't be declared in a manifest
+	Payload any
+	// Terraform resources creating the resource
+	Terraform []*terraform.Block
+	// IDs importing each of the Terraform resources, in the order of the import ID format of its type
+	ImportIds [][]string
+}
+
+// Declarable returns whether the resource can be exported in the YAML format, i.e. as manifest or payload
+func (r *Resource) Declarable() bool {
+	return r.Manifest != nil || r.Payload != nil
+}
+
+// ImportBlocks returns the import blocks of the Terraform resources of the resource
+func (r *Resource) ImportBlocks() ([]*terraform.Block, error) {
+	blocks := []*terraform.Block{}
+	for i, block := range r.Terraform {
+		if i >= len(r.ImportIds) {
+			return nil, fmt.Errorf("%s %q: no import ID for %s", r.Kind, r.Name, strings.Join(block.Labels, "."))
+		}
+		importBlock, err := terraform.NewImport(block, r.ImportIds[i]...)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", r.Kind, r.Name, err)
+		}
+		blocks = append(blocks, importBlock)
+	}
+	return blocks, nil
+}
+
+// Clients used to read the resources. Only the clients of the exported services are needed.
+type Clients struct {
+	IaaS         *iaas.APIClient
+	DNS          *dns.APIClient
+	PostgresFlex *postgresflex.APIClient
+	SKE          *ske.APIClient
+	LogMe        *logme.APIClient
+	MariaDB      *mariadb.APIClient
+	OpenSearch   *opensearch.APIClient
+	RabbitMQ     *rabbitmq.APIClient
+	Redis        *redis.APIClient
+}
+
+// ConfigureClients configures the clients of the services
+func ConfigureClients(p *print.Printer, cliVersion string, services []string) (Clients, error) {
+	var clients Clients
+	var err error
+	for _, service := range services {
+		switch service {
+		case ServiceIaaS:
+			clients.IaaS, err = iaasClient.ConfigureClient(p, cliVersion)
+		case ServiceDNS:
+			clients.DNS, err = dnsClient.ConfigureClient(p, cliVersion)
+		case ServicePostgresFlex:
+			clients.PostgresFlex, err = postgresflexClient.ConfigureClient(p, cliVersion)
+		case ServiceSKE:
+			clients.SKE, err = skeClient.ConfigureClient(p, cliVersion)
+		case ServiceLogMe:
+			clients.LogMe, err = logmeClient.ConfigureClient(p, cliVersion)
+		case ServiceMariaDB:
+			clients.MariaDB, err = mariadbClient.ConfigureClient(p, cliVersion)
+		case ServiceOpenSearch:
+			clients.OpenSearch, err = opensearchClient.ConfigureClient(p, cliVersion)
+		case ServiceRabbitMQ:
+			clients.RabbitMQ, err = rabbitmqClient.ConfigureClient(p, cliVersion)
+		case ServiceRedis:
+			clients.Redis, err = redisClient.ConfigureClient(p, cliVersion)
+		}
+		if err != nil {
+			return clients, err
+		}
+	}
+	return clients, nil
+}
+
+// collector lists the resources of a service
+type collector struct {
+	projectId string
+	region    string
+	clients   Clients
+	names     terraform.Names
+	warnings  []string
+}
+
+// Collect lists the resources of the services in the project, describing them if the list doesn't return all their fields.
+// Services that aren't enabled for the project are skipped, which is returned as a warning.
+func Collect(ctx context.Context, clients Clients, projectId, region string, services []string) (resources []Resource, warnings []string, err error) {
+	c := &collector{
+		projectId: projectId,
+		region:    region,
+		clients:   clients,
+		names:     terraform.Names{},
+	}
+	collectors := map[string]func(context.Context) ([]Resource, error){
+		ServiceIaaS:         c.collectIaaS,
+		ServiceDNS:          c.collectDNS,
+		ServicePostgresFlex: c.collectPostgresFlex,
+		ServiceSKE:          c.collectSKE,
+		ServiceLogMe:        c.collectLogMe,
+		ServiceMariaDB:      c.collectMariaDB,
+		ServiceOpenSearch:   c.collectOpenSearch,
+		ServiceRabbitMQ:     c.collectRabbitMQ,
+		ServiceRedis:        c.collectRedis,
+	}
+	// The services are collected in a fixed order, so that the output doesn't depend on the order of the flag values
+	for _, service := range Services() {
+		if !contains(services, service) {
+			continue
+		}
+		serviceResources, err := collectors[service](ctx)
+		if isNotEnabled(err) {
+			c.warnings = append(c.warnings, fmt.Sprintf("skipped service %q, which isn't enabled for the project", service))
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, serviceResources...)
+	}
+	return resources, c.warnings, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// notEnabledError is returned if the first request of a service fails because the service isn't enabled for the project
+type notEnabledError struct {
+	err error
+}
+
+func (e *notEnabledError) Error() string {
+	return e.err.Error()
+}
+
+func (e *notEnabledError) Unwrap() error {
+	return e.err
+}
+
+// probeError checks the error of the first request of a service, for which "not found" means that the service isn't enabled for the project.
+// For the later requests "not found" is an actual error, e.g. of a resource deleted during the export, so they return their errors as they are.
+func probeError(err error) error {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+		return &notEnabledError{err: err}
+	}
+	return err
+}
+
+// isNotEnabled returns whether the error was returned because the service isn't enabled for the project
+func isNotEnabled(err error) bool {
+	var notEnabledErr *notEnabledError
+	return errors.As(err, &notEnabledErr)
+}
+
+// Write writes one file per resource into the directory and returns the paths of the written files.
+// The directory must be empty or not exist, so that no files of a previous export are left in it.
+// For the YAML format, resources that aren't declarable are skipped.
+// For the Terraform format, it also writes the variable of the project ID and the required provider.
+func Write(dir, format, projectId string, resources []Resource) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read directory: %w", err)
+	}
+	if len(entries) > 0 {
+		return nil, &cliErr.DirectoryNotEmptyError{Dir: dir}
+	}
+	err = os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	files := []string{}
+	usedNames := map[string]bool{}
+	write := func(name string, content []byte) error {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, content, 0o600)
+		if err != nil {
+			return fmt.Errorf("write file: %w", err)
+		}
+		files = append(files, path)
+		return nil
+	}
+
+	for i := range resources {
+		r := &resources[i]
+		if format == FormatYAML && !r.Declarable() {
+			continue
+		}
+		// Resources with the same file name get a number appended, which mustn't be the file name of another resource
+		baseName := fileName(r.Kind, r.Name)
+		for n := 2; usedNames[baseName]; n++ {
+			baseName = fmt.Sprintf("%s-%d", fileName(r.Kind, r.Name), n)
+		}
+		usedNames[baseName] = true
+
+		var name string
+		var content []byte
+		switch {
+		case format == FormatTerraform:
+			name = baseName + ".tf"
+			content = []byte(renderBlocks(r.Terraform))
+		case r.Manifest != nil:
+			name = baseName + ".yaml"
+			content, err = yaml.Marshal(r.Manifest)
+		default:
+			name = baseName + ".json"
+			content, err = json.MarshalIndent(r.Payload, "", "  ")
+			content = append(content, '\n')
+		}
+		if err != nil {
+			return nil, fmt.Errorf("encode %s %q: %w", r.Kind, r.Name, err)
+		}
+		err = write(name, content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if format == FormatTerraform {
+		provider := &terraform.Block{
+			Type: "terraform",
+			Blocks: []*terraform.Block{
+				(&terraform.Block{Type: "required_providers"}).Set("stackit", terraform.Object{}.Set("source", providerSource)),
+			},
+		}
+		err = write(terraformFileName, []byte(provider.String()))
+		if err != nil {
+			return nil, err
+		}
+		err = write(variablesFileName, []byte(ProjectIdVariable(projectId).String()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// ProjectIdVariable returns the variable of the project ID referenced by the Terraform resources, with the project ID as default
+func ProjectIdVariable(projectId string) *terraform.Block {
+	return terraform.NewVariable(projectIdVariable, projectId)
+}
+
+// fileName returns the name of the file of the resource, without extension, e.g. "server-web-01"
+func fileName(kind, name string) string {
+	name = invalidFileNameCharacters.ReplaceAllString(strings.ToLower(name), "-")
+	return kind + "-" + strings.Trim(name, "-.")
+}
+
+func renderBlocks(blocks []*terraform.Block) string {
+	rendered := make([]string, len(blocks))
+	for i, b := range blocks {
+		rendered[i] = b.String()
+	}
+	return strings.Join(rendered, "\n")
+}
@@ -0,0 +1,492 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/stackit-sdk-go/services/redis"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+const (
+	testProjectId       = "00000000-0000-0000-0000-000000000001"
+	testNetworkId       = "00000000-0000-0000-0000-000000000002"
+	testSecurityGroupId = "00000000-0000-0000-0000-000000000003"
+	testServerId        = "00000000-0000-0000-0000-000000000004"
+	testImageId         = "00000000-0000-0000-0000-000000000005"
+	testZoneId          = "00000000-0000-0000-0000-000000000006"
+	testRecordSetId     = "00000000-0000-0000-0000-000000000007"
+	testInstanceId      = "00000000-0000-0000-0000-000000000008"
+	testBootVolumeId    = "00000000-0000-0000-0000-000000000009"
+	testVolumeId        = "00000000-0000-0000-0000-000000000010"
+	testRedisId         = "00000000-0000-0000-0000-000000000011"
+	testRuleId          = "00000000-0000-0000-0000-000000000012"
+	testRegion          = "eu01"
+)
+
+var testCtx = context.Background()
+
+// fixtureAPI serves the resources of the project
+func fixtureAPI() map[string]any {
+	return map[string]any{
+		"/v1/projects/" + testProjectId + "/networks": iaas.NetworkListResponse{Items: &[]iaas.Network{
+			{NetworkId: utils.Ptr(testNetworkId), Name: utils.Ptr("net"), Prefixes: &[]string{"10.0.0.0/24"}},
+		}},
+		"/v1/projects/" + testProjectId + "/security-groups": iaas.SecurityGroupListResponse{Items: &[]iaas.SecurityGroup{
+			{Id: utils.Ptr(testSecurityGroupId), Name: utils.Ptr("web"), Stateful: utils.Ptr(true)},
+		}},
+		"/v1/projects/" + testProjectId + "/security-groups/" + testSecurityGroupId + "/rules": iaas.SecurityGroupRuleListResponse{Items: &[]iaas.SecurityGroupRule{
+			{
+				Id:        utils.Ptr(testRuleId),
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv4"),
+				PortRange: &iaas.PortRange{Min: utils.Ptr(int64(8000)), Max: utils.Ptr(int64(8080))},
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp")},
+			},
+		}},
+		"/v1/projects/" + testProjectId + "/servers": iaas.ServerListResponse{Items: &[]iaas.Server{
+			{
+				Id:          utils.Ptr(testServerId),
+				Name:        utils.Ptr("vm"),
+				MachineType: utils.Ptr("g1.1"),
+				BootVolume: &iaas.CreateServerPayloadBootVolume{
+					Id:     utils.Ptr(testBootVolumeId),
+					Size:   utils.Ptr(int64(64)),
+					Source: &iaas.BootVolumeSource{Id: utils.Ptr(testImageId), Type: utils.Ptr("image")},
+				},
+				Nics:           &[]iaas.ServerNetwork{{NetworkId: utils.Ptr(testNetworkId), NetworkName: utils.Ptr("net")}},
+				SecurityGroups: &[]string{"web"},
+				Labels:         &map[string]interface{}{"env": "prod"},
+			},
+		}},
+		"/v1/projects/" + testProjectId + "/volumes": iaas.VolumeListResponse{Items: &[]iaas.Volume{
+			{Id: utils.Ptr(testBootVolumeId), AvailabilityZone: utils.Ptr("eu01-1")},
+			{Id: utils.Ptr(testVolumeId), Name: utils.Ptr("data"), AvailabilityZone: utils.Ptr("eu01-1"), Size: utils.Ptr(int64(100))},
+		}},
+		"/v1/projects/" + testProjectId + "/instances": redis.ListInstancesResponse{Instances: &[]redis.Instance{
+			{InstanceId: utils.Ptr(testRedisId), Name: utils.Ptr("cache"), OfferingVersion: utils.Ptr("7"), PlanName: utils.Ptr("stackit-redis-1.2.10-replica")},
+		}},
+		"/v1/projects/" + testProjectId + "/zones": dns.ListZonesResponse{
+			Zones:      &[]dns.Zone{{Id: utils.Ptr(testZoneId), Name: utils.Ptr("example"), DnsName: utils.Ptr("example.com"), Type: utils.Ptr(dns.ZoneTypes("primary"))}},
+			TotalPages: utils.Ptr(int64(1)),
+		},
+		"/v1/projects/" + testProjectId + "/zones/" + testZoneId + "/rrsets": dns.ListRecordSetsResponse{
+			RrSets: &[]dns.RecordSet{
+				{Id: utils.Ptr("soa"), Name: utils.Ptr("example.com."), Type: utils.Ptr(dns.RecordSetTypes("SOA"))},
+				{Id: utils.Ptr("ns"), Name: utils.Ptr("example.com."), Type: utils.Ptr(dns.RecordSetTypes("NS"))},
+				{
+					Id:      utils.Ptr(testRecordSetId),
+					Name:    utils.Ptr("www.example.com."),
+					Type:    utils.Ptr(dns.RecordSetTypes("A")),
+					Ttl:     utils.Ptr(int64(60)),
+					Records: &[]dns.Record{{Content: utils.Ptr("1.2.3.4")}},
+				},
+			},
+			TotalPages: utils.Ptr(int64(1)),
+		},
+		"/v2/projects/" + testProjectId + "/regions/" + testRegion + "/instances": postgresflex.ListInstancesResponse{Items: &[]postgresflex.InstanceListInstance{
+			{Id: utils.Ptr(testInstanceId), Name: utils.Ptr("db"), Status: utils.Ptr("Ready")},
+			{Id: utils.Ptr("deleted"), Name: utils.Ptr("old"), Status: utils.Ptr("Deleted")},
+		}},
+		"/v2/projects/" + testProjectId + "/regions/" + testRegion + "/instances/" + testInstanceId: postgresflex.InstanceResponse{Item: &postgresflex.Instance{
+			Id:       utils.Ptr(testInstanceId),
+			Name:     utils.Ptr("db"),
+			Acl:      &postgresflex.ACL{Items: &[]string{"0.0.0.0/0"}},
+			Flavor:   &postgresflex.Flavor{Cpu: utils.Ptr(int64(2)), Memory: utils.Ptr(int64(4))},
+			Replicas: utils.Ptr(int64(1)),
+			Storage:  &postgresflex.Storage{Class: utils.Ptr("premium-perf2-stackit"), Size: utils.Ptr(int64(10))},
+			Version:  utils.Ptr("16"),
+			Status:   utils.Ptr("Ready"),
+		}},
+		"/v2/projects/" + testProjectId + "/regions/" + testRegion + "/clusters": ske.ListClustersResponse{Items: &[]ske.Cluster{
+			{
+				Name:       utils.Ptr("cluster"),
+				Kubernetes: &ske.Kubernetes{Version: utils.Ptr("1.31")},
+				Nodepools: &[]ske.Nodepool{{
+					Name:              utils.Ptr("pool"),
+					AvailabilityZones: &[]string{"eu01-1"},
+					Machine:           &ske.Machine{Type: utils.Ptr("c1.2"), Image: &ske.Image{Name: utils.Ptr("flatcar"), Version: utils.Ptr("4081.2.0")}},
+					Minimum:           utils.Ptr(int64(1)),
+					Maximum:           utils.Ptr(int64(2)),
+					Volume:            &ske.Volume{Size: utils.Ptr(int64(20))},
+				}},
+				Status: &ske.ClusterStatus{Aggregated: utils.Ptr(ske.ClusterStatusState("STATE_HEALTHY"))},
+			},
+		}},
+	}
+}
+
+func fixtureClients(t *testing.T, responses map[string]any) Clients {
+	t.Helper()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request while exporting: %s %s", r.Method, r.URL.Path)
+		}
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(resp)
+		if err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	})
+	mockedServer := httptest.NewServer(handler)
+	t.Cleanup(mockedServer.Close)
+
+	options := []sdkConfig.ConfigurationOption{sdkConfig.WithEndpoint(mockedServer.URL), sdkConfig.WithoutAuthentication()}
+	iaasClient, err := iaas.NewAPIClient(options...)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	dnsClient, err := dns.NewAPIClient(options...)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	postgresflexClient, err := postgresflex.NewAPIClient(options...)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	skeClient, err := ske.NewAPIClient(options...)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	redisClient, err := redis.NewAPIClient(options...)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	return Clients{IaaS: iaasClient, DNS: dnsClient, PostgresFlex: postgresflexClient, SKE: skeClient, Redis: redisClient}
+}
+
+type resourceSummary struct {
+	Kind string
+	Name string
+	Id   string
+}
+
+func TestCollect(t *testing.T) {
+	clients := fixtureClients(t, fixtureAPI())
+	services := []string{ServiceIaaS, ServiceDNS, ServicePostgresFlex, ServiceSKE, ServiceRedis}
+	resources, warnings, err := Collect(testCtx, clients, testProjectId, testRegion, services)
+	if err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("expected no warnings, got %q", warnings)
+	}
+
+	summaries := []resourceSummary{}
+	byKind := map[string]*Resource{}
+	importIds := []string{}
+	for i := range resources {
+		summaries = append(summaries, resourceSummary{Kind: resources[i].Kind, Name: resources[i].Name, Id: resources[i].Id})
+		byKind[resources[i].Kind] = &resources[i]
+		imports, err := resources[i].ImportBlocks()
+		if err != nil {
+			t.Fatalf("ImportBlocks() failed: %v", err)
+		}
+		for _, block := range imports {
+			importIds = append(importIds, block.Attributes[1].Value.(string))
+		}
+	}
+	expectedSummaries := []resourceSummary{
+		{Kind: KindNetwork, Name: "net", Id: testNetworkId},
+		{Kind: KindSecurityGroup, Name: "web", Id: testSecurityGroupId},
+		{Kind: KindServer, Name: "vm", Id: testServerId},
+		{Kind: KindVolume, Name: "data", Id: testVolumeId},
+		{Kind: KindDNSZone, Name: "example", Id: testZoneId},
+		{Kind: KindDNSRecordSet, Name: "www.example.com A", Id: testRecordSetId},
+		{Kind: KindPostgresFlexInstance, Name: "db", Id: testInstanceId},
+		{Kind: KindSKECluster, Name: "cluster", Id: "cluster"},
+		{Kind: "redis-instance", Name: "cache", Id: testRedisId},
+	}
+	diff := cmp.Diff(summaries, expectedSummaries)
+	if diff != "" {
+		t.Fatalf("Resources do not match: %s", diff)
+	}
+
+	expectedImportIds := []string{
+		testProjectId + "," + testNetworkId,
+		testProjectId + "," + testSecurityGroupId,
+		testProjectId + "," + testSecurityGroupId + "," + testRuleId,
+		testProjectId + "," + testServerId,
+		testProjectId + "," + testVolumeId,
+		testProjectId + "," + testZoneId,
+		testProjectId + "," + testZoneId + "," + testRecordSetId,
+		testProjectId + "," + testRegion + "," + testInstanceId,
+		testProjectId + "," + testRegion + ",cluster",
+		testProjectId + "," + testRedisId,
+	}
+	diff = cmp.Diff(importIds, expectedImportIds)
+	if diff != "" {
+		t.Fatalf("Import IDs do not match: %s", diff)
+	}
+
+	expectedManifests := map[string]*apply.Manifest{
+		KindSecurityGroup: {SecurityGroups: []apply.SecurityGroup{{
+			Name:     "web",
+			Stateful: utils.Ptr(true),
+			Rules:    []apply.SecurityGroupRule{{Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", Ports: "8000-8080"}},
+		}}},
+		KindServer: {Servers: []apply.Server{{
+			Name:           "vm",
+			MachineType:    "g1.1",
+			ImageId:        testImageId,
+			BootVolumeSize: utils.Ptr(int64(64)),
+			Network:        "net",
+			SecurityGroups: []string{"web"},
+			Labels:         map[string]string{"env": "prod"},
+		}}},
+		KindDNSRecordSet: {DNSRecordSets: []apply.DNSRecordSet{{
+			Zone:    "example.com",
+			Name:    "www.example.com.",
+			Type:    "A",
+			TTL:     utils.Ptr(int64(60)),
+			Records: []string{"1.2.3.4"},
+		}}},
+		KindPostgresFlexInstance: {PostgresFlexInstances: []apply.PostgresFlexInstance{{
+			Name:         "db",
+			CPU:          utils.Ptr(int64(2)),
+			RAM:          utils.Ptr(int64(4)),
+			StorageClass: "premium-perf2-stackit",
+			StorageSize:  utils.Ptr(int64(10)),
+			Version:      "16",
+			Type:         "Single",
+			ACL:          []string{"0.0.0.0/0"},
+		}}},
+	}
+	for kind, expected := range expectedManifests {
+		diff := cmp.Diff(byKind[kind].Manifest, expected)
+		if diff != "" {
+			t.Fatalf("Manifest of %s does not match: %s", kind, diff)
+		}
+	}
+
+	cluster := byKind[KindSKECluster]
+	if cluster.Manifest != nil {
+		t.Fatalf("expected no manifest for the SKE cluster")
+	}
+	payload, ok := cluster.Payload.(ske.CreateOrUpdateClusterPayload)
+	if !ok || payload.Status != nil || payload.Kubernetes.GetVersion() != "1.31" {
+		t.Fatalf("expected the payload of the SKE cluster without its status, got %+v", cluster.Payload)
+	}
+}
+
+func TestCollectSkipsServices(t *testing.T) {
+	responses := fixtureAPI()
+	delete(responses, "/v2/projects/"+testProjectId+"/regions/"+testRegion+"/clusters")
+	responses["/v1/projects/"+testProjectId+"/security-groups/"+testSecurityGroupId+"/rules"] = iaas.SecurityGroupRuleListResponse{Items: &[]iaas.SecurityGroupRule{
+		{Direction: utils.Ptr("ingress"), IcmpParameters: &iaas.ICMPParameters{Code: utils.Ptr(int64(0)), Type: utils.Ptr(int64(8))}},
+	}}
+	clients := fixtureClients(t, responses)
+
+	resources, warnings, err := Collect(testCtx, clients, testProjectId, testRegion, []string{ServiceSKE, ServiceIaaS})
+	if err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected a warning for the rules and one for SKE, got %q", warnings)
+	}
+	for i := range resources {
+		if resources[i].Service != ServiceIaaS {
+			t.Fatalf("expected IaaS resources only, got %s %q", resources[i].Kind, resources[i].Name)
+		}
+		if resources[i].Kind == KindSecurityGroup && resources[i].Manifest.SecurityGroups[0].Rules != nil {
+			t.Fatalf("expected the rules with ICMP parameters to be left out of the manifest")
+		}
+	}
+}
+
+func TestCollectFailsForDeletedResources(t *testing.T) {
+	responses := fixtureAPI()
+	// The instance is listed, but deleted before it's described
+	delete(responses, "/v2/projects/"+testProjectId+"/regions/"+testRegion+"/instances/"+testInstanceId)
+	clients := fixtureClients(t, responses)
+
+	_, _, err := Collect(testCtx, clients, testProjectId, testRegion, []string{ServicePostgresFlex})
+	if err == nil {
+		t.Fatalf("expected an error for the instance that wasn't found, instead of skipping the service")
+	}
+}
+
+func TestIsNotEnabled(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    bool
+	}{
+		{
+			description: "not found for the first request",
+			err:         fmt.Errorf("get networks: %w", probeError(&oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound})),
+			expected:    true,
+		},
+		{
+			description: "not found for a later request",
+			err:         fmt.Errorf("get rules of security group %q: %w", "default", &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound}),
+		},
+		{
+			description: "forbidden for the first request",
+			err:         probeError(&oapierror.GenericOpenAPIError{StatusCode: http.StatusForbidden}),
+		},
+		{
+			description: "other error",
+			err:         fmt.Errorf("connection refused"),
+		},
+		{
+			description: "no error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if isNotEnabled(tt.err) != tt.expected {
+				t.Fatalf("expected %t", tt.expected)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	resources := []Resource{
+		{
+			Kind:      KindNetwork,
+			Name:      "My Net",
+			Manifest:  &apply.Manifest{Networks: []apply.Network{{Name: "My Net"}}},
+			Terraform: []*terraform.Block{terraform.NewResource("stackit_network", "my_net").Set("name", "My Net")},
+		},
+		{
+			Kind:      KindNetwork,
+			Name:      "my-net",
+			Manifest:  &apply.Manifest{Networks: []apply.Network{{Name: "my-net"}}},
+			Terraform: []*terraform.Block{terraform.NewResource("stackit_network", "my_net_2").Set("name", "my-net")},
+		},
+		{
+			Kind:      KindSKECluster,
+			Name:      "cluster",
+			Payload:   ske.CreateOrUpdateClusterPayload{Kubernetes: &ske.Kubernetes{Version: utils.Ptr("1.31")}},
+			Terraform: []*terraform.Block{terraform.NewResource("stackit_ske_cluster", "cluster").Set("name", "cluster")},
+		},
+		{
+			Kind:      KindVolume,
+			Name:      "data",
+			Terraform: []*terraform.Block{terraform.NewResource("stackit_volume", "data").Set("name", "data")},
+		},
+	}
+
+	tests := []struct {
+		format        string
+		expectedFiles []string
+	}{
+		{
+			format:        FormatYAML,
+			expectedFiles: []string{"network-my-net.yaml", "network-my-net-2.yaml", "ske-cluster-cluster.json"},
+		},
+		{
+			format:        FormatTerraform,
+			expectedFiles: []string{"network-my-net.tf", "network-my-net-2.tf", "ske-cluster-cluster.tf", "volume-data.tf", "terraform.tf", "variables.tf"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "export")
+			files, err := Write(dir, tt.format, testProjectId, resources)
+			if err != nil {
+				t.Fatalf("Write() failed: %v", err)
+			}
+			names := []string{}
+			for _, file := range files {
+				names = append(names, filepath.Base(file))
+			}
+			diff := cmp.Diff(names, tt.expectedFiles)
+			if diff != "" {
+				t.Fatalf("Files do not match: %s", diff)
+			}
+
+			if tt.format == FormatYAML {
+				// The written manifests can be applied again
+				manifest, err := apply.Load(dir)
+				if err != nil {
+					t.Fatalf("Load() failed for the written manifests: %v", err)
+				}
+				if len(manifest.Networks) != 2 {
+					t.Fatalf("expected 2 networks, got %d", len(manifest.Networks))
+				}
+				return
+			}
+			variables, err := os.ReadFile(filepath.Join(dir, variablesFileName))
+			if err != nil {
+				t.Fatalf("read variables: %v", err)
+			}
+			if !strings.Contains(string(variables), testProjectId) {
+				t.Fatalf("expected the project ID as default of the variable, got %s", variables)
+			}
+		})
+	}
+}
+
+func TestWriteDuplicateNames(t *testing.T) {
+	resources := []Resource{}
+	for _, name := range []string{"web", "web", "web-2"} {
+		resources = append(resources, Resource{
+			Kind:     KindNetwork,
+			Name:     name,
+			Manifest: &apply.Manifest{Networks: []apply.Network{{Name: name}}},
+		})
+	}
+
+	dir := filepath.Join(t.TempDir(), "export")
+	files, err := Write(dir, FormatYAML, testProjectId, resources)
+	if err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	names := []string{}
+	for _, file := range files {
+		names = append(names, filepath.Base(file))
+	}
+	diff := cmp.Diff(names, []string{"network-web.yaml", "network-web-2.yaml", "network-web-2-2.yaml"})
+	if diff != "" {
+		t.Fatalf("Files do not match: %s", diff)
+	}
+	// The file of the resource named "web-2" isn't overwritten by the second resource named "web"
+	content, err := os.ReadFile(filepath.Join(dir, "network-web-2-2.yaml"))
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if !strings.Contains(string(content), "web-2") {
+		t.Fatalf("expected the network named \"web-2\", got %s", content)
+	}
+}
+
+func TestWriteNonEmptyDir(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "network-old.yaml"), []byte{}, 0o600)
+	if err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	_, err = Write(dir, FormatYAML, testProjectId, nil)
+	var dirErr *cliErr.DirectoryNotEmptyError
+	if !errors.As(err, &dirErr) {
+		t.Fatalf("expected an error for the non-empty directory, got %v", err)
+	}
+}
@@ -0,0 +1,279 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+const bootVolumeSourceImage = "image"
+
+func (c *collector) collectIaaS(ctx context.Context) ([]Resource, error) {
+	networksResp, err := c.clients.IaaS.ListNetworksExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get networks: %w", probeError(err))
+	}
+	resources := []Resource{}
+	for _, network := range utils.GetSliceFromPointer(networksResp.Items) {
+		resources = append(resources, c.networkResource(&network))
+	}
+
+	securityGroupsResp, err := c.clients.IaaS.ListSecurityGroupsExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get security groups: %w", err)
+	}
+	for _, securityGroup := range utils.GetSliceFromPointer(securityGroupsResp.Items) {
+		rulesResp, err := c.clients.IaaS.ListSecurityGroupRulesExecute(ctx, c.projectId, securityGroup.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("get rules of security group %q: %w", securityGroup.GetName(), err)
+		}
+		resources = append(resources, c.securityGroupResource(&securityGroup, utils.GetSliceFromPointer(rulesResp.Items)))
+	}
+
+	serversResp, err := c.clients.IaaS.ListServers(ctx, c.projectId).Details(true).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get servers: %w", err)
+	}
+	bootVolumes := map[string]bool{}
+	for _, server := range utils.GetSliceFromPointer(serversResp.Items) {
+		resources = append(resources, c.serverResource(&server))
+		if server.BootVolume != nil && server.BootVolume.Id != nil {
+			bootVolumes[*server.BootVolume.Id] = true
+		}
+	}
+
+	volumesResp, err := c.clients.IaaS.ListVolumesExecute(ctx, c.projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get volumes: %w", err)
+	}
+	for _, volume := range utils.GetSliceFromPointer(volumesResp.Items) {
+		// Boot volumes are part of their server
+		if bootVolumes[volume.GetId()] {
+			continue
+		}
+		resources = append(resources, c.volumeResource(&volume))
+	}
+	return resources, nil
+}
+
+func (c *collector) networkResource(network *iaas.Network) Resource {
+	prefix := ""
+	if prefixes := utils.GetSliceFromPointer(network.Prefixes); len(prefixes) > 0 {
+		prefix = prefixes[0]
+	}
+	labels := labelsFromAPI(network.Labels)
+	manifest := apply.Network{
+		Name:            network.GetName(),
+		IPv4Prefix:      prefix,
+		IPv4Nameservers: utils.GetSliceFromPointer(network.Nameservers),
+		Labels:          labels,
+	}
+
+	resourceType := "stackit_network"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, network.GetName())).
+		Set("project_id", projectIdReference).
+		Set("name", network.GetName()).
+		Set("ipv4_prefix", prefix).
+		Set("ipv4_nameservers", network.Nameservers).
+		Set("labels", labels)
+
+	return Resource{
+		Service:   ServiceIaaS,
+		Kind:      KindNetwork,
+		Name:      network.GetName(),
+		Id:        network.GetNetworkId(),
+		Manifest:  &apply.Manifest{Networks: []apply.Network{manifest}},
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, network.GetNetworkId()}},
+	}
+}
+
+func (c *collector) securityGroupResource(securityGroup *iaas.SecurityGroup, rules []iaas.SecurityGroupRule) Resource {
+	labels := labelsFromAPI(securityGroup.Labels)
+	manifest := apply.SecurityGroup{
+		Name:        securityGroup.GetName(),
+		Description: securityGroup.GetDescription(),
+		Stateful:    securityGroup.Stateful,
+		Labels:      labels,
+		Rules:       []apply.SecurityGroupRule{},
+	}
+
+	resourceType := "stackit_security_group"
+	name := c.names.Unique(resourceType, securityGroup.GetName())
+	blocks := []*terraform.Block{
+		terraform.NewResource(resourceType, name).
+			Set("project_id", projectIdReference).
+			Set("name", securityGroup.GetName()).
+			Set("description", securityGroup.Description).
+			Set("stateful", securityGroup.Stateful).
+			Set("labels", labels),
+	}
+	importIds := [][]string{{c.projectId, securityGroup.GetId()}}
+
+	ruleResourceType := "stackit_security_group_rule"
+	for i := range rules {
+		rule := &rules[i]
+		ports := ""
+		var portRange terraform.Object
+		if rule.PortRange != nil {
+			ports = strconv.FormatInt(rule.PortRange.GetMin(), 10)
+			if rule.PortRange.GetMax() != rule.PortRange.GetMin() {
+				ports += "-" + strconv.FormatInt(rule.PortRange.GetMax(), 10)
+			}
+			portRange = portRange.Set("min", rule.PortRange.Min).Set("max", rule.PortRange.Max)
+		}
+		var protocol terraform.Object
+		protocolName := ""
+		if rule.Protocol != nil {
+			protocolName = rule.Protocol.GetName()
+			protocol = protocol.Set("name", rule.Protocol.Name)
+		}
+		var icmpParameters terraform.Object
+		if rule.IcmpParameters != nil {
+			icmpParameters = icmpParameters.Set("code", rule.IcmpParameters.Code).Set("type", rule.IcmpParameters.Type)
+		}
+
+		// Rules of manifests can't declare remote security groups and ICMP parameters, so they aren't managed then
+		if manifest.Rules != nil && (rule.RemoteSecurityGroupId != nil || rule.IcmpParameters != nil) {
+			c.warnings = append(c.warnings, fmt.Sprintf("the rules of security group %q aren't exported to its manifest, as they can't be declared in manifests", securityGroup.GetName()))
+			manifest.Rules = nil
+		}
+		if manifest.Rules != nil {
+			manifest.Rules = append(manifest.Rules, apply.SecurityGroupRule{
+				Direction:   rule.GetDirection(),
+				EtherType:   rule.GetEthertype(),
+				Protocol:    protocolName,
+				Ports:       ports,
+				IPRange:     rule.GetIpRange(),
+				Description: rule.GetDescription(),
+			})
+		}
+
+		blocks = append(blocks, terraform.NewResource(ruleResourceType, c.names.Unique(ruleResourceType, fmt.Sprintf("%s_%d", name, i+1))).
+			Set("project_id", projectIdReference).
+			Set("security_group_id", terraform.Reference(fmt.Sprintf("%s.%s.security_group_id", resourceType, name))).
+			Set("direction", rule.Direction).
+			Set("ether_type", rule.Ethertype).
+			Set("ip_range", rule.IpRange).
+			Set("port_range", portRange).
+			Set("protocol", protocol).
+			Set("icmp_parameters", icmpParameters).
+			Set("remote_security_group_id", rule.RemoteSecurityGroupId).
+			Set("description", rule.Description))
+		importIds = append(importIds, []string{c.projectId, securityGroup.GetId(), rule.GetId()})
+	}
+
+	return Resource{
+		Service:   ServiceIaaS,
+		Kind:      KindSecurityGroup,
+		Name:      securityGroup.GetName(),
+		Id:        securityGroup.GetId(),
+		Manifest:  &apply.Manifest{SecurityGroups: []apply.SecurityGroup{manifest}},
+		Terraform: blocks,
+		ImportIds: importIds,
+	}
+}
+
+func (c *collector) serverResource(server *iaas.Server) Resource {
+	labels := labelsFromAPI(server.Labels)
+	manifest := apply.Server{
+		Name:             server.GetName(),
+		MachineType:      server.GetMachineType(),
+		AvailabilityZone: server.GetAvailabilityZone(),
+		ImageId:          server.GetImageId(),
+		KeypairName:      server.GetKeypairName(),
+		SecurityGroups:   utils.GetSliceFromPointer(server.SecurityGroups),
+		Labels:           labels,
+	}
+	if nics := utils.GetSliceFromPointer(server.Nics); len(nics) > 0 {
+		manifest.Network = nics[0].GetNetworkName()
+		if manifest.Network == "" {
+			manifest.Network = nics[0].GetNetworkId()
+		}
+	}
+
+	resourceType := "stackit_server"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, server.GetName())).
+		Set("project_id", projectIdReference).
+		Set("name", server.GetName()).
+		Set("machine_type", server.MachineType).
+		Set("availability_zone", server.AvailabilityZone).
+		Set("keypair_name", server.KeypairName).
+		Set("labels", labels)
+
+	bootVolume := server.BootVolume
+	if bootVolume != nil && bootVolume.Source != nil && bootVolume.Source.GetType() == bootVolumeSourceImage {
+		manifest.ImageId = bootVolume.Source.GetId()
+		manifest.BootVolumeSize = bootVolume.Size
+		block.Set("boot_volume", terraform.Object{}.
+			Set("source_type", bootVolume.Source.Type).
+			Set("source_id", bootVolume.Source.Id).
+			Set("size", bootVolume.Size).
+			Set("delete_on_termination", bootVolume.DeleteOnTermination))
+	} else {
+		block.Set("image_id", server.ImageId)
+	}
+
+	return Resource{
+		Service:   ServiceIaaS,
+		Kind:      KindServer,
+		Name:      server.GetName(),
+		Id:        server.GetId(),
+		Manifest:  &apply.Manifest{Servers: []apply.Server{manifest}},
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, server.GetId()}},
+	}
+}
+
+// volumeResource returns the volume, which can only be exported as Terraform resource
+func (c *collector) volumeResource(volume *iaas.Volume) Resource {
+	var source terraform.Object
+	if volume.Source != nil {
+		source = source.Set("type", volume.Source.Type).Set("id", volume.Source.Id)
+	}
+	resourceType := "stackit_volume"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, volumeName(volume))).
+		Set("project_id", projectIdReference).
+		Set("name", volume.Name).
+		Set("description", volume.Description).
+		Set("availability_zone", volume.AvailabilityZone).
+		Set("size", volume.Size).
+		Set("performance_class", volume.PerformanceClass).
+		Set("source", source).
+		Set("labels", labelsFromAPI(volume.Labels))
+
+	return Resource{
+		Service:   ServiceIaaS,
+		Kind:      KindVolume,
+		Name:      volumeName(volume),
+		Id:        volume.GetId(),
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, volume.GetId()}},
+	}
+}
+
+// volumeName returns the name of the volume, which is optional, falling back to its ID
+func volumeName(volume *iaas.Volume) string {
+	if volume.GetName() != "" {
+		return volume.GetName()
+	}
+	return volume.GetId()
+}
+
+// labelsFromAPI converts the labels returned by the API, which are nil if there are none
+func labelsFromAPI(labels *map[string]interface{}) map[string]string {
+	if labels == nil || len(*labels) == 0 {
+		return nil
+	}
+	converted := map[string]string{}
+	for k, v := range *labels {
+		converted[k] = fmt.Sprintf("%v", v)
+	}
+	return converted
+}
@@ -0,0 +1,85 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/apply"
+	postgresflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/wait"
+)
+
+func (c *collector) collectPostgresFlex(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.PostgresFlex.ListInstancesExecute(ctx, c.projectId, c.region)
+	if err != nil {
+		return nil, fmt.Errorf("get PostgreSQL Flex instances: %w", probeError(err))
+	}
+	resources := []Resource{}
+	for _, item := range utils.GetSliceFromPointer(resp.Items) {
+		// Instances in delayed deletion can only be restored or force deleted
+		if item.GetStatus() == wait.InstanceStateDeleted {
+			continue
+		}
+		// The list only returns the name and status of the instances
+		instanceResp, err := c.clients.PostgresFlex.GetInstanceExecute(ctx, c.projectId, c.region, item.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("get PostgreSQL Flex instance %q: %w", item.GetName(), err)
+		}
+		if instanceResp.Item == nil {
+			continue
+		}
+		resources = append(resources, c.postgresFlexInstanceResource(instanceResp.Item))
+	}
+	return resources, nil
+}
+
+func (c *collector) postgresFlexInstanceResource(instance *postgresflex.Instance) Resource {
+	instanceType, _ := postgresflexUtils.GetInstanceType(instance.GetReplicas())
+	var acl []string
+	if instance.Acl != nil {
+		acl = utils.GetSliceFromPointer(instance.Acl.Items)
+	}
+	manifest := apply.PostgresFlexInstance{
+		Name:           instance.GetName(),
+		Version:        instance.GetVersion(),
+		Type:           instanceType,
+		ACL:            acl,
+		BackupSchedule: instance.GetBackupSchedule(),
+	}
+	var flavor, storage terraform.Object
+	if instance.Flavor != nil {
+		manifest.CPU = instance.Flavor.Cpu
+		manifest.RAM = instance.Flavor.Memory
+		flavor = flavor.Set("cpu", instance.Flavor.Cpu).Set("ram", instance.Flavor.Memory)
+	}
+	if instance.Storage != nil {
+		manifest.StorageClass = instance.Storage.GetClass()
+		manifest.StorageSize = instance.Storage.Size
+		storage = storage.Set("class", instance.Storage.Class).Set("size", instance.Storage.Size)
+	}
+
+	resourceType := "stackit_postgresflex_instance"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, instance.GetName())).
+		Set("project_id", projectIdReference).
+		Set("name", instance.GetName()).
+		Set("acl", acl).
+		Set("backup_schedule", instance.BackupSchedule).
+		Set("flavor", flavor).
+		Set("replicas", instance.Replicas).
+		Set("storage", storage).
+		Set("version", instance.Version)
+
+	return Resource{
+		Service:   ServicePostgresFlex,
+		Kind:      KindPostgresFlexInstance,
+		Name:      instance.GetName(),
+		Id:        instance.GetId(),
+		Manifest:  &apply.Manifest{PostgresFlexInstances: []apply.PostgresFlexInstance{manifest}},
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, c.region, instance.GetId()}},
+	}
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/terraform"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func (c *collector) collectSKE(ctx context.Context) ([]Resource, error) {
+	resp, err := c.clients.SKE.ListClustersExecute(ctx, c.projectId, c.region)
+	if err != nil {
+		return nil, fmt.Errorf("get SKE clusters: %w", probeError(err))
+	}
+	resources := []Resource{}
+	for _, cluster := range utils.GetSliceFromPointer(resp.Items) {
+		resources = append(resources, c.clusterResource(&cluster))
+	}
+	return resources, nil
+}
+
+// clusterResource returns the cluster with the payload of "stackit ske cluster create", as clusters can't be declared in manifests
+func (c *collector) clusterResource(cluster *ske.Cluster) Resource {
+	payload := ske.CreateOrUpdateClusterPayload{
+		Extensions:  cluster.Extensions,
+		Hibernation: cluster.Hibernation,
+		Kubernetes:  cluster.Kubernetes,
+		Maintenance: cluster.Maintenance,
+		Network:     cluster.Network,
+		Nodepools:   cluster.Nodepools,
+	}
+
+	nodePools := []terraform.Object{}
+	for _, nodepool := range utils.GetSliceFromPointer(cluster.Nodepools) {
+		nodePool := terraform.Object{}.
+			Set("name", nodepool.Name).
+			Set("machine_type", nodepool.Machine.GetType()).
+			Set("os_name", nodepool.Machine.GetImage().Name).
+			Set("os_version_min", nodepool.Machine.GetImage().Version).
+			Set("minimum", nodepool.Minimum).
+			Set("maximum", nodepool.Maximum).
+			Set("max_surge", nodepool.MaxSurge).
+			Set("max_unavailable", nodepool.MaxUnavailable).
+			Set("availability_zones", nodepool.AvailabilityZones).
+			Set("volume_type", nodepool.Volume.GetType()).
+			Set("volume_size", nodepool.Volume.GetSize()).
+			Set("labels", nodepool.Labels)
+		nodePools = append(nodePools, nodePool)
+	}
+	resourceType := "stackit_ske_cluster"
+	block := terraform.NewResource(resourceType, c.names.Unique(resourceType, cluster.GetName())).
+		Set("project_id", projectIdReference).
+		Set("name", cluster.GetName()).
+		Set("kubernetes_version_min", cluster.GetKubernetes().Version).
+		Set("node_pools", nodePools)
+
+	return Resource{
+		Service:   ServiceSKE,
+		Kind:      KindSKECluster,
+		Name:      cluster.GetName(),
+		Id:        cluster.GetName(),
+		Payload:   payload,
+		Terraform: []*terraform.Block{block},
+		ImportIds: [][]string{{c.projectId, c.region, cluster.GetName()}},
+	}
+}
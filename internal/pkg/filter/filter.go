@@ -0,0 +1,608 @@
+package filter
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+)
+
+// Annotation set on the commands that support the --filter flag
+const annotation = "filter"
+
+// Expression is a parsed filter expression, such as `status=="ACTIVE" && labels.env=="prod"`.
+//
+// Expressions are evaluated against the JSON representation of an item and support:
+//   - field paths, e.g. `name`, `labels.env`, `nics[0].ipv4` or `labels["app.kubernetes.io/name"]`
+//   - string (double or single quoted), number, boolean and null literals
+//   - the comparison operators ==, !=, <, <=, >, >= and =~ (regular expression match)
+//   - the logical operators &&, || and !, and parentheses
+//
+// A field path on its own is true if the field is set and not false, 0 or empty.
+type Expression struct {
+	root node
+}
+
+// Enable marks the command as supporting the --filter flag, as it filters its items with Items
+func Enable(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[annotation] = "true"
+}
+
+// IsEnabled returns whether the command supports the --filter flag
+func IsEnabled(cmd *cobra.Command) bool {
+	return cmd.Annotations[annotation] == "true"
+}
+
+// Validate returns an error if the --filter flag is set on a command that doesn't support it,
+// or if its expression is invalid, so that this is reported before any request is made
+func Validate(p *print.Printer, cmd *cobra.Command) error {
+	expression := flags.FlagToStringValue(p, cmd, globalflags.FilterFlag)
+	if expression == "" {
+		return nil
+	}
+	if !IsEnabled(cmd) {
+		return &errors.FlagValidationError{
+			Flag:    globalflags.FilterFlag,
+			Details: fmt.Sprintf("not supported by %q, only commands listing items can be filtered", cmd.CommandPath()),
+		}
+	}
+	_, err := Parse(expression)
+	if err != nil {
+		return &errors.FlagValidationError{
+			Flag:    globalflags.FilterFlag,
+			Details: err.Error(),
+		}
+	}
+	return nil
+}
+
+// Parse parses the given filter expression
+func Parse(expression string) (*Expression, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, fmt.Errorf("parse filter %q: %w", expression, err)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("parse filter %q: %w", expression, err)
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("parse filter %q: unexpected %q", expression, p.peek().value)
+	}
+	return &Expression{root: root}, nil
+}
+
+// Match returns true if the JSON representation of the item matches the expression
+func (e *Expression) Match(item any) (bool, error) {
+	data, err := print.ToJSONObject(item)
+	if err != nil {
+		return false, err
+	}
+	value, err := e.root.eval(data)
+	if err != nil {
+		return false, err
+	}
+	return truthy(value), nil
+}
+
+// Items returns the items that match the given filter expression.
+// If the expression is empty, the items are returned unchanged.
+// List commands filter their items before truncating them to the --limit flag, so that the limit applies to the matching items.
+func Items[T any](expression string, items []T) ([]T, error) {
+	if expression == "" {
+		return items, nil
+	}
+	e, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []T{}
+	for i := range items {
+		match, err := e.Match(items[i])
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			filtered = append(filtered, items[i])
+		}
+	}
+	return filtered, nil
+}
+
+type node interface {
+	eval(data any) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n literalNode) eval(_ any) (any, error) {
+	return n.value, nil
+}
+
+// Each path element is either a string (object key) or an int (array index)
+type pathNode struct {
+	elements []any
+}
+
+func (n pathNode) eval(data any) (any, error) {
+	current := data
+	for _, element := range n.elements {
+		switch e := element.(type) {
+		case string:
+			object, ok := current.(map[string]any)
+			if !ok {
+				return nil, nil
+			}
+			value, ok := object[e]
+			if !ok {
+				// Fall back to a case-insensitive match, so that e.g. "STATUS" (as in the table header) matches "status"
+				for key, v := range object {
+					if strings.EqualFold(key, e) {
+						value = v
+						break
+					}
+				}
+			}
+			current = value
+		case int:
+			array, ok := current.([]any)
+			if !ok || e < 0 || e >= len(array) {
+				return nil, nil
+			}
+			current = array[e]
+		}
+	}
+	return current, nil
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(data any) (any, error) {
+	value, err := n.operand.eval(data)
+	if err != nil {
+		return nil, err
+	}
+	return !truthy(value), nil
+}
+
+type logicalNode struct {
+	operator    string
+	left, right node
+}
+
+func (n logicalNode) eval(data any) (any, error) {
+	left, err := n.left.eval(data)
+	if err != nil {
+		return nil, err
+	}
+	if n.operator == "&&" && !truthy(left) {
+		return false, nil
+	}
+	if n.operator == "||" && truthy(left) {
+		return true, nil
+	}
+	right, err := n.right.eval(data)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(right), nil
+}
+
+type comparisonNode struct {
+	operator    string
+	left, right node
+	// Compiled regular expression, if the operator is "=~"
+	regex *regexp.Regexp
+}
+
+func (n comparisonNode) eval(data any) (any, error) {
+	left, err := n.left.eval(data)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "=~":
+		s, ok := left.(string)
+		if !ok {
+			return false, nil
+		}
+		return n.regex.MatchString(s), nil
+	}
+
+	cmp, ok := compare(left, right)
+	if !ok {
+		return false, nil
+	}
+	switch n.operator {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", n.operator)
+	}
+}
+
+func equal(a, b any) bool {
+	if cmp, ok := compare(a, b); ok {
+		return cmp == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Compares numbers numerically and strings lexicographically.
+// Returns false if the values can't be compared
+func compare(a, b any) (int, bool) {
+	if a, ok := toFloat(a); ok {
+		b, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	default:
+		return 0, false
+	}
+}
+
+// Numbers of the items are int64 (or float64, if not an integer), numbers of the expression are float64
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case int64:
+		return v != 0
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+type tokenKind int
+
+const (
+	identifierToken tokenKind = iota
+	stringToken
+	numberToken
+	operatorToken
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "<", ">", "!", "(", ")", "[", "]", "."}
+
+func tokenize(expression string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'':
+			value, next, err := readString(expression, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: stringToken, value: value})
+			i = next
+		case isDigit(c) || (c == '-' && i+1 < len(expression) && isDigit(expression[i+1])):
+			start := i
+			i++
+			for i < len(expression) && (isDigit(expression[i]) || expression[i] == '.' || expression[i] == 'e' || expression[i] == 'E') {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, value: expression[start:i]})
+		case isIdentifierStart(c):
+			start := i
+			for i < len(expression) && isIdentifierPart(expression[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: identifierToken, value: expression[start:i]})
+		default:
+			found := false
+			for _, o := range operators {
+				if strings.HasPrefix(expression[i:], o) {
+					tokens = append(tokens, token{kind: operatorToken, value: o})
+					i += len(o)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// Reads the quoted string starting at position start, returning its value and the position after it
+func readString(expression string, start int) (value string, next int, err error) {
+	quote := expression[start]
+	var sb strings.Builder
+	for i := start + 1; i < len(expression); i++ {
+		c := expression[i]
+		if c == '\\' && i+1 < len(expression) {
+			i++
+			sb.WriteByte(expression[i])
+			continue
+		}
+		if c == quote {
+			return sb.String(), i + 1, nil
+		}
+		sb.WriteByte(c)
+	}
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", start)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '-'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) isOperator(values ...string) bool {
+	t := p.peek()
+	if p.done() || t.kind != operatorToken {
+		return false
+	}
+	for _, v := range values {
+		if t.value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expectOperator(value string) error {
+	if !p.isOperator(value) {
+		if p.done() {
+			return fmt.Errorf("expected %q, got end of expression", value)
+		}
+		return fmt.Errorf("expected %q, got %q", value, p.peek().value)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{operator: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("&&") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{operator: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("!") {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	if p.isOperator("(") {
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.expectOperator(")")
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("==", "!=", "<", "<=", ">", ">=", "=~") {
+		return left, nil
+	}
+	operator := p.peek().value
+	p.pos++
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	n := comparisonNode{operator: operator, left: left, right: right}
+	if operator == "=~" {
+		pattern, ok := right.(literalNode)
+		patternStr, isString := pattern.value.(string)
+		if !ok || !isString {
+			return nil, fmt.Errorf("the right side of %q must be a string", operator)
+		}
+		n.regex, err = regexp.Compile(patternStr)
+		if err != nil {
+			return nil, fmt.Errorf("compile regular expression %q: %w", patternStr, err)
+		}
+	}
+	return n, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	t := p.peek()
+	switch t.kind {
+	case stringToken:
+		p.pos++
+		return literalNode{value: t.value}, nil
+	case numberToken:
+		p.pos++
+		number, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.value)
+		}
+		return literalNode{value: number}, nil
+	case identifierToken:
+		switch t.value {
+		case "true":
+			p.pos++
+			return literalNode{value: true}, nil
+		case "false":
+			p.pos++
+			return literalNode{value: false}, nil
+		case "null":
+			p.pos++
+			return literalNode{value: nil}, nil
+		}
+		return p.parsePath()
+	default:
+		return nil, fmt.Errorf("unexpected %q", t.value)
+	}
+}
+
+func (p *parser) parsePath() (node, error) {
+	elements := []any{p.peek().value}
+	p.pos++
+	for {
+		switch {
+		case p.isOperator("."):
+			p.pos++
+			t := p.peek()
+			if p.done() || t.kind != identifierToken {
+				return nil, fmt.Errorf("expected field name after \".\"")
+			}
+			elements = append(elements, t.value)
+			p.pos++
+		case p.isOperator("["):
+			p.pos++
+			t := p.peek()
+			switch {
+			case t.kind == stringToken && !p.done():
+				elements = append(elements, t.value)
+			case t.kind == numberToken:
+				index, err := strconv.Atoi(t.value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %q", t.value)
+				}
+				elements = append(elements, index)
+			default:
+				return nil, fmt.Errorf("expected index or quoted key after \"[\"")
+			}
+			p.pos++
+			if err := p.expectOperator("]"); err != nil {
+				return nil, err
+			}
+		default:
+			return pathNode{elements: elements}, nil
+		}
+	}
+}
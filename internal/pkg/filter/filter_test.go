@@ -0,0 +1,193 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+type testItem struct {
+	Id     *string            `json:"id,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Status *string            `json:"status,omitempty"`
+	Size   *int64             `json:"size,omitempty"`
+	Active *bool              `json:"active,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Nics   *[]testNic         `json:"nics,omitempty"`
+}
+
+type testNic struct {
+	Ipv4 *string `json:"ipv4,omitempty"`
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+var testItemValue = testItem{
+	Id:     ptr("id-1"),
+	Name:   ptr("web-01"),
+	Status: ptr("ACTIVE"),
+	Size:   ptr(int64(20)),
+	Active: ptr(true),
+	Labels: &map[string]string{
+		"env":                    "prod",
+		"app.kubernetes.io/name": "web",
+	},
+	Nics: &[]testNic{
+		{Ipv4: ptr("10.0.0.1")},
+	},
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		description string
+		expression  string
+		expected    bool
+		isValid     bool
+	}{
+		{"equal string", `status=="ACTIVE"`, true, true},
+		{"equal string single quotes", `status=='ACTIVE'`, true, true},
+		{"not equal string", `status!="ACTIVE"`, false, true},
+		{"and", `status=="ACTIVE" && labels.env=="prod"`, true, true},
+		{"and false", `status=="ACTIVE" && labels.env=="dev"`, false, true},
+		{"or", `status=="DELETING" || labels.env=="prod"`, true, true},
+		{"not", `!(status=="DELETING")`, true, true},
+		{"precedence", `status=="DELETING" && labels.env=="dev" || name=="web-01"`, true, true},
+		{"number greater", `size > 10`, true, true},
+		{"number less or equal", `size <= 10`, false, true},
+		{"number equal", `size == 20`, true, true},
+		{"negative number", `size > -1`, true, true},
+		{"string comparison", `name >= "web"`, true, true},
+		{"bool", `active == true`, true, true},
+		{"truthy field", `active`, true, true},
+		{"missing field", `unknown`, false, true},
+		{"missing field equals null", `unknown == null`, true, true},
+		{"regex", `name =~ "^web-[0-9]+$"`, true, true},
+		{"regex no match", `name =~ "^db-"`, false, true},
+		{"quoted key", `labels["app.kubernetes.io/name"] == "web"`, true, true},
+		{"array index", `nics[0].ipv4 == "10.0.0.1"`, true, true},
+		{"array index out of range", `nics[1].ipv4 == "10.0.0.1"`, false, true},
+		{"case-insensitive field", `STATUS == "ACTIVE"`, true, true},
+		{"type mismatch", `size == "20"`, false, true},
+		{"empty", ``, false, false},
+		{"unterminated string", `status=="ACTIVE`, false, false},
+		{"missing operand", `status==`, false, false},
+		{"missing closing parenthesis", `(status=="ACTIVE"`, false, false},
+		{"unexpected token", `status=="ACTIVE" "x"`, false, false},
+		{"invalid character", `status=="ACTIVE" # comment`, false, false},
+		{"invalid regex", `name =~ "("`, false, false},
+		{"regex on non-string", `name =~ size`, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			e, err := Parse(tt.expression)
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if !tt.isValid {
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			match, err := e.Match(testItemValue)
+			if err != nil {
+				t.Fatalf("failed to match: %v", err)
+			}
+			if match != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, match)
+			}
+		})
+	}
+}
+
+func TestItems(t *testing.T) {
+	items := []testItem{
+		{Id: ptr("id-1"), Status: ptr("ACTIVE")},
+		{Id: ptr("id-2"), Status: ptr("ERROR")},
+		{Id: ptr("id-3"), Status: ptr("ACTIVE")},
+	}
+
+	filtered, err := Items(`status=="ACTIVE"`, items)
+	if err != nil {
+		t.Fatalf("failed to filter: %v", err)
+	}
+	expected := []testItem{items[0], items[2]}
+	if diff := cmp.Diff(expected, filtered); diff != "" {
+		t.Errorf("filtered items do not match: %s", diff)
+	}
+
+	unfiltered, err := Items("", items)
+	if err != nil {
+		t.Fatalf("failed with empty filter: %v", err)
+	}
+	if diff := cmp.Diff(items, unfiltered); diff != "" {
+		t.Errorf("items should be unchanged: %s", diff)
+	}
+
+	_, err = Items(`status==`, items)
+	if err == nil {
+		t.Fatalf("did not fail on invalid expression")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      string
+		enabled     bool
+		isValid     bool
+	}{
+		{
+			description: "no filter",
+			isValid:     true,
+		},
+		{
+			description: "valid filter",
+			filter:      `status=="ACTIVE"`,
+			enabled:     true,
+			isValid:     true,
+		},
+		{
+			description: "invalid filter",
+			filter:      `status==`,
+			enabled:     true,
+			isValid:     false,
+		},
+		{
+			description: "command not supporting the filter",
+			filter:      `status=="ACTIVE"`,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().String(globalflags.FilterFlag, "", "")
+			if tt.filter != "" {
+				err := cmd.Flags().Set(globalflags.FilterFlag, tt.filter)
+				if err != nil {
+					t.Fatalf("failed to set filter flag: %v", err)
+				}
+			}
+			if tt.enabled {
+				Enable(cmd)
+			}
+			p := print.NewPrinter()
+			p.Cmd = cmd
+
+			err := Validate(p, cmd)
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+type enumWithArgFlag struct {
+	options    []string
+	argOptions []string
+	value      string
+}
+
+// Ensure the implementation satisfies the expected interface
+var _ pflag.Value = &enumWithArgFlag{}
+
+// EnumWithArgFlag returns a flag which must be either one of the given options,
+// or one of the given argument options followed by "=" and a non-empty argument (e.g. "jsonpath={.id}").
+// Options are matched ignoring case and returned in lower case, the argument is returned unchanged.
+func EnumWithArgFlag(options, argOptions []string) *enumWithArgFlag {
+	return &enumWithArgFlag{options: options, argOptions: argOptions}
+}
+
+func (f *enumWithArgFlag) String() string {
+	return f.value
+}
+
+func (f *enumWithArgFlag) Set(value string) error {
+	for _, o := range f.options {
+		if strings.EqualFold(value, o) {
+			f.value = strings.ToLower(value)
+			return nil
+		}
+	}
+
+	option, arg, found := strings.Cut(value, "=")
+	for _, o := range f.argOptions {
+		if !strings.EqualFold(option, o) {
+			continue
+		}
+		if !found || arg == "" {
+			return fmt.Errorf("expected an argument for %q, e.g. %s=<value>", o, o)
+		}
+		f.value = fmt.Sprintf("%s=%s", strings.ToLower(option), arg)
+		return nil
+	}
+
+	return fmt.Errorf("expected one of %q, or one of %q followed by \"=<value>\"", f.options, f.argOptions)
+}
+
+func (f *enumWithArgFlag) Type() string {
+	return "string"
+}
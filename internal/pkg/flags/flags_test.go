@@ -274,6 +274,104 @@ func TestEnumSliceFlag(t *testing.T) {
 	}
 }
 
+func TestEnumWithArgFlag(t *testing.T) {
+	options := []string{"json", "pretty"}
+	argOptions := []string{"jsonpath", "go-template"}
+
+	tests := []struct {
+		description   string
+		value         string
+		expectedValue string
+		isValid       bool
+	}{
+		{
+			description:   "valid option",
+			value:         "json",
+			expectedValue: "json",
+			isValid:       true,
+		},
+		{
+			description:   "valid option - ignore case",
+			value:         "JSON",
+			expectedValue: "json",
+			isValid:       true,
+		},
+		{
+			description:   "valid arg option",
+			value:         "jsonpath={.id}",
+			expectedValue: "jsonpath={.id}",
+			isValid:       true,
+		},
+		{
+			description:   "valid arg option - argument case is kept",
+			value:         "Go-Template={{.Name}}",
+			expectedValue: "go-template={{.Name}}",
+			isValid:       true,
+		},
+		{
+			description:   "valid arg option - argument contains =",
+			value:         `jsonpath={.items[?(@.name=="foo")].id}`,
+			expectedValue: `jsonpath={.items[?(@.name=="foo")].id}`,
+			isValid:       true,
+		},
+		{
+			description: "empty",
+			value:       "",
+			isValid:     false,
+		},
+		{
+			description: "unknown option",
+			value:       "xml",
+			isValid:     false,
+		},
+		{
+			description: "arg option without argument",
+			value:       "jsonpath",
+			isValid:     false,
+		},
+		{
+			description: "arg option with empty argument",
+			value:       "jsonpath=",
+			isValid:     false,
+		},
+		{
+			description: "option with argument",
+			value:       "json={.id}",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			flag := EnumWithArgFlag(options, argOptions)
+			cmd := &cobra.Command{
+				Use: "test",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return nil
+				},
+			}
+			cmd.Flags().Var(flag, "test-flag", "test")
+
+			err := cmd.Flags().Set("test-flag", tt.value)
+
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if !tt.isValid {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			value := FlagToStringValue(nil, cmd, "test-flag")
+			if value != tt.expectedValue {
+				t.Fatalf("flag did not return set value (expected %q, got %q)", tt.expectedValue, value)
+			}
+		})
+	}
+}
+
 func TestUUIDFlag(t *testing.T) {
 	tests := []struct {
 		description string
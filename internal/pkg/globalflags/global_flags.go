@@ -15,9 +15,20 @@ import (
 const (
 	AsyncFlag        = "async"
 	AssumeYesFlag    = "assume-yes"
+	DryRunFlag       = "dry-run"
+	FilterFlag       = "filter"
+	LogFileFlag      = "log-file"
+	LogFormatFlag    = "log-format"
+	NoHeadersFlag    = "no-headers"
 	OutputFormatFlag = "output-format"
 	ProjectIdFlag    = "project-id"
+	RecordFlag       = "record-dir"
 	RegionFlag       = "region"
+	ReplayFlag       = "replay-dir"
+	RetriesFlag      = "retries"
+	RetryBackoffFlag = "retry-backoff"
+	TimeoutFlag      = "timeout"
+	TraceHARFlag     = "trace-har"
 	VerbosityFlag    = "verbosity"
 
 	DebugVerbosity   = string(print.DebugLevel)
@@ -28,12 +39,23 @@ const (
 	VerbosityDefault = InfoVerbosity
 )
 
-var outputFormatFlagOptions = []string{print.JSONOutputFormat, print.PrettyOutputFormat, print.NoneOutputFormat, print.YAMLOutputFormat}
+var outputFormatFlagOptions = []string{
+	print.JSONOutputFormat,
+	print.PrettyOutputFormat,
+	print.NoneOutputFormat,
+	print.YAMLOutputFormat,
+	print.CSVOutputFormat,
+	print.TSVOutputFormat,
+	print.MarkdownOutputFormat,
+}
+var outputFormatFlagArgOptions = []string{print.JSONPathOutputFormat, print.GoTemplateOutputFormat}
 var verbosityFlagOptions = []string{DebugVerbosity, InfoVerbosity, WarningVerbosity, ErrorVerbosity}
 
 type GlobalFlagModel struct {
 	Async        bool
 	AssumeYes    bool
+	Filter       string
+	NoHeaders    bool
 	OutputFormat string
 	ProjectId    string
 	Region       string
@@ -47,7 +69,8 @@ func Configure(flagSet *pflag.FlagSet) error {
 		return fmt.Errorf("bind --%s flag to config: %w", ProjectIdFlag, err)
 	}
 
-	flagSet.VarP(flags.EnumFlag(true, "", outputFormatFlagOptions...), OutputFormatFlag, "o", fmt.Sprintf("Output format, one of %q", outputFormatFlagOptions))
+	outputFormatUsage := fmt.Sprintf(`Output format, one of %q, "jsonpath=<expression>" or "go-template=<template>". The expression or template can be read from a file by prefixing the file path with "@"`, outputFormatFlagOptions)
+	flagSet.VarP(flags.EnumWithArgFlag(outputFormatFlagOptions, outputFormatFlagArgOptions), OutputFormatFlag, "o", outputFormatUsage)
 	err = viper.BindPFlag(config.OutputFormatKey, flagSet.Lookup(OutputFormatFlag))
 	if err != nil {
 		return fmt.Errorf("bind --%s flag to config: %w", OutputFormatFlag, err)
@@ -60,6 +83,10 @@ func Configure(flagSet *pflag.FlagSet) error {
 	}
 
 	flagSet.BoolP(AssumeYesFlag, "y", false, "If set, skips all confirmation prompts")
+	flagSet.Bool(DryRunFlag, false, "If set, commands that change resources print the API request they would send (method, URL and payload) instead of sending it. Requests that only read resources, e.g. to resolve names, are still sent")
+
+	flagSet.Bool(NoHeadersFlag, false, `If set, omits the table headers in the "pretty", "csv", "tsv" and "markdown" output formats`)
+	flagSet.String(FilterFlag, "", `Filter expression for list outputs, evaluated against the JSON representation of each item, e.g. 'status=="ACTIVE" && labels.env=="prod"'`)
 
 	flagSet.Var(flags.EnumFlag(true, VerbosityDefault, verbosityFlagOptions...), VerbosityFlag, fmt.Sprintf("Verbosity of the CLI, one of %q", verbosityFlagOptions))
 	err = viper.BindPFlag(config.VerbosityKey, flagSet.Lookup(VerbosityFlag))
@@ -67,19 +94,58 @@ func Configure(flagSet *pflag.FlagSet) error {
 		return fmt.Errorf("bind --%s flag to config: %w", VerbosityFlag, err)
 	}
 
+	flagSet.Var(flags.EnumFlag(false, config.LogFormatDefault, print.LogFormats...), LogFormatFlag, fmt.Sprintf("Format of the debug logs, one of %q. Log records in the %q format or written to a log file include the ID of the CLI invocation", print.LogFormats, print.JSONLogFormat))
+	err = viper.BindPFlag(config.LogFormatKey, flagSet.Lookup(LogFormatFlag))
+	if err != nil {
+		return fmt.Errorf("bind --%s flag to config: %w", LogFormatFlag, err)
+	}
+
+	flagSet.String(LogFileFlag, "", "File to write the debug logs to instead of stderr, regardless of the verbosity. The file is rotated once it reaches 10 MiB")
+	err = viper.BindPFlag(config.LogFileKey, flagSet.Lookup(LogFileFlag))
+	if err != nil {
+		return fmt.Errorf("bind --%s flag to config: %w", LogFileFlag, err)
+	}
+
 	flagSet.String(RegionFlag, "", "Target region for region-specific requests")
 	err = viper.BindPFlag(config.RegionKey, flagSet.Lookup(RegionFlag))
 	if err != nil {
 		return fmt.Errorf("bind --%s flag to config: %w", RegionFlag, err)
 	}
 
+	flagSet.Duration(TimeoutFlag, config.TimeoutDefault, "Maximum duration of the command, including all API requests and waiting for async operations. If 0, there is no limit, except for the wait command, which then waits for at most 20m")
+	err = viper.BindPFlag(config.TimeoutKey, flagSet.Lookup(TimeoutFlag))
+	if err != nil {
+		return fmt.Errorf("bind --%s flag to config: %w", TimeoutFlag, err)
+	}
+
+	flagSet.Int(RetriesFlag, config.RetriesDefault, "Maximum number of retries of an API request that failed with a transient error, such as 429 or 503 responses")
+	err = viper.BindPFlag(config.RetriesKey, flagSet.Lookup(RetriesFlag))
+	if err != nil {
+		return fmt.Errorf("bind --%s flag to config: %w", RetriesFlag, err)
+	}
+
+	flagSet.Duration(RetryBackoffFlag, config.RetryBackoffDefault, `Time waited before the first retry of an API request, doubled for each further retry. A "Retry-After" header in the response takes precedence`)
+	err = viper.BindPFlag(config.RetryBackoffKey, flagSet.Lookup(RetryBackoffFlag))
+	if err != nil {
+		return fmt.Errorf("bind --%s flag to config: %w", RetryBackoffFlag, err)
+	}
+
+	flagSet.String(RecordFlag, "", fmt.Sprintf("Directory to record the API requests and responses of the command to, with credentials and secrets redacted. The recording can be served with --%s", ReplayFlag))
+	flagSet.String(ReplayFlag, "", fmt.Sprintf("Directory of a recording made with --%s to serve the API responses from, instead of calling the API. No network access or credentials are needed", RecordFlag))
+	flagSet.String(TraceHARFlag, "", "File to write all HTTP requests and responses of the command to, in HTTP Archive (HAR) format, with credentials and secrets redacted")
+
 	return nil
 }
 
 func Parse(p *print.Printer, cmd *cobra.Command) *GlobalFlagModel {
+	// Nothing is changed in a dry run, so there is nothing to confirm
+	assumeYes := flags.FlagToBoolValue(p, cmd, AssumeYesFlag) || flags.FlagToBoolValue(p, cmd, DryRunFlag)
+
 	return &GlobalFlagModel{
 		Async:        viper.GetBool(config.AsyncKey),
-		AssumeYes:    flags.FlagToBoolValue(p, cmd, AssumeYesFlag),
+		AssumeYes:    assumeYes,
+		Filter:       flags.FlagToStringValue(p, cmd, FilterFlag),
+		NoHeaders:    flags.FlagToBoolValue(p, cmd, NoHeadersFlag),
 		OutputFormat: viper.GetString(config.OutputFormatKey),
 		ProjectId:    viper.GetString(config.ProjectIdKey),
 		Region:       viper.GetString(config.RegionKey),
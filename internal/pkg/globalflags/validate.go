@@ -0,0 +1,88 @@
+package globalflags
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// ValidateConfig returns an error for each value of the configuration bound to a global flag that the flag would reject.
+// Values set in the config file or as environment variables are not validated when commands are run.
+func ValidateConfig() []error {
+	errs := []error{}
+	values := map[string]pflag.Value{
+		config.ProjectIdKey:    flags.UUIDFlag(),
+		config.OutputFormatKey: flags.EnumWithArgFlag(outputFormatFlagOptions, outputFormatFlagArgOptions),
+		config.VerbosityKey:    flags.EnumFlag(true, "", verbosityFlagOptions...),
+		config.LogFormatKey:    flags.EnumFlag(false, "", print.LogFormats...),
+	}
+	for _, key := range []string{config.ProjectIdKey, config.OutputFormatKey, config.VerbosityKey, config.LogFormatKey} {
+		value := viper.GetString(key)
+		if value == "" {
+			continue
+		}
+		err := values[key].Set(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %q: %w", key, value, err))
+		}
+	}
+
+	for _, key := range []string{config.TimeoutKey, config.RetryBackoffKey, config.SessionTimeLimitKey} {
+		err := validateDuration(viper.Get(key))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	if s, ok := viper.Get(config.RetriesKey).(string); ok {
+		retries, err := strconv.Atoi(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %q: must be a number", config.RetriesKey, s))
+		} else if retries < 0 {
+			errs = append(errs, fmt.Errorf("%s %q: must not be negative", config.RetriesKey, s))
+		}
+	} else if viper.GetInt(config.RetriesKey) < 0 {
+		errs = append(errs, fmt.Errorf("%s: must not be negative", config.RetriesKey))
+	}
+	return errs
+}
+
+// validateDuration returns an error if the value is not a valid, non-negative duration.
+// Durations written by the CLI are strings such as "30s", numbers are taken as nanoseconds.
+func validateDuration(value any) error {
+	var duration time.Duration
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration, e.g. \"30s\"", v)
+		}
+		duration = d
+	case time.Duration:
+		duration = v
+	case int:
+		duration = time.Duration(v)
+	case int64:
+		duration = time.Duration(v)
+	case float64:
+		duration = time.Duration(v)
+	default:
+		return fmt.Errorf("%v is not a duration, e.g. \"30s\"", v)
+	}
+	if duration < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
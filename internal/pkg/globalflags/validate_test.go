@@ -0,0 +1,70 @@
+package globalflags
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+
+	"github.com/spf13/viper"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		description    string
+		values         map[string]any
+		expectedErrors int
+	}{
+		{
+			description: "no values",
+			values:      map[string]any{},
+		},
+		{
+			description: "valid values",
+			values: map[string]any{
+				config.ProjectIdKey:        "3e9bd2c8-4c8e-4b9e-9c7e-0d0a6f7c2b1a",
+				config.OutputFormatKey:     "jsonpath={.id}",
+				config.VerbosityKey:        "DEBUG",
+				config.LogFormatKey:        "json",
+				config.TimeoutKey:          "5m",
+				config.RetryBackoffKey:     float64(1000000000),
+				config.SessionTimeLimitKey: "2h",
+				config.RetriesKey:          float64(3),
+			},
+		},
+		{
+			description: "invalid values",
+			values: map[string]any{
+				config.ProjectIdKey:        "invalid-uuid",
+				config.OutputFormatKey:     "xml",
+				config.VerbosityKey:        "loud",
+				config.LogFormatKey:        "xml",
+				config.TimeoutKey:          "5 minutes",
+				config.RetryBackoffKey:     "-1s",
+				config.SessionTimeLimitKey: true,
+				config.RetriesKey:          "many",
+			},
+			expectedErrors: 8,
+		},
+		{
+			description: "negative retries",
+			values: map[string]any{
+				config.RetriesKey: float64(-1),
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			for key, value := range tt.values {
+				viper.Set(key, value)
+			}
+
+			errs := ValidateConfig()
+			if len(errs) != tt.expectedErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tt.expectedErrors, len(errs), errs)
+			}
+		})
+	}
+}
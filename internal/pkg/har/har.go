@@ -0,0 +1,467 @@
+package har
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/redact"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	version     = "1.2"
+	creatorName = "stackit-cli"
+
+	// Bodies are only included up to this size, so that e.g. image uploads don't end up in the archive
+	maxBodySize = 1024 * 1024
+
+	filePerm = 0o600
+
+	startedDateTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// HTTP Archive, as specified in http://www.softwareishard.com/blog/har-12-spec/
+type archive struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string  `json:"version"`
+	Creator creator `json:"creator"`
+	Entries []entry `json:"entries"`
+}
+
+type creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type entry struct {
+	StartedDateTime string   `json:"startedDateTime"`
+	Time            float64  `json:"time"`
+	Request         request  `json:"request"`
+	Response        response `json:"response"`
+	Cache           struct{} `json:"cache"`
+	Timings         timings  `json:"timings"`
+	Comment         string   `json:"comment,omitempty"`
+}
+
+type request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []nameValue `json:"cookies"`
+	Headers     []nameValue `json:"headers"`
+	QueryString []nameValue `json:"queryString"`
+	PostData    *postData   `json:"postData,omitempty"`
+	HeadersSize int64       `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+type response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []nameValue `json:"cookies"`
+	Headers     []nameValue `json:"headers"`
+	Content     content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int64       `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+type nameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type postData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type content struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// Durations in milliseconds, -1 if the phase does not apply, e.g. no DNS lookup for a reused connection
+type timings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	SSL     float64 `json:"ssl"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+type recorder struct {
+	path       string
+	cliVersion string
+
+	mu      sync.Mutex
+	entries []*entry
+}
+
+// Recorder of the current invocation, nil if no HTTP Archive is written
+var active *recorder
+
+// Key of the context value set on the requests handled by the middleware, so that they are not handled again by the transport
+type handledKey struct{}
+
+// Start records the HTTP traffic of the command, if the --trace-har flag is set
+func Start(p *print.Printer, cmd *cobra.Command, cliVersion string) {
+	path := flags.FlagToStringValue(p, cmd, globalflags.TraceHARFlag)
+	if path == "" {
+		return
+	}
+	p.Debug(print.DebugLevel, "recording HTTP traffic to HTTP Archive %q", path)
+	active = &recorder{
+		path:       path,
+		cliVersion: cliVersion,
+	}
+}
+
+// Save writes the HTTP traffic recorded so far to the HTTP Archive and stops the recording
+func Save() error {
+	r := active
+	if r == nil {
+		return nil
+	}
+	active = nil
+
+	// Entries are in the order the requests were started
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := make([]entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		entries = append(entries, *e)
+	}
+
+	content := &bytes.Buffer{}
+	encoder := json.NewEncoder(content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(archive{
+		Log: harLog{
+			Version: version,
+			Creator: creator{Name: creatorName, Version: r.cliVersion},
+			Entries: entries,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal HTTP Archive: %w", err)
+	}
+	err = os.WriteFile(r.path, content.Bytes(), filePerm)
+	if err != nil {
+		return fmt.Errorf("write HTTP Archive: %w", err)
+	}
+	return nil
+}
+
+// IsActive returns whether the HTTP traffic is recorded
+func IsActive() bool {
+	return active != nil
+}
+
+// Middleware is an SDK middleware that records the requests of an API client
+func Middleware(rt http.RoundTripper) http.RoundTripper {
+	return &roundTripperWithHAR{transport: rt}
+}
+
+// Transport wraps the transport of HTTP clients that are not created by the SDK, such as the ones of the token flows,
+// so that their requests are recorded too. Requests already handled by the middleware are passed through.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if active == nil {
+		return rt
+	}
+	return &roundTripperWithHAR{transport: rt, skipHandled: true}
+}
+
+type roundTripperWithHAR struct {
+	transport   http.RoundTripper
+	skipHandled bool
+}
+
+func (rt *roundTripperWithHAR) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := rt.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := active
+	if r == nil || (rt.skipHandled && req.Context().Value(handledKey{}) != nil) {
+		return transport.RoundTrip(req)
+	}
+
+	t := &tracer{}
+	ctx := context.WithValue(req.Context(), handledKey{}, true)
+	req = req.WithContext(httptrace.WithClientTrace(ctx, t.clientTrace()))
+
+	e := &entry{
+		Request: buildRequest(req),
+	}
+	t.start = time.Now()
+	e.StartedDateTime = t.start.Format(startedDateTimeFormat)
+	r.add(e)
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		r.update(func() {
+			e.Comment = fmt.Sprintf("request failed: %v", err)
+			e.Response = response{Cookies: []nameValue{}, Headers: []nameValue{}, HeadersSize: -1, BodySize: -1}
+			e.Timings, e.Time = t.timings(time.Now())
+		})
+		return nil, err
+	}
+
+	r.update(func() {
+		e.Response = buildResponse(resp)
+		e.Timings, e.Time = t.timings(time.Now())
+	})
+	resp.Body = &bodyRecorder{
+		ReadCloser: resp.Body,
+		onDone: func(body []byte, size int64, truncated bool) {
+			r.update(func() {
+				e.Response.BodySize = size
+				e.Response.Content.Size = size
+				if truncated {
+					e.Response.Content.Comment = fmt.Sprintf("content larger than %d bytes is not included", maxBodySize)
+				} else {
+					e.Response.Content.Text = redact.Body(body, resp.Header.Get("Content-Type"))
+				}
+				e.Timings, e.Time = t.timings(time.Now())
+			})
+		},
+	}
+	return resp, nil
+}
+
+func (r *recorder) add(e *entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, e)
+}
+
+// Entries are updated while the response is read, possibly concurrently to other requests
+func (r *recorder) update(f func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f()
+}
+
+func buildRequest(req *http.Request) request {
+	u, err := url.Parse(redact.URL(req.URL))
+	if err != nil {
+		u = req.URL
+	}
+	queryString := []nameValue{}
+	for name, values := range u.Query() {
+		for _, value := range values {
+			queryString = append(queryString, nameValue{Name: name, Value: value})
+		}
+	}
+	sortNameValues(queryString)
+
+	harRequest := request{
+		Method:      req.Method,
+		URL:         u.String(),
+		HTTPVersion: httpVersion(req.Proto),
+		Cookies:     []nameValue{},
+		Headers:     buildHeaders(req.Header),
+		QueryString: queryString,
+		HeadersSize: -1,
+		BodySize:    req.ContentLength,
+	}
+
+	// The body is only read if it can be read again by the transport, which is the case for the SDK requests
+	if req.GetBody != nil && req.ContentLength > 0 && req.ContentLength <= maxBodySize {
+		body, err := req.GetBody()
+		if err == nil {
+			content, err := io.ReadAll(body)
+			_ = body.Close()
+			if err == nil {
+				harRequest.PostData = &postData{
+					MimeType: req.Header.Get("Content-Type"),
+					Text:     redact.Body(content, req.Header.Get("Content-Type")),
+				}
+			}
+		}
+	}
+	return harRequest
+}
+
+func buildResponse(resp *http.Response) response {
+	return response{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: httpVersion(resp.Proto),
+		Cookies:     []nameValue{},
+		Headers:     buildHeaders(resp.Header),
+		Content: content{
+			MimeType: resp.Header.Get("Content-Type"),
+		},
+		RedirectURL: resp.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+}
+
+func buildHeaders(header http.Header) []nameValue {
+	headers := []nameValue{}
+	for name, values := range redact.Header(header) {
+		for _, value := range values {
+			headers = append(headers, nameValue{Name: name, Value: value})
+		}
+	}
+	sortNameValues(headers)
+	return headers
+}
+
+func sortNameValues(values []nameValue) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
+}
+
+func httpVersion(proto string) string {
+	if proto == "" {
+		return "HTTP/1.1"
+	}
+	return proto
+}
+
+// bodyRecorder keeps the first bytes of the response body while it is read by the caller
+type bodyRecorder struct {
+	io.ReadCloser
+	onDone func(body []byte, size int64, truncated bool)
+
+	body      []byte
+	size      int64
+	truncated bool
+	done      bool
+}
+
+func (b *bodyRecorder) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	if !b.truncated {
+		if len(b.body)+n > maxBodySize {
+			b.truncated = true
+			b.body = nil
+		} else {
+			b.body = append(b.body, p[:n]...)
+		}
+	}
+	if err == io.EOF {
+		b.finish()
+	}
+	return n, err
+}
+
+func (b *bodyRecorder) Close() error {
+	b.finish()
+	return b.ReadCloser.Close()
+}
+
+func (b *bodyRecorder) finish() {
+	if b.done {
+		return
+	}
+	b.done = true
+	b.onDone(b.body, b.size, b.truncated)
+}
+
+// tracer collects the times of the phases of a request
+type tracer struct {
+	mu           sync.Mutex
+	start        time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	gotConn      time.Time
+	wroteRequest time.Time
+	firstByte    time.Time
+}
+
+func (t *tracer) clientTrace() *httptrace.ClientTrace {
+	set := func(field *time.Time) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		*field = time.Now()
+	}
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { set(&t.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { set(&t.dnsDone) },
+		ConnectStart:         func(_, _ string) { set(&t.connectStart) },
+		ConnectDone:          func(_, _ string, _ error) { set(&t.connectDone) },
+		TLSHandshakeStart:    func() { set(&t.tlsStart) },
+		GotConn:              func(httptrace.GotConnInfo) { set(&t.gotConn) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { set(&t.wroteRequest) },
+		GotFirstResponseByte: func() { set(&t.firstByte) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { set(&t.tlsDone) },
+	}
+}
+
+// timings returns the durations of the phases of the request up to now, and their total.
+// If the request did not go through the network, e.g. when it is replayed, the whole duration is accounted as wait.
+func (t *tracer) timings(now time.Time) (timings, float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tm := timings{
+		Blocked: -1,
+		DNS:     between(t.dnsStart, t.dnsDone),
+		Connect: between(t.connectStart, t.connectDone),
+		SSL:     between(t.tlsStart, t.tlsDone),
+	}
+	switch {
+	case t.wroteRequest.IsZero():
+		tm.Send = 0
+		tm.Wait = milliseconds(now.Sub(t.start))
+		tm.Receive = 0
+	case t.firstByte.IsZero():
+		tm.Send = between(t.gotConn, t.wroteRequest)
+		tm.Wait = milliseconds(now.Sub(t.wroteRequest))
+		tm.Receive = 0
+	default:
+		tm.Send = between(t.gotConn, t.wroteRequest)
+		tm.Wait = milliseconds(t.firstByte.Sub(t.wroteRequest))
+		tm.Receive = milliseconds(now.Sub(t.firstByte))
+	}
+	if tm.Send < 0 {
+		tm.Send = 0
+	}
+	return tm, milliseconds(now.Sub(t.start))
+}
+
+func between(start, end time.Time) float64 {
+	if start.IsZero() || end.IsZero() {
+		return -1
+	}
+	return milliseconds(end.Sub(start))
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
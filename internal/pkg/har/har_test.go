@@ -0,0 +1,188 @@
+package har
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func doRequest(t *testing.T, client *http.Client, method, requestURL, body string) {
+	t.Helper()
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // only used in tests
+	_, err = io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response body: %v", err)
+	}
+}
+
+func readArchive(t *testing.T, path string) (content string, a *archive) {
+	t.Helper()
+	file, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read HTTP Archive: %v", err)
+	}
+	a = &archive{}
+	err = json.Unmarshal(file, a)
+	if err != nil {
+		t.Fatalf("parse HTTP Archive: %v", err)
+	}
+	return string(file), a
+}
+
+func TestMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.har")
+	active = &recorder{path: path, cliVersion: "1.0.0"}
+	defer func() { active = nil }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":"xxx","password":"p4ssw0rd"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"xxx"}`)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: Middleware(http.DefaultTransport)}
+
+	doRequest(t, client, http.MethodPost, server.URL+"/instances?token=abc", `{"name":"test","secret":"s3cr3t"}`)
+	doRequest(t, client, http.MethodGet, server.URL+"/instances/xxx", "")
+
+	err := Save()
+	if err != nil {
+		t.Fatalf("save HTTP Archive: %v", err)
+	}
+	if IsActive() {
+		t.Errorf("expected recording to be stopped")
+	}
+
+	content, a := readArchive(t, path)
+	for _, secret := range []string{"secret-token", "p4ssw0rd", "s3cr3t", "token=abc"} {
+		if strings.Contains(content, secret) {
+			t.Errorf("HTTP Archive contains %q: %s", secret, content)
+		}
+	}
+	if a.Log.Version != version || a.Log.Creator.Name != creatorName || a.Log.Creator.Version != "1.0.0" {
+		t.Errorf("unexpected log metadata: %+v", a.Log)
+	}
+	if len(a.Log.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(a.Log.Entries))
+	}
+
+	tests := []struct {
+		description      string
+		entry            entry
+		expectedMethod   string
+		expectedStatus   int
+		expectedPostData string
+		expectedContent  string
+	}{
+		{
+			description:      "create",
+			entry:            a.Log.Entries[0],
+			expectedMethod:   http.MethodPost,
+			expectedStatus:   http.StatusCreated,
+			expectedPostData: `{"name":"test","secret":"REDACTED"}`,
+			expectedContent:  `{"id":"xxx","password":"REDACTED"}`,
+		},
+		{
+			description:     "get",
+			entry:           a.Log.Entries[1],
+			expectedMethod:  http.MethodGet,
+			expectedStatus:  http.StatusOK,
+			expectedContent: `{"id":"xxx"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if tt.entry.Request.Method != tt.expectedMethod {
+				t.Errorf("expected method %q, got %q", tt.expectedMethod, tt.entry.Request.Method)
+			}
+			if tt.entry.Response.Status != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, tt.entry.Response.Status)
+			}
+			postData := ""
+			if tt.entry.Request.PostData != nil {
+				postData = tt.entry.Request.PostData.Text
+			}
+			if postData != tt.expectedPostData {
+				t.Errorf("expected post data %q, got %q", tt.expectedPostData, postData)
+			}
+			if tt.entry.Response.Content.Text != tt.expectedContent {
+				t.Errorf("expected content %q, got %q", tt.expectedContent, tt.entry.Response.Content.Text)
+			}
+			for _, header := range tt.entry.Request.Headers {
+				if header.Name == "Authorization" && header.Value != "REDACTED" {
+					t.Errorf("expected Authorization header to be redacted, got %q", header.Value)
+				}
+			}
+			if tt.entry.Time < 0 || tt.entry.Timings.Wait < 0 || tt.entry.Timings.Receive < 0 {
+				t.Errorf("unexpected timings: %+v", tt.entry.Timings)
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.har")
+	active = &recorder{path: path}
+	defer func() { active = nil }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	// A request made by an authentication flow inside an API client is only recorded once, by the middleware
+	tokenFlowClient := &http.Client{Transport: Transport(http.DefaultTransport)}
+	authenticated := &http.Client{Transport: Middleware(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return tokenFlowClient.Transport.RoundTrip(req)
+	}))}
+	doRequest(t, authenticated, http.MethodGet, server.URL+"/resources", "")
+
+	// A request of the token flow itself is recorded by the transport
+	doRequest(t, tokenFlowClient, http.MethodPost, server.URL+"/token", "")
+
+	err := Save()
+	if err != nil {
+		t.Fatalf("save HTTP Archive: %v", err)
+	}
+	_, a := readArchive(t, path)
+	if len(a.Log.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(a.Log.Entries))
+	}
+}
+
+func TestTransportInactive(t *testing.T) {
+	if Transport(http.DefaultTransport) != http.DefaultTransport {
+		t.Errorf("expected transport to be returned as is when not recording")
+	}
+	err := Save()
+	if err != nil {
+		t.Errorf("save without recording: %v", err)
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,375 @@
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/redact"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Annotation set on the commands whose runs are recorded in the journal
+	annotation = "journal"
+
+	// Name of the journal file, in the config folder
+	fileName = "history.jsonl"
+
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+	OutcomeAborted   = "aborted"
+)
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Path segments followed by the ID of the project or organization the resources belong to, which are not resources of the command
+var scopeSegments = []string{"projects", "organizations"}
+
+// Entry is a run of a command that changes resources
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Profile   string    `json:"profile"`
+	Identity  string    `json:"identity,omitempty"`
+	Host      string    `json:"host,omitempty"`
+	ProjectId string    `json:"projectId,omitempty"`
+	// Command line of the run, with the values of flags holding secrets redacted
+	Command string `json:"command"`
+	// IDs of the resources changed by the run, taken from the paths and responses of its API requests
+	ResourceIds []string `json:"resourceIds,omitempty"`
+	Outcome     string   `json:"outcome"`
+	ExitCode    int      `json:"exitCode"`
+	Error       string   `json:"error,omitempty"`
+}
+
+type journalRun struct {
+	cmd   *cobra.Command
+	start time.Time
+
+	mu          sync.Mutex
+	resourceIds []string
+}
+
+// Run of the current invocation, nil if it is not recorded
+var active *journalRun
+
+// Enable marks the command as changing resources, so that its runs are recorded in the journal
+func Enable(cmd *cobra.Command) {
+	if cmd.RunE == nil {
+		return
+	}
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[annotation] = "true"
+}
+
+// IsEnabled returns whether the runs of the command are recorded in the journal
+func IsEnabled(cmd *cobra.Command) bool {
+	return cmd.Annotations[annotation] == "true"
+}
+
+// Start begins recording the run of the command, if it changes resources.
+// Dry runs and replays of recorded responses don't change anything, so they are not recorded.
+func Start(p *print.Printer, cmd *cobra.Command) {
+	// A command run before in the interactive shell may have left its run active
+	Stop()
+
+	if !IsEnabled(cmd) || dryrun.IsActive() || cassette.IsReplaying() {
+		return
+	}
+	p.Debug(print.DebugLevel, "the run of the command is recorded in the journal %q", FilePath())
+	active = &journalRun{cmd: cmd, start: time.Now()}
+}
+
+// Stop ends recording the run, without adding it to the journal
+func Stop() {
+	active = nil
+}
+
+// IsActive returns whether the run of the current command is recorded
+func IsActive() bool {
+	return active != nil
+}
+
+// Record adds the run of the current command to the journal, with the outcome given by the error it returned.
+// The arguments are the ones of the command line, which are redacted before they are written.
+func Record(p *print.Printer, inputArgs []string, cmdErr error) {
+	r := active
+	Stop()
+	if r == nil {
+		return
+	}
+	entry := r.entry(inputArgs, cmdErr)
+	err := appendEntry(FilePath(), entry)
+	if err != nil {
+		p.Warn("record the command in the history: %v\n", err)
+	}
+}
+
+func (r *journalRun) entry(inputArgs []string, cmdErr error) *Entry {
+	profile, err := config.GetProfile()
+	if err != nil {
+		profile = config.DefaultProfileName
+	}
+	// The identity is unknown when authenticated with an access token only
+	identity, err := auth.GetAuthEmail()
+	if err != nil {
+		identity = ""
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = ""
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry := &Entry{
+		Time:        r.start.UTC(),
+		Profile:     profile,
+		Identity:    identity,
+		Host:        host,
+		ProjectId:   viper.GetString(config.ProjectIdKey),
+		Command:     strings.Join(append([]string{"stackit"}, RedactArgs(r.cmd, inputArgs)...), " "),
+		ResourceIds: slices.Clone(r.resourceIds),
+		Outcome:     OutcomeSucceeded,
+	}
+	if cmdErr != nil {
+		details := cliErr.Details(cmdErr)
+		entry.Outcome = OutcomeFailed
+		if details.ExitCode == cliErr.ExitCodeAborted {
+			entry.Outcome = OutcomeAborted
+		}
+		entry.ExitCode = details.ExitCode
+		entry.Error = details.Message
+	}
+	return entry
+}
+
+// RedactArgs returns the arguments with the values of the flags holding secrets, e.g. passwords, replaced
+func RedactArgs(cmd *cobra.Command, inputArgs []string) []string {
+	redacted := make([]string, 0, len(inputArgs))
+	redactNext, afterFlags := false, false
+	for _, arg := range inputArgs {
+		if redactNext {
+			redacted = append(redacted, redact.Redacted)
+			redactNext = false
+			continue
+		}
+		// The arguments after "--" are not flags
+		afterFlags = afterFlags || arg == "--"
+		if afterFlags || !strings.HasPrefix(arg, "-") {
+			redacted = append(redacted, arg)
+			continue
+		}
+
+		name, _, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		flagName := name
+		if !strings.HasPrefix(arg, "--") {
+			if f := cmd.Flags().ShorthandLookup(name); f != nil {
+				flagName = f.Name
+			}
+		}
+		if !redact.IsSensitive(flagName) {
+			redacted = append(redacted, arg)
+			continue
+		}
+		if hasValue {
+			redacted = append(redacted, fmt.Sprintf("%s=%s", strings.SplitN(arg, "=", 2)[0], redact.Redacted))
+			continue
+		}
+		redacted = append(redacted, arg)
+		// Boolean flags, e.g. to show a password, have no value
+		f := cmd.Flags().Lookup(flagName)
+		redactNext = f == nil || f.Value.Type() != "bool"
+	}
+	return redacted
+}
+
+// Middleware is an SDK middleware that collects the IDs of the resources changed by the API requests of the recorded run
+func Middleware(rt http.RoundTripper) http.RoundTripper {
+	return &roundTripperWithJournal{transport: rt}
+}
+
+type roundTripperWithJournal struct {
+	transport http.RoundTripper
+}
+
+func (rt *roundTripperWithJournal) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.transport.RoundTrip(req)
+	r := active
+	if r == nil || err != nil || isReadOnly(req.Method) || resp.StatusCode >= http.StatusBadRequest {
+		return resp, err
+	}
+
+	ids := resourceIdsFromPath(req.URL.Path)
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read response body: %w", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		ids = append(ids, resourceIdsFromBody(body)...)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		if !slices.Contains(r.resourceIds, id) {
+			r.resourceIds = append(r.resourceIds, id)
+		}
+	}
+	return resp, nil
+}
+
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// resourceIdsFromPath returns the UUIDs in the path, except the ones of the project or organization
+func resourceIdsFromPath(path string) []string {
+	ids := []string{}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !uuidRegex.MatchString(segment) {
+			continue
+		}
+		if i > 0 && slices.Contains(scopeSegments, segments[i-1]) {
+			continue
+		}
+		ids = append(ids, segment)
+	}
+	return ids
+}
+
+// resourceIdsFromBody returns the ID of the resource in the response, e.g. of a created resource.
+// Some APIs wrap the resource in an object, e.g. {"zone": {"id": ...}}, whose ID is taken if there is none at the top level.
+func resourceIdsFromBody(body []byte) []string {
+	var object map[string]any
+	err := json.Unmarshal(body, &object)
+	if err != nil {
+		return nil
+	}
+	if id := objectId(object); id != "" {
+		return []string{id}
+	}
+	ids := []string{}
+	for _, value := range object {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		if id := objectId(nested); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func objectId(object map[string]any) string {
+	for _, key := range []string{"id", "instanceId"} {
+		if id, ok := object[key].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// FilePath returns the path to the journal, which is shared by all profiles
+func FilePath() string {
+	return filepath.Join(config.GetProfileFolderPath(config.DefaultProfileName), fileName)
+}
+
+// appendEntry adds the entry as a line to the journal, which is only ever appended to
+func appendEntry(path string, entry *Entry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal entry: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open journal: %w", err)
+	}
+	_, err = f.Write(append(content, '\n'))
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write journal: %w", err)
+	}
+	return f.Close()
+}
+
+// Filter selects the entries of the journal. Empty fields match all entries.
+type Filter struct {
+	Since     time.Time
+	ProjectId string
+}
+
+func (f Filter) matches(entry *Entry) bool {
+	if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+		return false
+	}
+	return f.ProjectId == "" || strings.EqualFold(entry.ProjectId, f.ProjectId)
+}
+
+// Read returns the entries of the journal matching the filter, from the oldest to the newest.
+// It returns no entries if no command was recorded yet.
+func Read(filter Filter) ([]Entry, error) {
+	return readEntries(FilePath(), filter)
+}
+
+func readEntries(path string, filter Filter) ([]Entry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Entry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry Entry
+		err := json.Unmarshal(scanner.Bytes(), &entry)
+		if err != nil {
+			return nil, fmt.Errorf("parse line %d of journal %q: %w", line, path, err)
+		}
+		if filter.matches(&entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read journal: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,257 @@
+package journal
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+const (
+	testProjectId = "11111111-1111-1111-1111-111111111111"
+	testServerId  = "22222222-2222-2222-2222-222222222222"
+	testZoneId    = "33333333-3333-3333-3333-333333333333"
+)
+
+func TestRedactArgs(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("password", "p", "", "")
+	cmd.Flags().Bool("show-password", false, "")
+	cmd.Flags().String("name", "", "")
+
+	tests := []struct {
+		description string
+		args        []string
+		expected    []string
+	}{
+		{
+			description: "no secrets",
+			args:        []string{"server", "create", "--name", "vm"},
+			expected:    []string{"server", "create", "--name", "vm"},
+		},
+		{
+			description: "flag with value",
+			args:        []string{"user", "create", "--password", "secret", "--name", "user"},
+			expected:    []string{"user", "create", "--password", "REDACTED", "--name", "user"},
+		},
+		{
+			description: "flag with equals sign",
+			args:        []string{"user", "create", "--password=secret"},
+			expected:    []string{"user", "create", "--password=REDACTED"},
+		},
+		{
+			description: "shorthand flag",
+			args:        []string{"user", "create", "-p", "secret"},
+			expected:    []string{"user", "create", "-p", "REDACTED"},
+		},
+		{
+			description: "boolean flag",
+			args:        []string{"user", "create", "--show-password", "user"},
+			expected:    []string{"user", "create", "--show-password", "user"},
+		},
+		{
+			description: "unknown flag",
+			args:        []string{"auth", "login", "--access-token", "token"},
+			expected:    []string{"auth", "login", "--access-token", "REDACTED"},
+		},
+		{
+			description: "after flags terminator",
+			args:        []string{"run", "--", "--password", "secret"},
+			expected:    []string{"run", "--", "--password", "secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diff := cmp.Diff(RedactArgs(cmd, tt.args), tt.expected)
+			if diff != "" {
+				t.Fatalf("Arguments do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Cleanup(Stop)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/zones"):
+			_, _ = w.Write([]byte(`{"zone": {"id": "` + testZoneId + `", "name": "zone"}}`))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			_, _ = w.Write([]byte(`{"id": "44444444-4444-4444-4444-444444444444"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: Middleware(http.DefaultTransport)}
+
+	active = &journalRun{}
+	requests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/v1/projects/" + testProjectId + "/servers"},
+		{http.MethodPost, "/v1/projects/" + testProjectId + "/zones"},
+		{http.MethodDelete, "/v1/projects/" + testProjectId + "/servers/" + testServerId},
+		{http.MethodPost, "/v1/projects/" + testProjectId + "/servers/55555555-5555-5555-5555-555555555555/stop"},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(r.method, server.URL+r.path, http.NoBody)
+		if err != nil {
+			t.Fatalf("create request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s request failed: %v", r.method, err)
+		}
+		// The response body is still readable by the API client
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil || (resp.StatusCode == http.StatusOK && len(body) == 0) {
+			t.Fatalf("read response body of %s request: %v", r.method, err)
+		}
+	}
+
+	// The IDs are taken from the requests changing resources, in their order, but not from the failed ones
+	expected := []string{testZoneId, testServerId, "44444444-4444-4444-4444-444444444444"}
+	if diff := cmp.Diff(active.resourceIds, expected); diff != "" {
+		t.Fatalf("Resource IDs do not match: %s", diff)
+	}
+}
+
+func TestResourceIdsFromPath(t *testing.T) {
+	path := "/v1/organizations/" + testProjectId + "/projects/" + testProjectId + "/zones/" + testZoneId + "/rrsets/" + testServerId
+	diff := cmp.Diff(resourceIdsFromPath(path), []string{testZoneId, testServerId})
+	if diff != "" {
+		t.Fatalf("Resource IDs do not match: %s", diff)
+	}
+}
+
+func TestResourceIdsFromBody(t *testing.T) {
+	tests := []struct {
+		description string
+		body        string
+		expected    []string
+	}{
+		{
+			description: "top level id",
+			body:        `{"id": "xxx", "network": {"id": "yyy"}}`,
+			expected:    []string{"xxx"},
+		},
+		{
+			description: "instance id",
+			body:        `{"instanceId": "xxx"}`,
+			expected:    []string{"xxx"},
+		},
+		{
+			description: "nested id",
+			body:        `{"zone": {"id": "xxx"}, "message": "ok"}`,
+			expected:    []string{"xxx"},
+		},
+		{
+			description: "no id",
+			body:        `{"message": "ok"}`,
+			expected:    []string{},
+		},
+		{
+			description: "not an object",
+			body:        `[{"id": "xxx"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diff := cmp.Diff(resourceIdsFromBody([]byte(tt.body)), tt.expected)
+			if diff != "" {
+				t.Fatalf("Resource IDs do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", fileName)
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{Time: now.Add(-48 * time.Hour), Profile: "default", ProjectId: testProjectId, Command: "stackit server delete xxx", Outcome: OutcomeSucceeded},
+		{Time: now.Add(-time.Hour), Profile: "dev", ProjectId: "other", Command: "stackit dns zone create", Outcome: OutcomeFailed, ExitCode: 6, Error: "conflict"},
+		{Time: now, Profile: "default", ProjectId: testProjectId, Command: "stackit server stop xxx", ResourceIds: []string{"xxx"}, Outcome: OutcomeSucceeded},
+	}
+
+	read, err := readEntries(path, Filter{})
+	if err != nil {
+		t.Fatalf("reading a missing journal failed: %v", err)
+	}
+	if len(read) != 0 {
+		t.Fatalf("expected no entries in a missing journal, got %d", len(read))
+	}
+
+	for i := range entries {
+		err := appendEntry(path, &entries[i])
+		if err != nil {
+			t.Fatalf("appendEntry() failed: %v", err)
+		}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat journal: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected the journal to be only readable by the user, got %v", info.Mode().Perm())
+	}
+
+	tests := []struct {
+		description string
+		filter      Filter
+		expected    []Entry
+	}{
+		{
+			description: "all",
+			expected:    entries,
+		},
+		{
+			description: "since",
+			filter:      Filter{Since: now.Add(-24 * time.Hour)},
+			expected:    entries[1:],
+		},
+		{
+			description: "project",
+			filter:      Filter{ProjectId: testProjectId},
+			expected:    []Entry{entries[0], entries[2]},
+		},
+		{
+			description: "since and project",
+			filter:      Filter{Since: now.Add(-24 * time.Hour), ProjectId: testProjectId},
+			expected:    entries[2:],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			read, err := readEntries(path, tt.filter)
+			if err != nil {
+				t.Fatalf("readEntries() failed: %v", err)
+			}
+			diff := cmp.Diff(read, tt.expected)
+			if diff != "" {
+				t.Fatalf("Entries do not match: %s", diff)
+			}
+		})
+	}
+
+	err = os.WriteFile(path, []byte("not json\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write journal: %v", err)
+	}
+	_, err = readEntries(path, Filter{})
+	if err == nil {
+		t.Fatalf("readEntries() did not fail for a corrupted journal")
+	}
+}
@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/viper"
+)
+
+// Plugins are executables on the PATH named "stackit-<name>", run as "stackit <name>"
+const ExecutablePrefix = "stackit-"
+
+// Environment variables set for the plugins
+const (
+	ProfileEnvVar     = config.ProfileEnvVar
+	ProjectIdEnvVar   = "STACKIT_PROJECT_ID"
+	RegionEnvVar      = "STACKIT_REGION"
+	AccessTokenEnvVar = "STACKIT_ACCESS_TOKEN"
+)
+
+type Plugin struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Whether the plugin is shadowed by a built-in command or by a plugin with the same name earlier on the PATH
+	Shadowed bool `json:"shadowed"`
+}
+
+// Find returns the plugin with the given name, or nil if there is no such executable on the PATH
+func Find(name string) *Plugin {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil
+	}
+	path, err := exec.LookPath(ExecutablePrefix + name)
+	if err != nil {
+		return nil
+	}
+	return &Plugin{Name: name, Path: path}
+}
+
+// List returns the plugins found on the PATH, sorted by name and in PATH order for plugins with the same name.
+// Plugins named like one of the built-in commands can't be run and are marked as shadowed.
+func List(isBuiltin func(name string) bool) []Plugin {
+	plugins := []Plugin{}
+	found := map[string]bool{}
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		// Plugins in the current directory aren't run, as exec.LookPath refuses relative paths, so they aren't listed either
+		if !filepath.IsAbs(dir) {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			plugins = append(plugins, Plugin{
+				Name:     name,
+				Path:     path,
+				Shadowed: isBuiltin(name) || found[name],
+			})
+			found[name] = true
+		}
+	}
+	sort.SliceStable(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins
+}
+
+func pluginName(fileName string) (string, bool) {
+	if !strings.HasPrefix(fileName, ExecutablePrefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(fileName, ExecutablePrefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return name, name != ""
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode()&0o111 != 0
+}
+
+// Run runs the plugin with the arguments, passing on stdin, stdout and stderr.
+// The active profile, project ID, region and a fresh access token are set in the environment of the plugin.
+func Run(ctx context.Context, p *print.Printer, plugin *Plugin, args []string) error {
+	cmd := exec.CommandContext(ctx, plugin.Path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = p.Cmd.OutOrStdout()
+	cmd.Stderr = p.Cmd.ErrOrStderr()
+	cmd.Env = append(os.Environ(), Env(p)...)
+
+	p.Debug(print.DebugLevel, "running plugin %q: %s", plugin.Name, print.BuildDebugStrFromSlice(cmd.Args))
+	err := cmd.Run()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return &cliErr.PluginExitError{Plugin: plugin.Name, ExitCode: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("run plugin %q: %w", plugin.Name, err)
+	}
+	return nil
+}
+
+// Env returns the environment variables set for the plugins, in the "KEY=value" form.
+// Values that are not configured are left out, as is the access token when not authenticated.
+func Env(p *print.Printer) []string {
+	env := []string{}
+	profile, err := config.GetProfile()
+	if err != nil {
+		p.Debug(print.ErrorLevel, "get profile for plugin: %v", err)
+	} else {
+		env = append(env, fmt.Sprintf("%s=%s", ProfileEnvVar, profile))
+	}
+	if projectId := viper.GetString(config.ProjectIdKey); projectId != "" {
+		env = append(env, fmt.Sprintf("%s=%s", ProjectIdEnvVar, projectId))
+	}
+	if region := viper.GetString(config.RegionKey); region != "" {
+		env = append(env, fmt.Sprintf("%s=%s", RegionEnvVar, region))
+	}
+	accessToken, err := accessToken(p)
+	if err != nil {
+		p.Debug(print.DebugLevel, "no access token is passed to the plugin: %v", err)
+	} else {
+		env = append(env, fmt.Sprintf("%s=%s", AccessTokenEnvVar, accessToken))
+	}
+	return env
+}
+
+func accessToken(p *print.Printer) (string, error) {
+	userSessionExpired, err := auth.UserSessionExpired()
+	if err != nil {
+		return "", err
+	}
+	if userSessionExpired {
+		return "", &cliErr.SessionExpiredError{}
+	}
+	return auth.GetValidAccessToken(p)
+}
@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeExecutable(t *testing.T, dir, name string, perm os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"), perm)
+	if err != nil {
+		t.Fatalf("write executable: %v", err)
+	}
+	return path
+}
+
+func TestList(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executables are identified by their extension on Windows")
+	}
+	firstDir := t.TempDir()
+	secondDir := t.TempDir()
+	cost := writeExecutable(t, firstDir, "stackit-cost", 0o755)
+	dns := writeExecutable(t, firstDir, "stackit-dns", 0o755)
+	writeExecutable(t, firstDir, "stackit-not-executable", 0o644)
+	writeExecutable(t, firstDir, "other-tool", 0o755)
+	secondCost := writeExecutable(t, secondDir, "stackit-cost", 0o755)
+	bootstrap := writeExecutable(t, secondDir, "stackit-team-bootstrap", 0o755)
+	t.Setenv("PATH", firstDir+string(os.PathListSeparator)+secondDir)
+
+	plugins := List(func(name string) bool { return name == "dns" || name == "ske" })
+
+	expected := []Plugin{
+		{Name: "cost", Path: cost},
+		{Name: "cost", Path: secondCost, Shadowed: true},
+		{Name: "dns", Path: dns, Shadowed: true},
+		{Name: "team-bootstrap", Path: bootstrap},
+	}
+	diff := cmp.Diff(plugins, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestListSkipsRelativeDirs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executables are identified by their extension on Windows")
+	}
+	workDir := t.TempDir()
+	writeExecutable(t, workDir, "stackit-local", 0o755)
+	t.Chdir(workDir)
+	dir := t.TempDir()
+	cost := writeExecutable(t, dir, "stackit-cost", 0o755)
+	t.Setenv("PATH", strings.Join([]string{"", ".", dir}, string(os.PathListSeparator)))
+
+	plugins := List(func(string) bool { return false })
+
+	// The plugins of the current directory can't be found by Find, so they aren't listed either
+	expected := []Plugin{
+		{Name: "cost", Path: cost},
+	}
+	diff := cmp.Diff(plugins, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if Find("local") != nil {
+		t.Fatalf("expected the plugin in the current directory not to be found")
+	}
+}
+
+func TestFind(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executables are identified by their extension on Windows")
+	}
+	dir := t.TempDir()
+	cost := writeExecutable(t, dir, "stackit-cost", 0o755)
+	t.Setenv("PATH", dir)
+
+	tests := []struct {
+		description  string
+		name         string
+		expectedPath string
+	}{
+		{
+			description:  "found",
+			name:         "cost",
+			expectedPath: cost,
+		},
+		{
+			description: "not found",
+			name:        "bootstrap",
+		},
+		{
+			description: "path",
+			name:        "../stackit-cost",
+		},
+		{
+			description: "empty",
+			name:        "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := Find(tt.name)
+			if tt.expectedPath == "" {
+				if p != nil {
+					t.Fatalf("expected no plugin, got %q", p.Path)
+				}
+				return
+			}
+			if p == nil {
+				t.Fatalf("expected plugin %q to be found", tt.name)
+			}
+			if p.Path != tt.expectedPath {
+				t.Errorf("expected path %q, got %q", tt.expectedPath, p.Path)
+			}
+		})
+	}
+}
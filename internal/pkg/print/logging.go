@@ -0,0 +1,166 @@
+package print
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lmittmann/tint"
+	"github.com/mattn/go-colorable"
+)
+
+const (
+	TextLogFormat = "text"
+	JSONLogFormat = "json"
+
+	// Size from which the log file is rotated
+	maxLogFileSize = 10 * 1024 * 1024
+	// Number of rotated log files that are kept, named "<file>.1" (the most recent) to "<file>.<maxLogFileBackups>"
+	maxLogFileBackups = 3
+
+	logFilePerm = 0o600
+	logDirPerm  = 0o700
+
+	// Attribute added to all log records, to correlate the records of the same CLI invocation
+	invocationIdAttribute = "invocation_id"
+)
+
+var LogFormats = []string{TextLogFormat, JSONLogFormat}
+
+// Whether the logs are written to a file, which receives the debug logs regardless of the verbosity
+var logsToFile bool
+
+// ConfigureLogger sets up the default logger with the given format, writing to the file if one is provided or to stderr otherwise.
+// Records in the JSON format or written to a file include the invocation ID, which identifies the command run.
+// The debug logs are written to the file regardless of the verbosity, while they are only written to stderr with the debug verbosity.
+// The returned closer must be called once the logs are written.
+func ConfigureLogger(format, filePath, invocationId string) (io.Closer, error) {
+	var w io.Writer = colorable.NewColorable(os.Stderr)
+	var closer io.Closer = io.NopCloser(nil)
+	if filePath != "" {
+		file, err := openRotatingFile(filePath, maxLogFileSize, maxLogFileBackups)
+		if err != nil {
+			return nil, fmt.Errorf("open log file: %w", err)
+		}
+		w = file
+		closer = file
+	}
+
+	var handler slog.Handler
+	switch format {
+	case TextLogFormat, "":
+		handler = tint.NewHandler(w, &tint.Options{
+			AddSource: false,
+			Level:     slog.LevelDebug,
+			// Colours would only clutter a log file
+			NoColor: filePath != "",
+		})
+	case JSONLogFormat:
+		handler = slog.NewJSONHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug})
+	default:
+		_ = closer.Close()
+		return nil, fmt.Errorf("log format %q is not supported, supported formats are %q", format, LogFormats)
+	}
+
+	logger := slog.New(handler)
+	// On stderr, the text records are read by a person right away and don't need to be correlated
+	if format == JSONLogFormat || filePath != "" {
+		logger = logger.With(invocationIdAttribute, invocationId)
+	}
+	slog.SetDefault(logger)
+	logsToFile = filePath != ""
+	return closer, nil
+}
+
+// rotatingFile is a file that is renamed to "<file>.1" once it reaches the maximum size, after which a new file is started
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	file       *os.File
+	size       int64
+	maxSize    int64
+	maxBackups int
+}
+
+func openRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	err := os.MkdirAll(filepath.Dir(path), logDirPerm)
+	if err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	err = f.open()
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePerm)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(b []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(b)) > f.maxSize {
+		err := f.rotate()
+		if err != nil {
+			return 0, fmt.Errorf("rotate log file: %w", err)
+		}
+	}
+	n, err := f.file.Write(b)
+	f.size += int64(n)
+	return n, err
+}
+
+// rotate shifts the backups by one, dropping the oldest, and moves the current file to the first backup
+func (f *rotatingFile) rotate() error {
+	err := f.file.Close()
+	if err != nil {
+		return err
+	}
+	for i := f.maxBackups - 1; i >= 1; i-- {
+		err = os.Rename(backupPath(f.path, i), backupPath(f.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if f.maxBackups > 0 {
+		err = os.Rename(f.path, backupPath(f.path, 1))
+	} else {
+		err = os.Remove(f.path)
+	}
+	if err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,203 @@
+package print
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+const testInvocationId = "00000000-0000-0000-0000-000000000001"
+
+func TestConfigureLogger(t *testing.T) {
+	tests := []struct {
+		description string
+		format      string
+		isValid     bool
+		isJSON      bool
+	}{
+		{
+			description: "text",
+			format:      TextLogFormat,
+			isValid:     true,
+		},
+		{
+			description: "json",
+			format:      JSONLogFormat,
+			isValid:     true,
+			isJSON:      true,
+		},
+		{
+			description: "invalid format",
+			format:      "xml",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			defaultLogger := slog.Default()
+			defer func() {
+				slog.SetDefault(defaultLogger)
+				logsToFile = false
+			}()
+			logFile := filepath.Join(t.TempDir(), "logs", "cli.log")
+
+			closer, err := ConfigureLogger(tt.format, logFile, testInvocationId)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			slog.Debug("request to https://example.com")
+			err = closer.Close()
+			if err != nil {
+				t.Fatalf("close log file: %v", err)
+			}
+
+			content, err := os.ReadFile(logFile)
+			if err != nil {
+				t.Fatalf("read log file: %v", err)
+			}
+			if !strings.Contains(string(content), testInvocationId) {
+				t.Errorf("log record does not include the invocation ID: %s", content)
+			}
+			if tt.isJSON {
+				record := map[string]any{}
+				err = json.Unmarshal(content, &record)
+				if err != nil {
+					t.Fatalf("log record is not valid JSON: %v", err)
+				}
+				if record["msg"] != "request to https://example.com" {
+					t.Errorf("unexpected message in log record: %v", record["msg"])
+				}
+				if record[invocationIdAttribute] != testInvocationId {
+					t.Errorf("expected invocation ID %q, got %v", testInvocationId, record[invocationIdAttribute])
+				}
+			}
+		})
+	}
+}
+
+func TestInvocationIdPerRun(t *testing.T) {
+	defaultLogger := slog.Default()
+	defer func() {
+		slog.SetDefault(defaultLogger)
+		logsToFile = false
+	}()
+	logFile := filepath.Join(t.TempDir(), "cli.log")
+
+	// Like the commands run in the shell, each run configures the logger with its own invocation ID
+	invocationIds := []string{testInvocationId, "00000000-0000-0000-0000-000000000002"}
+	for _, invocationId := range invocationIds {
+		closer, err := ConfigureLogger(JSONLogFormat, logFile, invocationId)
+		if err != nil {
+			t.Fatalf("failed on valid input: %v", err)
+		}
+		slog.Debug("run command")
+		err = closer.Close()
+		if err != nil {
+			t.Fatalf("close log file: %v", err)
+		}
+	}
+
+	content, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("read log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != len(invocationIds) {
+		t.Fatalf("expected %d log records, got %q", len(invocationIds), content)
+	}
+	for i, line := range lines {
+		record := map[string]any{}
+		err = json.Unmarshal([]byte(line), &record)
+		if err != nil {
+			t.Fatalf("log record is not valid JSON: %v", err)
+		}
+		if record[invocationIdAttribute] != invocationIds[i] {
+			t.Errorf("expected invocation ID %q in record %d, got %v", invocationIds[i], i, record[invocationIdAttribute])
+		}
+	}
+}
+
+func TestDebugLogsToFile(t *testing.T) {
+	defaultLogger := slog.Default()
+	defer func() {
+		slog.SetDefault(defaultLogger)
+		logsToFile = false
+	}()
+	logFile := filepath.Join(t.TempDir(), "cli.log")
+
+	closer, err := ConfigureLogger(TextLogFormat, logFile, testInvocationId)
+	if err != nil {
+		t.Fatalf("failed on valid input: %v", err)
+	}
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	p := &Printer{Cmd: cmd, Verbosity: ErrorLevel}
+	p.Debug(DebugLevel, "request to %s", "https://example.com")
+	err = closer.Close()
+	if err != nil {
+		t.Fatalf("close log file: %v", err)
+	}
+
+	content, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("read log file: %v", err)
+	}
+	if !strings.Contains(string(content), "request to https://example.com") {
+		t.Errorf("expected the debug log in the log file regardless of the verbosity, got %q", content)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output with the error verbosity, got %q", buf.String())
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cli.log")
+	f, err := openRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatalf("open file: %v", err)
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err = f.Write([]byte(line))
+		if err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	expectedFiles := map[string]string{
+		path:                "fourth\n",
+		backupPath(path, 1): "third\n",
+		backupPath(path, 2): "second\n",
+	}
+	for file, expectedContent := range expectedFiles {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %q: %v", file, err)
+		}
+		if string(content) != expectedContent {
+			t.Errorf("expected %q to contain %q, got %q", file, expectedContent, content)
+		}
+	}
+	_, err = os.Stat(backupPath(path, 3))
+	if !os.IsNotExist(err) {
+		t.Errorf("expected the oldest backup to be removed")
+	}
+}
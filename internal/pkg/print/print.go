@@ -35,14 +35,30 @@ const (
 	// Originally defined in "internal/pkg/config/config.go"
 	outputFormatKey = "output-format"
 
+	// Needed to avoid import cycle
+	// Originally defined in "internal/pkg/watch/watch.go"
+	watchFlag = "watch"
+
+	// Command used to page long outputs if the PAGER environment variable is not set
+	defaultPager = "less"
+
 	JSONOutputFormat   = "json"
 	PrettyOutputFormat = "pretty"
 	NoneOutputFormat   = "none"
 	YAMLOutputFormat   = "yaml"
+
+	// Tabular output formats, rendered from the same tables as the pretty output format
+	CSVOutputFormat      = "csv"
+	TSVOutputFormat      = "tsv"
+	MarkdownOutputFormat = "markdown"
+
+	// Output formats that take an argument, e.g. "jsonpath={.id}"
+	JSONPathOutputFormat   = "jsonpath"
+	GoTemplateOutputFormat = "go-template"
 )
 
 var (
-	errAborted = errors.New("operation aborted")
+	ErrAborted = errors.New("operation aborted")
 
 	WhiteBold  = color.New(color.FgHiWhite, color.Bold).SprintFunc()
 	RedBold    = color.New(color.FgHiRed, color.Bold).SprintFunc()
@@ -86,9 +102,9 @@ func (p *Printer) Outputln(msg string) {
 }
 
 // Print a Debug level log through the "slog" package.
-// If the verbosity level is not Debug, it does nothing
+// If the verbosity level is not Debug and the logs aren't written to a file, it does nothing
 func (p *Printer) Debug(level Level, msg string, args ...any) {
-	if !p.IsVerbosityDebug() {
+	if !p.IsDebugLogged() {
 		return
 	}
 	msg = fmt.Sprintf(msg, args...)
@@ -147,7 +163,7 @@ func (p *Printer) PromptForConfirmation(prompt string) error {
 			return nil
 		}
 		if answer == "" || answer == "n" || answer == "no" {
-			return errAborted
+			return ErrAborted
 		}
 	}
 	return fmt.Errorf("max number of wrong inputs")
@@ -181,11 +197,16 @@ func (p *Printer) PromptForPassword(prompt string) (string, error) {
 
 // Shows the content in the command's stdout using the "less" command
 // If output format is set to none, it does nothing
+// If the --watch flag is set, the content is printed without pager, as it is redrawn repeatedly
 func (p *Printer) PagerDisplay(content string) error {
 	outputFormat := viper.GetString(outputFormatKey)
 	if outputFormat == NoneOutputFormat {
 		return nil
 	}
+	if p.isWatching() {
+		p.Outputln(content)
+		return nil
+	}
 
 	// less arguments
 	// -F: exits if the entire file fits on the first screen
@@ -193,7 +214,7 @@ func (p *Printer) PagerDisplay(content string) error {
 	// -w: highlight the first line after moving one full page down
 	// -R: interprets ANSI color and style sequences
 	// -K: exits if an interrupt character is typed
-	pagerCmd := exec.Command("less", "-F", "-S", "-w", "-R", "-K")
+	pagerCmd := exec.Command(defaultPager, "-F", "-S", "-w", "-R", "-K")
 
 	pager, pagerExists := os.LookupEnv("PAGER")
 	if pagerExists && pager != "nil" && pager != "" {
@@ -212,11 +233,25 @@ func (p *Printer) PagerDisplay(content string) error {
 	return nil
 }
 
+// Pager returns the command used to page long outputs, "less" unless the PAGER environment variable is set
+func Pager() string {
+	pager, pagerExists := os.LookupEnv("PAGER")
+	if pagerExists && pager != "nil" && pager != "" {
+		return pager
+	}
+	return defaultPager
+}
+
 // Returns True if the verbosity level is set to Debug, False otherwise.
 func (p *Printer) IsVerbosityDebug() bool {
 	return p.Verbosity == DebugLevel
 }
 
+// Returns True if debug logs are written, either because the verbosity level is set to Debug or because the logs are written to a file, False otherwise.
+func (p *Printer) IsDebugLogged() bool {
+	return p.IsVerbosityDebug() || logsToFile
+}
+
 // Returns True if the verbosity level is set to Info, False otherwise.
 func (p *Printer) IsVerbosityInfo() bool {
 	return p.Verbosity == InfoLevel
@@ -232,9 +267,9 @@ func (p *Printer) IsVerbosityError() bool {
 	return p.Verbosity == ErrorLevel
 }
 
-// DebugInputModel prints the given input model in case verbosity level is set to Debug, does nothing otherwise
+// DebugInputModel prints the given input model in case debug logs are written, does nothing otherwise
 func (p *Printer) DebugInputModel(model any) {
-	if p.IsVerbosityDebug() {
+	if p.IsDebugLogged() {
 		modelStr, err := buildDebugStrFromInputModel(model)
 		if err != nil {
 			p.Debug(ErrorLevel, "convert model to string for debugging: %v", err)
@@ -244,8 +279,11 @@ func (p *Printer) DebugInputModel(model any) {
 	}
 }
 
+// OutputResult prints the output in the given output format.
+// Pretty output is delegated to prettyOutputFunc, all other formats are derived from the output object.
 func (p *Printer) OutputResult(outputFormat string, output any, prettyOutputFunc func() error) error {
-	switch outputFormat {
+	format, argument := ParseOutputFormat(outputFormat)
+	switch format {
 	case JSONOutputFormat:
 		details, err := json.MarshalIndent(output, "", "  ")
 		if err != nil {
@@ -262,7 +300,20 @@ func (p *Printer) OutputResult(outputFormat string, output any, prettyOutputFunc
 		p.Outputln(string(details))
 
 		return nil
+	case JSONPathOutputFormat:
+		return p.outputJSONPath(output, argument)
+	case GoTemplateOutputFormat:
+		return p.outputGoTemplate(output, argument)
 	default:
 		return prettyOutputFunc()
 	}
 }
+
+// Returns whether the --watch flag is set
+func (p *Printer) isWatching() bool {
+	if p.Cmd == nil {
+		return false
+	}
+	f := p.Cmd.Flags().Lookup(watchFlag)
+	return f != nil && f.Value.String() == "true"
+}
@@ -675,10 +675,10 @@ func TestPromptForConfirmation(t *testing.T) {
 			if !tt.isValid && err == nil {
 				t.Errorf("should have failed")
 			}
-			if tt.isAborted && !errors.Is(err, errAborted) {
+			if tt.isAborted && !errors.Is(err, ErrAborted) {
 				t.Errorf("should have returned aborted error, instead returned: %v", err)
 			}
-			if !tt.isAborted && errors.Is(err, errAborted) {
+			if !tt.isAborted && errors.Is(err, ErrAborted) {
 				t.Errorf("should not have returned aborted error")
 			}
 		})
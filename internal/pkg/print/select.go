@@ -0,0 +1,246 @@
+package print
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+const (
+	// Number of options shown at once by the selection prompt
+	selectionHeight = 10
+
+	keyCtrlC     = 3
+	keyBackspace = 127
+	keyCtrlH     = 8
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyEscape    = 27
+)
+
+// Returns True if the user can be prompted interactively, i.e. if stdin and stderr are terminals.
+func (p *Printer) IsInteractive() bool {
+	stdin, ok := p.Cmd.InOrStdin().(*os.File)
+	if !ok || !term.IsTerminal(int(stdin.Fd())) {
+		return false
+	}
+	stderr, ok := p.Cmd.ErrOrStderr().(*os.File)
+	return ok && term.IsTerminal(int(stderr.Fd()))
+}
+
+// Prompts the user to select one of the options. Typing filters the options with a fuzzy search,
+// the arrow keys move the selection and Enter confirms it.
+//
+// Returns the index of the selected option, or ErrAborted if the user pressed Esc or Ctrl+C.
+func (p *Printer) PromptForSelection(prompt string, options []string) (int, error) {
+	stdin, ok := p.Cmd.InOrStdin().(*os.File)
+	if !ok {
+		return -1, fmt.Errorf("stdin is not a terminal")
+	}
+	state, err := term.MakeRaw(int(stdin.Fd()))
+	if err != nil {
+		return -1, fmt.Errorf("set terminal to raw mode: %w", err)
+	}
+	defer term.Restore(int(stdin.Fd()), state) //nolint:errcheck // the terminal is left as it is if it can't be restored
+
+	return selectOption(stdin, p.Cmd.ErrOrStderr(), prompt, options)
+}
+
+// selectOption runs the selection prompt, reading the keys from the terminal in raw mode
+func selectOption(in io.Reader, out io.Writer, prompt string, options []string) (int, error) {
+	s := &selection{out: out, prompt: prompt, options: options}
+	s.matches = FuzzyFilter("", options)
+
+	buf := make([]byte, 256)
+	for {
+		s.render()
+		n, err := in.Read(buf)
+		if err != nil {
+			s.clear()
+			if errors.Is(err, io.EOF) {
+				return -1, ErrAborted
+			}
+			return -1, fmt.Errorf("read selection: %w", err)
+		}
+		for keys := buf[:n]; len(keys) > 0; {
+			var done bool
+			done, keys = s.handleKey(keys)
+			if !done {
+				continue
+			}
+			s.clear()
+			if s.selected < 0 {
+				return -1, ErrAborted
+			}
+			fmt.Fprintf(out, "%s %s\r\n", prompt, options[s.selected])
+			return s.selected, nil
+		}
+	}
+}
+
+type selection struct {
+	out     io.Writer
+	prompt  string
+	options []string
+	query   []rune
+	// Indexes of the options matching the query, best match first
+	matches []int
+	cursor  int
+	// Number of lines of the last rendering, which are cleared before rendering again
+	lines int
+	// Index of the selected option once the prompt is done, -1 if it was aborted
+	selected int
+}
+
+// handleKey handles the first key of the input, returning whether the prompt is done and the rest of the input
+func (s *selection) handleKey(keys []byte) (done bool, rest []byte) {
+	switch {
+	case keys[0] == '\r' || keys[0] == '\n':
+		if len(s.matches) == 0 {
+			return false, keys[1:]
+		}
+		s.selected = s.matches[s.cursor]
+		return true, nil
+	case keys[0] == keyCtrlC:
+		s.selected = -1
+		return true, nil
+	case keys[0] == keyEscape:
+		if len(keys) >= 3 && keys[1] == '[' {
+			switch keys[2] {
+			case 'A':
+				s.move(-1)
+			case 'B':
+				s.move(1)
+			}
+			return false, keys[3:]
+		}
+		s.selected = -1
+		return true, nil
+	case keys[0] == keyCtrlP:
+		s.move(-1)
+		return false, keys[1:]
+	case keys[0] == keyCtrlN || keys[0] == '\t':
+		s.move(1)
+		return false, keys[1:]
+	case keys[0] == keyBackspace || keys[0] == keyCtrlH:
+		if len(s.query) > 0 {
+			s.setQuery(s.query[:len(s.query)-1])
+		}
+		return false, keys[1:]
+	}
+
+	r, size := utf8.DecodeRune(keys)
+	if unicode.IsPrint(r) {
+		s.setQuery(append(s.query, r))
+	}
+	return false, keys[size:]
+}
+
+func (s *selection) move(offset int) {
+	if len(s.matches) == 0 {
+		return
+	}
+	s.cursor = (s.cursor + offset + len(s.matches)) % len(s.matches)
+}
+
+func (s *selection) setQuery(query []rune) {
+	s.query = query
+	s.matches = FuzzyFilter(string(query), s.options)
+	s.cursor = 0
+}
+
+// render draws the prompt and the matching options around the cursor, replacing the last rendering
+func (s *selection) render() {
+	s.clear()
+	lines := []string{fmt.Sprintf("%s %s", s.prompt, string(s.query))}
+	start := max(0, min(s.cursor-selectionHeight/2, len(s.matches)-selectionHeight))
+	end := min(len(s.matches), start+selectionHeight)
+	for i := start; i < end; i++ {
+		marker := "  "
+		if i == s.cursor {
+			marker = "> "
+		}
+		lines = append(lines, marker+s.options[s.matches[i]])
+	}
+	switch {
+	case len(s.matches) == 0:
+		lines = append(lines, "  (no matches)")
+	case len(s.matches) > end-start:
+		lines = append(lines, fmt.Sprintf("  (%d of %d, type to filter)", end-start, len(s.matches)))
+	}
+	fmt.Fprint(s.out, strings.Join(lines, "\r\n"))
+	// The cursor is left at the end of the query
+	if len(lines) > 1 {
+		fmt.Fprintf(s.out, "\x1b[%dA", len(lines)-1)
+	}
+	fmt.Fprintf(s.out, "\r\x1b[%dC", utf8.RuneCountInString(lines[0]))
+	s.lines = len(lines)
+}
+
+// clear removes the last rendering, leaving the cursor at its first line
+func (s *selection) clear() {
+	if s.lines > 0 {
+		fmt.Fprint(s.out, "\r\x1b[J")
+	}
+	s.lines = 0
+}
+
+// FuzzyFilter returns the indexes of the options that contain the characters of the query in order, ignoring case.
+// Options with consecutive matches and matches at the start of the option or of its words come first,
+// the order is kept otherwise.
+func FuzzyFilter(query string, options []string) []int {
+	type match struct {
+		index int
+		score int
+	}
+	matches := []match{}
+	for i, option := range options {
+		score, ok := fuzzyScore(strings.ToLower(query), strings.ToLower(option))
+		if ok {
+			matches = append(matches, match{index: i, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	indexes := make([]int, 0, len(matches))
+	for _, m := range matches {
+		indexes = append(indexes, m.index)
+	}
+	return indexes
+}
+
+func fuzzyScore(query, option string) (score int, ok bool) {
+	queryRunes := []rune(query)
+	if len(queryRunes) == 0 {
+		return 0, true
+	}
+	next := 0
+	previousMatched := false
+	var previous rune
+	for i, r := range option {
+		if next < len(queryRunes) && r == queryRunes[next] {
+			if previousMatched {
+				score += 2
+			}
+			if i == 0 {
+				score += 4
+			} else if !unicode.IsLetter(previous) && !unicode.IsDigit(previous) {
+				score += 3
+			}
+			next++
+			previousMatched = true
+		} else {
+			previousMatched = false
+		}
+		previous = r
+	}
+	return score, next == len(queryRunes)
+}
@@ -0,0 +1,132 @@
+package print
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFuzzyFilter(t *testing.T) {
+	options := []string{"prod-zone", "staging-zone", "zone-prod", "test"}
+	tests := []struct {
+		description string
+		query       string
+		expected    []int
+	}{
+		{
+			description: "empty query",
+			query:       "",
+			expected:    []int{0, 1, 2, 3},
+		},
+		{
+			description: "subsequence",
+			query:       "stz",
+			expected:    []int{1},
+		},
+		{
+			description: "start of words first",
+			query:       "zone",
+			expected:    []int{2, 0, 1},
+		},
+		{
+			description: "case insensitive",
+			query:       "PROD",
+			expected:    []int{0, 2},
+		},
+		{
+			description: "no match",
+			query:       "xyz",
+			expected:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			matches := FuzzyFilter(tt.query, options)
+			if diff := cmp.Diff(matches, tt.expected); diff != "" {
+				t.Errorf("unexpected matches (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectOption(t *testing.T) {
+	options := []string{"alpha", "beta", "gamma"}
+	tests := []struct {
+		description   string
+		input         string
+		expected      int
+		expectAborted bool
+	}{
+		{
+			description: "first option",
+			input:       "\r",
+			expected:    0,
+		},
+		{
+			description: "arrow keys",
+			input:       "\x1b[B\x1b[B\x1b[A\r",
+			expected:    1,
+		},
+		{
+			description: "wrap around",
+			input:       "\x1b[A\r",
+			expected:    2,
+		},
+		{
+			description: "filter",
+			input:       "gm\r",
+			expected:    2,
+		},
+		{
+			description: "backspace",
+			input:       "gx\x7f\r",
+			expected:    2,
+		},
+		{
+			description: "no match is not selected",
+			input:       "x\r\x7fb\r",
+			expected:    1,
+		},
+		{
+			description:   "escape",
+			input:         "\x1b",
+			expectAborted: true,
+		},
+		{
+			description:   "ctrl+c",
+			input:         "be\x03",
+			expectAborted: true,
+		},
+		{
+			description:   "end of input",
+			input:         "be",
+			expectAborted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			selected, err := selectOption(strings.NewReader(tt.input), out, "Select:", options)
+			if tt.expectAborted {
+				if !errors.Is(err, ErrAborted) {
+					t.Fatalf("expected aborted error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if selected != tt.expected {
+				t.Errorf("expected option %d to be selected, got %d", tt.expected, selected)
+			}
+			if !strings.HasSuffix(out.String(), "Select: "+options[tt.expected]+"\r\n") {
+				t.Errorf("expected selection to be printed, got %q", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package print
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ParseOutputFormat splits an output format of the form "<format>=<argument>" (e.g. "jsonpath={.id}")
+// into the format and its argument.
+// Output formats without argument are returned unchanged, with an empty argument.
+func ParseOutputFormat(outputFormat string) (format, argument string) {
+	format, argument, _ = strings.Cut(outputFormat, "=")
+	return format, argument
+}
+
+// Prints the output evaluated against the given JSONPath expression (same syntax as kubectl)
+func (p *Printer) outputJSONPath(output any, expression string) error {
+	expression, err := readTemplateArgument(expression)
+	if err != nil {
+		return err
+	}
+	data, err := ToJSONObject(output)
+	if err != nil {
+		return err
+	}
+
+	jp := jsonpath.New("output")
+	err = jp.Parse(relaxedJSONPathExpression(expression))
+	if err != nil {
+		return fmt.Errorf("parse jsonpath expression: %w", err)
+	}
+	var buf bytes.Buffer
+	err = jp.Execute(&buf, data)
+	if err != nil {
+		return fmt.Errorf("execute jsonpath expression: %w", err)
+	}
+	p.Outputf("%s", buf.String())
+	return nil
+}
+
+// Prints the output evaluated against the given Go template
+func (p *Printer) outputGoTemplate(output any, tmpl string) error {
+	tmpl, err := readTemplateArgument(tmpl)
+	if err != nil {
+		return err
+	}
+	data, err := ToJSONObject(output)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("parse go-template: %w", err)
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return fmt.Errorf("execute go-template: %w", err)
+	}
+	p.Outputf("%s", buf.String())
+	return nil
+}
+
+// If the argument starts with "@", it is assumed to be a file path and the content is read from the file instead
+func readTemplateArgument(argument string) (string, error) {
+	if !strings.HasPrefix(argument, "@") {
+		return argument, nil
+	}
+	path := strings.Trim(argument[1:], `"'`)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read template from file: %w", err)
+	}
+	return string(content), nil
+}
+
+// ToJSONObject converts the output into the generic object that its JSON representation decodes to,
+// so that JSONPath expressions, templates and filters use the same field names as the JSON output
+func ToJSONObject(output any) (any, error) {
+	details, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(details))
+	decoder.UseNumber()
+	var data any
+	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal json: %w", err)
+	}
+	return convertJSONNumbers(data), nil
+}
+
+// Converts json.Number values to int64 (or float64, if not an integer),
+// so that they can be compared in filters and are printed without exponent
+func convertJSONNumbers(data any) any {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = convertJSONNumbers(value)
+		}
+		return v
+	case []any:
+		for i, value := range v {
+			v[i] = convertJSONNumbers(value)
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// Allows to omit the braces and the leading dot of simple expressions, e.g. "id" instead of "{.id}"
+func relaxedJSONPathExpression(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "{") {
+		return expression
+	}
+	if !strings.HasPrefix(expression, ".") && !strings.HasPrefix(expression, "[") {
+		expression = "." + expression
+	}
+	return fmt.Sprintf("{%s}", expression)
+}
+
+// IsTemplateOutputFormat returns whether the output format evaluates an expression or template against the output,
+// i.e. "jsonpath=<expression>" or "go-template=<template>". Commands printing their JSON and YAML output themselves
+// pass the output to OutputResult for these formats.
+func IsTemplateOutputFormat(outputFormat string) bool {
+	format, _ := ParseOutputFormat(outputFormat)
+	return format == JSONPathOutputFormat || format == GoTemplateOutputFormat
+}
@@ -0,0 +1,208 @@
+package print
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type testOutput struct {
+	Id     *string           `json:"id,omitempty"`
+	Name   *string           `json:"name,omitempty"`
+	Size   *int64            `json:"size,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func newTestOutput(id, name string, size int64) testOutput {
+	return testOutput{
+		Id:     &id,
+		Name:   &name,
+		Size:   &size,
+		Labels: map[string]string{"env": "prod"},
+	}
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		description      string
+		outputFormat     string
+		expectedFormat   string
+		expectedArgument string
+	}{
+		{
+			description:    "format without argument",
+			outputFormat:   JSONOutputFormat,
+			expectedFormat: JSONOutputFormat,
+		},
+		{
+			description:      "format with argument",
+			outputFormat:     "jsonpath={.id}",
+			expectedFormat:   JSONPathOutputFormat,
+			expectedArgument: "{.id}",
+		},
+		{
+			description:      "argument contains =",
+			outputFormat:     `go-template={{if eq .name "a=b"}}x{{end}}`,
+			expectedFormat:   GoTemplateOutputFormat,
+			expectedArgument: `{{if eq .name "a=b"}}x{{end}}`,
+		},
+		{
+			description: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			format, argument := ParseOutputFormat(tt.outputFormat)
+			if format != tt.expectedFormat {
+				t.Errorf("expected format %q, got %q", tt.expectedFormat, format)
+			}
+			if argument != tt.expectedArgument {
+				t.Errorf("expected argument %q, got %q", tt.expectedArgument, argument)
+			}
+		})
+	}
+}
+
+func TestIsTemplateOutputFormat(t *testing.T) {
+	tests := []struct {
+		outputFormat string
+		expected     bool
+	}{
+		{outputFormat: "jsonpath={.id}", expected: true},
+		{outputFormat: "go-template={{.id}}", expected: true},
+		{outputFormat: JSONOutputFormat, expected: false},
+		{outputFormat: PrettyOutputFormat, expected: false},
+		{outputFormat: "", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.outputFormat, func(t *testing.T) {
+			if IsTemplateOutputFormat(tt.outputFormat) != tt.expected {
+				t.Errorf("expected %t", tt.expected)
+			}
+		})
+	}
+}
+
+func TestOutputResultTemplates(t *testing.T) {
+	templateFile := filepath.Join(t.TempDir(), "template.txt")
+	err := os.WriteFile(templateFile, []byte(`{{range .}}{{.name}}{{"\n"}}{{end}}`), 0o600)
+	if err != nil {
+		t.Fatalf("failed to write template file: %v", err)
+	}
+
+	tests := []struct {
+		description    string
+		outputFormat   string
+		output         any
+		expectedOutput string
+		isValid        bool
+	}{
+		{
+			description:    "jsonpath",
+			outputFormat:   "jsonpath={.id}",
+			output:         newTestOutput("id-1", "name-1", 10),
+			expectedOutput: "id-1",
+			isValid:        true,
+		},
+		{
+			description:    "jsonpath - relaxed expression",
+			outputFormat:   "jsonpath=labels.env",
+			output:         newTestOutput("id-1", "name-1", 10),
+			expectedOutput: "prod",
+			isValid:        true,
+		},
+		{
+			description:    "jsonpath - list",
+			outputFormat:   "jsonpath={[*].id}",
+			output:         []testOutput{newTestOutput("id-1", "name-1", 10), newTestOutput("id-2", "name-2", 20)},
+			expectedOutput: "id-1 id-2",
+			isValid:        true,
+		},
+		{
+			description:    "jsonpath - filter on number",
+			outputFormat:   "jsonpath={[?(@.size>15)].name}",
+			output:         []testOutput{newTestOutput("id-1", "name-1", 10), newTestOutput("id-2", "name-2", 20)},
+			expectedOutput: "name-2",
+			isValid:        true,
+		},
+		{
+			description:    "jsonpath - large numbers are not printed with exponent",
+			outputFormat:   "jsonpath={.size}",
+			output:         newTestOutput("id-1", "name-1", 10000000000),
+			expectedOutput: "10000000000",
+			isValid:        true,
+		},
+		{
+			description:  "jsonpath - invalid expression",
+			outputFormat: "jsonpath={.id",
+			output:       newTestOutput("id-1", "name-1", 10),
+			isValid:      false,
+		},
+		{
+			description:  "jsonpath - missing key",
+			outputFormat: "jsonpath={.unknown}",
+			output:       newTestOutput("id-1", "name-1", 10),
+			isValid:      false,
+		},
+		{
+			description:    "go-template",
+			outputFormat:   `go-template={{.id}}:{{.labels.env}}`,
+			output:         newTestOutput("id-1", "name-1", 10),
+			expectedOutput: "id-1:prod",
+			isValid:        true,
+		},
+		{
+			description:    "go-template - from file",
+			outputFormat:   "go-template=@" + templateFile,
+			output:         []testOutput{newTestOutput("id-1", "name-1", 10), newTestOutput("id-2", "name-2", 20)},
+			expectedOutput: "name-1\nname-2\n",
+			isValid:        true,
+		},
+		{
+			description:  "go-template - file does not exist",
+			outputFormat: "go-template=@" + filepath.Join(t.TempDir(), "missing.txt"),
+			output:       newTestOutput("id-1", "name-1", 10),
+			isValid:      false,
+		},
+		{
+			description:  "go-template - invalid template",
+			outputFormat: "go-template={{.id",
+			output:       newTestOutput("id-1", "name-1", 10),
+			isValid:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			viper.Reset()
+			var buf bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&buf)
+			p := &Printer{
+				Cmd:       cmd,
+				Verbosity: ErrorLevel,
+			}
+
+			err := p.OutputResult(tt.outputFormat, tt.output, func() error {
+				t.Fatalf("pretty output func should not be called")
+				return nil
+			})
+
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if !tt.isValid {
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if buf.String() != tt.expectedOutput {
+				t.Errorf("expected output %q, got %q", tt.expectedOutput, buf.String())
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/resourcemanager/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/resourcemanager/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/session"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -30,19 +31,26 @@ func GetProjectName(ctx context.Context, p *print.Printer, cliVersion string, cm
 		return "", fmt.Errorf("found empty project ID and name")
 	}
 
-	apiClient, err := client.ConfigureClient(p, cliVersion)
-	if err != nil {
-		return "", fmt.Errorf("configure resource manager client: %w", err)
-	}
+	// In the interactive shell, the name is looked up once per project
+	projectName, err := session.Remember(fmt.Sprintf("project-name/%s", projectId), func() (string, error) {
+		apiClient, err := client.ConfigureClient(p, cliVersion)
+		if err != nil {
+			return "", fmt.Errorf("configure resource manager client: %w", err)
+		}
 
-	projectName, err := utils.GetProjectName(ctx, apiClient, projectId)
+		projectName, err := utils.GetProjectName(ctx, apiClient, projectId)
+		if err != nil {
+			return "", fmt.Errorf("get project name: %w", err)
+		}
+		return projectName, nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("get project name: %w", err)
+		return "", err
 	}
 
 	// If project ID is set in config, we store the project name in config
 	// (So next time we can just pull it from there)
-	if !(isProjectIdSetInFlags(p, cmd) || isProjectIdSetInEnvVar()) {
+	if !(isProjectIdSetInFlags(p, cmd) || isProjectIdSetInEnvVar() || config.IsSetInLocalConfig(config.ProjectIdKey)) {
 		viper.Set(config.ProjectNameKey, projectName)
 		err = config.Write()
 		if err != nil {
@@ -60,12 +68,14 @@ func useProjectNameFromConfig(p *print.Printer, cmd *cobra.Command) bool {
 	// - Project name in the config file is not empty
 	projectIdSetInFlags := isProjectIdSetInFlags(p, cmd)
 	projectIdSetInEnv := isProjectIdSetInEnvVar()
+	// The project name in the config file belongs to the project ID of the profile, not to the one of the local config file
+	projectIdSetInLocalConfig := config.IsSetInLocalConfig(config.ProjectIdKey)
 	projectName := viper.GetString(config.ProjectNameKey)
 	projectNameSet := false
 	if projectName != "" {
 		projectNameSet = true
 	}
-	return !projectIdSetInFlags && !projectIdSetInEnv && projectNameSet
+	return !projectIdSetInFlags && !projectIdSetInEnv && !projectIdSetInLocalConfig && projectNameSet
 }
 
 func isProjectIdSetInFlags(p *print.Printer, cmd *cobra.Command) bool {
@@ -0,0 +1,128 @@
+package redact
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces the values of credentials and secrets
+const Redacted = "REDACTED"
+
+// Headers that carry credentials
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// Parts of the names of query parameters and body fields whose values are redacted,
+// compared in lower case and without "_" and "-"
+var sensitiveNames = []string{
+	"token",
+	"password",
+	"secret",
+	"privatekey",
+	"assertion",
+}
+
+// Header returns a copy of the header with the values of the headers carrying credentials redacted
+func Header(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	redacted := header.Clone()
+	for name := range redacted {
+		if IsSensitiveHeader(name) {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// IsSensitiveHeader returns whether the header carries credentials
+func IsSensitiveHeader(name string) bool {
+	for _, sensitive := range sensitiveHeaders {
+		if http.CanonicalHeaderKey(name) == sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// URL returns the URL without user info and with the values of sensitive query parameters redacted
+func URL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	if redacted.RawQuery != "" {
+		redacted.RawQuery = Values(redacted.Query()).Encode()
+	}
+	return redacted.String()
+}
+
+// Values redacts the sensitive values of query parameters or form fields, in place
+func Values(values url.Values) url.Values {
+	for name := range values {
+		if IsSensitive(name) {
+			values.Set(name, Redacted)
+		}
+	}
+	return values
+}
+
+// Body redacts the sensitive fields of JSON and form bodies. Other bodies are returned as they are
+func Body(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			return Values(values).Encode()
+		}
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") || json.Valid(body):
+		var content any
+		err := json.Unmarshal(body, &content)
+		if err == nil {
+			redacted, err := json.Marshal(redactJSON(content))
+			if err == nil {
+				return string(redacted)
+			}
+		}
+	}
+	return string(body)
+}
+
+func redactJSON(content any) any {
+	switch v := content.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if IsSensitive(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = redactJSON(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return content
+}
+
+// IsSensitive returns whether the query parameter or body field with the given name holds a credential or secret
+func IsSensitive(name string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+	return false
+}
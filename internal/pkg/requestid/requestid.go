@@ -0,0 +1,58 @@
+package requestid
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Header is set by the STACKIT APIs on their responses, to identify the request when contacting support
+const Header = "X-Request-Id"
+
+var (
+	mu sync.Mutex
+	// Status code and request ID of the last failed API request of the command run
+	lastFailedStatus int
+	lastFailed       string
+)
+
+// Middleware is an SDK middleware that keeps the request ID of the last failed API request, to report it with the error
+func Middleware(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := rt.RoundTrip(req)
+		if err != nil || resp.StatusCode < http.StatusBadRequest {
+			return resp, err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		// A response without a request ID replaces the one of an earlier failure too, so that it isn't reported for this one
+		lastFailedStatus = resp.StatusCode
+		lastFailed = resp.Header.Get(Header)
+		return resp, nil
+	})
+}
+
+// Reset forgets the last failed API request. It is called at the start of each command run,
+// so that the request ID of a previous command run in the shell isn't reported.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	lastFailedStatus = 0
+	lastFailed = ""
+}
+
+// LastFailed returns the request ID of the last failed API request, if it failed with the given status code.
+// Otherwise, the error being reported is not the one of that request, e.g. if it was retried or handled, and an empty string is returned.
+func LastFailed(status int) string {
+	mu.Lock()
+	defer mu.Unlock()
+	if status != lastFailedStatus {
+		return ""
+	}
+	return lastFailed
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
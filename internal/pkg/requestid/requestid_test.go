@@ -0,0 +1,87 @@
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLastFailed(t *testing.T) {
+	tests := []struct {
+		description       string
+		responses         []*http.Response
+		reset             bool
+		status            int
+		expectedRequestId string
+	}{
+		{
+			description: "failed request",
+			responses: []*http.Response{
+				{StatusCode: http.StatusNotFound, Header: http.Header{Header: []string{"id-1"}}},
+			},
+			status:            http.StatusNotFound,
+			expectedRequestId: "id-1",
+		},
+		{
+			description: "successful request after failed one",
+			responses: []*http.Response{
+				{StatusCode: http.StatusServiceUnavailable, Header: http.Header{Header: []string{"id-1"}}},
+				{StatusCode: http.StatusOK, Header: http.Header{Header: []string{"id-2"}}},
+			},
+			status:            http.StatusServiceUnavailable,
+			expectedRequestId: "id-1",
+		},
+		{
+			description: "other status code",
+			responses: []*http.Response{
+				{StatusCode: http.StatusNotFound, Header: http.Header{Header: []string{"id-1"}}},
+			},
+			status:            http.StatusConflict,
+			expectedRequestId: "",
+		},
+		{
+			description: "failed request without request ID",
+			responses: []*http.Response{
+				{StatusCode: http.StatusNotFound, Header: http.Header{Header: []string{"id-1"}}},
+				{StatusCode: http.StatusNotFound, Header: http.Header{}},
+			},
+			status:            http.StatusNotFound,
+			expectedRequestId: "",
+		},
+		{
+			description: "reset",
+			responses: []*http.Response{
+				{StatusCode: http.StatusNotFound, Header: http.Header{Header: []string{"id-1"}}},
+			},
+			reset:             true,
+			status:            http.StatusNotFound,
+			expectedRequestId: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			Reset()
+			responses := tt.responses
+			rt := Middleware(roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+				resp := responses[0]
+				responses = responses[1:]
+				return resp, nil
+			}))
+			for range tt.responses {
+				_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if tt.reset {
+				Reset()
+			}
+
+			requestId := LastFailed(tt.status)
+			if requestId != tt.expectedRequestId {
+				t.Errorf("expected request ID %q, got %q", tt.expectedRequestId, requestId)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Prefix of a reference to a resource by its name, e.g. "name:web-01"
+	namePrefix = "name:"
+
+	namesCacheIdentifier = "resource-names"
+	// Resolved names are cached for a short time only, as resources can be renamed or recreated
+	namesCacheTTL = 5 * time.Minute
+)
+
+// reference is a flag value or argument that may reference a resource by its name instead of its ID
+type reference struct {
+	pflag.Value
+	in *input
+	// Name of the referenced resource, empty if the value is an ID or the name was already resolved
+	name string
+}
+
+// Set keeps the value as the name of the resource if it isn't a valid ID
+func (r *reference) Set(value string) error {
+	name, ok := strings.CutPrefix(value, namePrefix)
+	if !ok {
+		err := r.Value.Set(value)
+		if err == nil {
+			r.name = ""
+			return nil
+		}
+	}
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	r.name = name
+	return nil
+}
+
+func (r *reference) String() string {
+	if r.name != "" {
+		return namePrefix + r.name
+	}
+	return r.Value.String()
+}
+
+// resolve sets the flag to the ID of the referenced resource, if it references a resource by its name
+func (r *reference) resolve(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command) error {
+	if r.name == "" {
+		return nil
+	}
+	id, err := resolveName(ctx, p, cliVersion, cmd, r.in, r.name)
+	if err != nil {
+		return err
+	}
+	r.name = ""
+	err = r.Value.Set(id)
+	if err != nil {
+		return &cliErr.FlagValidationError{
+			Flag:    r.in.name,
+			Details: err.Error(),
+		}
+	}
+	return nil
+}
+
+// argReference returns the reference of the argument if it failed the validation of the command
+// but can be the name of a known input, or nil otherwise
+func argReference(path, value string, validationErr error) *reference {
+	var argValidationErr *cliErr.ArgValidationError
+	if !errors.As(validationErr, &argValidationErr) {
+		return nil
+	}
+	in := find(path, argValidationErr.Arg)
+	if in == nil || in.byName {
+		return nil
+	}
+	name := strings.TrimPrefix(value, namePrefix)
+	if name == "" {
+		return nil
+	}
+	return &reference{in: in, name: name}
+}
+
+// resolveName returns the ID of the only resource of the input with the name
+func resolveName(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command, in *input, name string) (string, error) {
+	err := cache.Init()
+	if err != nil {
+		p.Debug(print.ErrorLevel, "initialize cache for resolved names: %v", err)
+	}
+	key := namesCacheKey(p, cmd, in, name)
+	// Commands that change resources don't use the cache, as the name may refer to another resource by now
+	if !journal.IsEnabled(cmd) {
+		if id, ok := getCachedName(key); ok {
+			p.Debug(print.DebugLevel, "resolved %s name %q to ID %q from the cache", in.resource, name, id)
+			return id, nil
+		}
+	}
+
+	candidates, err := in.list(ctx, p, cliVersion, cmd)
+	if err != nil {
+		return "", fmt.Errorf("list %ss to resolve the name %q: %w", in.resource, name, err)
+	}
+	ids := []string{}
+	for _, c := range candidates {
+		if c.Name == name {
+			ids = append(ids, c.Value)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", &cliErr.ResourceNameNotFoundError{Resource: in.resource, Name: name}
+	case 1:
+		p.Debug(print.DebugLevel, "resolved %s name %q to ID %q", in.resource, name, ids[0])
+		err = putCachedName(key, ids[0])
+		if err != nil {
+			p.Debug(print.ErrorLevel, "cache resolved %s name: %v", in.resource, err)
+		}
+		return ids[0], nil
+	default:
+		return "", &cliErr.ResourceNameAmbiguousError{Resource: in.resource, Name: name, Ids: ids}
+	}
+}
+
+// namesCacheKey identifies the resource by its name within the project, region and parent resource
+func namesCacheKey(p *print.Printer, cmd *cobra.Command, in *input, name string) string {
+	parent := ""
+	if in.parent != "" {
+		parent = flags.FlagToStringValue(p, cmd, in.parent)
+	}
+	return strings.Join([]string{
+		in.resource,
+		viper.GetString(config.ProjectIdKey),
+		viper.GetString(config.RegionKey),
+		parent,
+		name,
+	}, "/")
+}
+
+type cachedName struct {
+	Id      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+}
+
+func readNamesCache() map[string]cachedName {
+	names := map[string]cachedName{}
+	content, err := cache.GetObject(namesCacheIdentifier)
+	if err != nil {
+		return names
+	}
+	// A corrupted cache is ignored and overwritten
+	_ = json.Unmarshal(content, &names)
+	return names
+}
+
+func getCachedName(key string) (string, bool) {
+	name, ok := readNamesCache()[key]
+	if !ok || time.Now().After(name.Expires) {
+		return "", false
+	}
+	return name.Id, true
+}
+
+func putCachedName(key, id string) error {
+	names := readNamesCache()
+	now := time.Now()
+	for k, name := range names {
+		if now.After(name.Expires) {
+			delete(names, k)
+		}
+	}
+	names[key] = cachedName{Id: id, Expires: now.Add(namesCacheTTL)}
+	content, err := json.Marshal(names)
+	if err != nil {
+		return fmt.Errorf("marshal names: %w", err)
+	}
+	return cache.PutObject(namesCacheIdentifier, content)
+}
@@ -0,0 +1,310 @@
+package resolve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// The argument of create commands names the resource to be created, so it is not selected
+const createCommand = "create"
+
+// Candidate is a value that can be selected for a missing flag or argument
+type Candidate struct {
+	// Value set as the flag value or argument, e.g. the ID of the resource
+	Value string
+	// Name of the resource, shown in the selection prompt and used to resolve references by name
+	Name string
+	// Description shown in the selection prompt after the name, e.g. the type of the resource
+	Description string
+}
+
+// Lister lists the candidates for an input of the command. It returns no candidates if they can't be listed
+// without other inputs that are missing, such as the project ID.
+type Lister func(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command) ([]Candidate, error)
+
+// input is a flag or argument whose candidates can be listed
+type input struct {
+	// Path of the commands the input belongs to, without the root command, e.g. "dns"
+	scope string
+	// Name of the flag, or name of the argument in upper case as in the command usage, e.g. "ZONE_ID"
+	name string
+	// Type of the resource, shown in the selection prompt
+	resource string
+	// Flag the candidates depend on, e.g. the zone of DNS record sets
+	parent string
+	// Whether the value is the name of the resource, so that it isn't resolved as a reference
+	byName bool
+	list   Lister
+}
+
+func (in *input) isArg() bool {
+	return strings.ToUpper(in.name) == in.name
+}
+
+// Enable lets the user select the missing required flags and the missing argument of the command, if they are known inputs.
+// The candidates are listed when the command is run in a terminal without the --assume-yes flag,
+// otherwise the command fails as usual.
+//
+// Known inputs also accept the name of the resource instead of its ID, e.g. "name:web-01" or just "web-01".
+// The name is resolved to the ID before the command is run.
+//
+// The argument validation, pre-run and run functions of the command are wrapped, so they are still run.
+func Enable(p *print.Printer, cliVersion string, cmd *cobra.Command) {
+	runE := cmd.RunE
+	if runE == nil {
+		return
+	}
+	path := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+
+	flagInputs := []*input{}
+	flagRefs := []*reference{}
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		in := find(path, f.Name)
+		if in == nil {
+			return
+		}
+		if !in.byName {
+			ref := &reference{Value: f.Value, in: in}
+			f.Value = ref
+			flagRefs = append(flagRefs, ref)
+		}
+		if isRequired(f) {
+			flagInputs = append(flagInputs, in)
+		}
+	})
+	validateArgs := cmd.Args
+	if cmd.Name() == createCommand || !hasArgInputs(path) {
+		validateArgs = nil
+	}
+	if len(flagRefs) == 0 && len(flagInputs) == 0 && validateArgs == nil {
+		return
+	}
+
+	var missingArg *input
+	var argErr error
+	var argRef *reference
+	var resolvedArgs []string
+
+	if validateArgs != nil {
+		// The arguments are validated before the persistent pre-run, which sets up the authentication,
+		// so the missing argument and the argument referenced by name are only noted here and resolved
+		// in the pre-run of the command
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			err := validateArgs(cmd, args)
+			if err == nil {
+				return nil
+			}
+			if len(args) == 1 {
+				ref := argReference(path, args[0], err)
+				if ref == nil {
+					return err
+				}
+				argRef = ref
+				return nil
+			}
+			if len(args) != 0 {
+				return err
+			}
+			name, ok := missingArgName(err)
+			if !ok || !isInteractive(p, cmd) {
+				return err
+			}
+			in := find(path, name)
+			if in == nil {
+				return err
+			}
+			missingArg = in
+			argErr = err
+			return nil
+		}
+	}
+
+	resolveInputs := func(cmd *cobra.Command) error {
+		// Flags are resolved and selected first, as listing the candidates of the argument may depend on them
+		for _, ref := range flagRefs {
+			err := ref.resolve(cmd.Context(), p, cliVersion, cmd)
+			if err != nil {
+				return err
+			}
+		}
+		if isInteractive(p, cmd) {
+			for _, in := range flagInputs {
+				if cmd.Flags().Changed(in.name) {
+					continue
+				}
+				value, err := selectValue(cmd.Context(), p, cliVersion, cmd, in)
+				if err != nil {
+					return err
+				}
+				if value == "" {
+					// The command fails as the flag is required
+					continue
+				}
+				err = cmd.Flags().Set(in.name, value)
+				if err != nil {
+					return &cliErr.FlagValidationError{
+						Flag:    in.name,
+						Details: err.Error(),
+					}
+				}
+			}
+		}
+
+		// Missing flags are reported before resolving the argument, which may depend on them
+		err := cmd.ValidateRequiredFlags()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case argRef != nil:
+			id, err := resolveName(cmd.Context(), p, cliVersion, cmd, argRef.in, argRef.name)
+			if err != nil {
+				return err
+			}
+			resolvedArgs = []string{id}
+		case missingArg != nil:
+			value, err := selectValue(cmd.Context(), p, cliVersion, cmd, missingArg)
+			if err != nil {
+				return err
+			}
+			if value == "" {
+				return argErr
+			}
+			resolvedArgs = []string{value}
+		default:
+			return nil
+		}
+		return validateArgs(cmd, resolvedArgs)
+	}
+
+	// The pre-run of the command is kept and run with the resolved inputs
+	preRunE := cmd.PreRunE
+	preRun := cmd.PreRun
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		err := resolveInputs(cmd)
+		if err != nil {
+			return err
+		}
+		if resolvedArgs != nil {
+			args = resolvedArgs
+		}
+		switch {
+		case preRunE != nil:
+			return preRunE(cmd, args)
+		case preRun != nil:
+			preRun(cmd, args)
+		}
+		return nil
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if resolvedArgs != nil {
+			args = resolvedArgs
+		}
+		return runE(cmd, args)
+	}
+}
+
+// isInteractive returns whether the user can be prompted to select the missing inputs
+func isInteractive(p *print.Printer, cmd *cobra.Command) bool {
+	if flags.FlagToBoolValue(p, cmd, globalflags.AssumeYesFlag) {
+		return false
+	}
+	return p.IsInteractive()
+}
+
+func isRequired(f *pflag.Flag) bool {
+	required := f.Annotations[cobra.BashCompOneRequiredFlag]
+	return len(required) == 1 && required[0] == "true"
+}
+
+// missingArgName returns the name of the argument the error is about, if the argument is missing
+func missingArgName(err error) (string, bool) {
+	var singleArgErr *cliErr.SingleArgExpectedError
+	if errors.As(err, &singleArgErr) && singleArgErr.Count == 0 {
+		return singleArgErr.Expected, true
+	}
+	var argMissingErr *cliErr.ArgMissingError
+	if errors.As(err, &argMissingErr) {
+		return argMissingErr.Expected, true
+	}
+	return "", false
+}
+
+// find returns the input with the name in the most specific scope that contains the command path, or nil if there is none
+func find(path, name string) *input {
+	var found *input
+	for i := range inputs {
+		in := &inputs[i]
+		if in.name != name || !inScope(path, in.scope) {
+			continue
+		}
+		if found == nil || len(in.scope) > len(found.scope) {
+			found = in
+		}
+	}
+	return found
+}
+
+func hasArgInputs(path string) bool {
+	for i := range inputs {
+		if inputs[i].isArg() && inScope(path, inputs[i].scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func inScope(path, scope string) bool {
+	return path == scope || strings.HasPrefix(path, scope+" ")
+}
+
+// selectValue lists the candidates of the input and prompts the user to select one.
+// It returns an empty value if there are no candidates.
+func selectValue(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command, in *input) (string, error) {
+	candidates, err := in.list(ctx, p, cliVersion, cmd)
+	if err != nil {
+		return "", fmt.Errorf("list %ss to select from: %w", in.resource, err)
+	}
+	if len(candidates) == 0 {
+		p.Debug(print.DebugLevel, "no %ss to select for %s", in.resource, in.name)
+		return "", nil
+	}
+
+	options := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		options = append(options, c.label())
+	}
+	prompt := fmt.Sprintf("Select a %s for %s:", in.resource, displayName(in))
+	selected, err := p.PromptForSelection(prompt, options)
+	if err != nil {
+		return "", err
+	}
+	return candidates[selected].Value, nil
+}
+
+func (c Candidate) label() string {
+	label := strings.TrimSpace(fmt.Sprintf("%s %s", c.Name, c.Description))
+	if label == "" || label == c.Value {
+		return c.Value
+	}
+	return fmt.Sprintf("%s (%s)", label, c.Value)
+}
+
+func displayName(in *input) string {
+	if in.isArg() {
+		return in.name
+	}
+	return "--" + in.name
+}
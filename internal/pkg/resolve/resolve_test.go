@@ -0,0 +1,402 @@
+package resolve
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const testId = "00000000-0000-0000-0000-000000000000"
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		description   string
+		path          string
+		name          string
+		expectedScope string
+	}{
+		{
+			description:   "flag in service",
+			path:          "dns record-set list",
+			name:          "zone-id",
+			expectedScope: "dns",
+		},
+		{
+			description:   "argument in resource",
+			path:          "dns record-set describe",
+			name:          "RECORD_SET_ID",
+			expectedScope: "dns record-set",
+		},
+		{
+			description: "argument of other resource",
+			path:        "dns record-set describe",
+			name:        "ZONE_ID",
+		},
+		{
+			description:   "flag in other service",
+			path:          "mariadb credentials list",
+			name:          "instance-id",
+			expectedScope: "mariadb",
+		},
+		{
+			description:   "flag of create command",
+			path:          "server create",
+			name:          "image-id",
+			expectedScope: "server",
+		},
+		{
+			description:   "argument in service",
+			path:          "observability grafana describe",
+			name:          "INSTANCE_ID",
+			expectedScope: "observability",
+		},
+		{
+			description:   "beta service",
+			path:          "beta sqlserverflex database list",
+			name:          "instance-id",
+			expectedScope: "beta sqlserverflex",
+		},
+		{
+			description: "instance of other service",
+			path:        "load-balancer observability-credentials list",
+			name:        "instance-id",
+		},
+		{
+			description: "scope is a prefix of a word",
+			path:        "servers list",
+			name:        "server-id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			in := find(tt.path, tt.name)
+			if tt.expectedScope == "" {
+				if in != nil {
+					t.Fatalf("expected no input, got %+v", in)
+				}
+				return
+			}
+			if in == nil {
+				t.Fatalf("expected input in scope %q, got none", tt.expectedScope)
+			}
+			if in.scope != tt.expectedScope {
+				t.Errorf("expected scope %q, got %q", tt.expectedScope, in.scope)
+			}
+		})
+	}
+}
+
+func TestMissingArgName(t *testing.T) {
+	tests := []struct {
+		description  string
+		err          error
+		expectedName string
+		expectedOk   bool
+	}{
+		{
+			description:  "single argument missing",
+			err:          &cliErr.SingleArgExpectedError{Expected: "ZONE_ID", Count: 0},
+			expectedName: "ZONE_ID",
+			expectedOk:   true,
+		},
+		{
+			description: "too many arguments",
+			err:         &cliErr.SingleArgExpectedError{Expected: "ZONE_ID", Count: 2},
+		},
+		{
+			description:  "minimum arguments",
+			err:          &cliErr.ArgMissingError{Expected: "CLUSTER_NAME"},
+			expectedName: "CLUSTER_NAME",
+			expectedOk:   true,
+		},
+		{
+			description: "invalid argument",
+			err:         &cliErr.ArgValidationError{Arg: "ZONE_ID"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			name, ok := missingArgName(tt.err)
+			if ok != tt.expectedOk || name != tt.expectedName {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tt.expectedName, tt.expectedOk, name, ok)
+			}
+		})
+	}
+}
+
+func fixtureCmds() (root, cmd *cobra.Command) {
+	root = &cobra.Command{Use: "stackit"}
+	root.PersistentFlags().Bool(globalflags.AssumeYesFlag, false, "")
+	dns := &cobra.Command{Use: "dns"}
+	recordSet := &cobra.Command{Use: "record-set"}
+	cmd = &cobra.Command{
+		Use:  "describe RECORD_SET_ID",
+		Args: args.SingleArg("RECORD_SET_ID", utils.ValidateUUID),
+		RunE: func(*cobra.Command, []string) error { return nil },
+	}
+	cmd.Flags().Var(flags.UUIDFlag(), "zone-id", "")
+	_ = flags.MarkFlagsRequired(cmd, "zone-id")
+	recordSet.AddCommand(cmd)
+	dns.AddCommand(recordSet)
+	root.AddCommand(dns)
+	return root, cmd
+}
+
+func TestEnable(t *testing.T) {
+	p := print.NewPrinter()
+	root, cmd := fixtureCmds()
+	p.Cmd = root
+	Enable(p, "", cmd)
+
+	if cmd.PreRunE == nil {
+		t.Fatalf("expected pre-run to be set")
+	}
+
+	// Outside of a terminal, missing inputs are reported as usual
+	root.SetArgs([]string{"dns", "record-set", "describe"})
+	err := root.Execute()
+	var singleArgErr *cliErr.SingleArgExpectedError
+	if !errors.As(err, &singleArgErr) {
+		t.Fatalf("expected missing argument error, got %v", err)
+	}
+
+	root.SetArgs([]string{"dns", "record-set", "describe", "xxx"})
+	err = root.Execute()
+	if err == nil || err.Error() != `required flag(s) "zone-id" not set` {
+		t.Fatalf("expected missing flag error, got %v", err)
+	}
+
+	// Names are resolved instead of failing the validation of the argument
+	root.SetArgs([]string{"dns", "record-set", "describe", "name:www", "--zone-id", testId})
+	err = root.Execute()
+	var nameNotFoundErr *cliErr.ResourceNameNotFoundError
+	if !errors.As(err, &nameNotFoundErr) {
+		t.Fatalf("expected name not found error, got %v", err)
+	}
+}
+
+func TestEnableKeepsPreRun(t *testing.T) {
+	p := print.NewPrinter()
+	root, cmd := fixtureCmds()
+	p.Cmd = root
+	preRunErr := errors.New("pre-run failed")
+	var preRunArgs []string
+	cmd.PreRunE = func(_ *cobra.Command, args []string) error {
+		preRunArgs = args
+		return preRunErr
+	}
+	Enable(p, "", cmd)
+
+	root.SetArgs([]string{"dns", "record-set", "describe", testId, "--zone-id", testId})
+	err := root.Execute()
+	if !errors.Is(err, preRunErr) {
+		t.Fatalf("expected error of the pre-run of the command, got %v", err)
+	}
+	if len(preRunArgs) != 1 || preRunArgs[0] != testId {
+		t.Errorf("expected pre-run of the command to be run with the arguments, got %v", preRunArgs)
+	}
+}
+
+func TestEnableWithoutInputs(t *testing.T) {
+	p := print.NewPrinter()
+	cmd := &cobra.Command{
+		Use:  "create RECORD_SET_ID",
+		Args: args.SingleArg("RECORD_SET_ID", nil),
+		RunE: func(*cobra.Command, []string) error { return nil },
+	}
+	recordSet := &cobra.Command{Use: "record-set"}
+	recordSet.AddCommand(cmd)
+	dns := &cobra.Command{Use: "dns"}
+	dns.AddCommand(recordSet)
+	root := &cobra.Command{Use: "stackit"}
+	root.AddCommand(dns)
+	Enable(p, "", cmd)
+
+	// The argument of a create command names the new resource
+	if cmd.PreRunE != nil {
+		t.Errorf("expected command without inputs to be left as it is")
+	}
+}
+
+func TestReferenceSet(t *testing.T) {
+	tests := []struct {
+		description   string
+		value         string
+		isValid       bool
+		expectedName  string
+		expectedValue string
+	}{
+		{
+			description:   "id",
+			value:         testId,
+			isValid:       true,
+			expectedValue: testId,
+		},
+		{
+			description:   "explicit name",
+			value:         "name:web-01",
+			isValid:       true,
+			expectedName:  "web-01",
+			expectedValue: "name:web-01",
+		},
+		{
+			description:   "plain name",
+			value:         "web-01",
+			isValid:       true,
+			expectedName:  "web-01",
+			expectedValue: "name:web-01",
+		},
+		{
+			description: "empty name",
+			value:       "name:",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ref := &reference{Value: flags.UUIDFlag(), in: &input{name: "server-id"}}
+			err := ref.Set(tt.value)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid value")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ref.name != tt.expectedName {
+				t.Errorf("expected name %q, got %q", tt.expectedName, ref.name)
+			}
+			if ref.String() != tt.expectedValue {
+				t.Errorf("expected value %q, got %q", tt.expectedValue, ref.String())
+			}
+		})
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	tests := []struct {
+		description string
+		name        string
+		expectedId  string
+		expectedErr any
+	}{
+		{
+			description: "unique name",
+			name:        "web-01",
+			expectedId:  testId,
+		},
+		{
+			description: "unknown name",
+			name:        "web-03",
+			expectedErr: &cliErr.ResourceNameNotFoundError{},
+		},
+		{
+			description: "ambiguous name",
+			name:        "web-02",
+			expectedErr: &cliErr.ResourceNameAmbiguousError{},
+		},
+	}
+
+	in := &input{
+		name:     "server-id",
+		resource: "server",
+		list: func(context.Context, *print.Printer, string, *cobra.Command) ([]Candidate, error) {
+			return []Candidate{
+				{Value: testId, Name: "web-01"},
+				{Value: "11111111-1111-1111-1111-111111111111", Name: "web-02"},
+				{Value: "22222222-2222-2222-2222-222222222222", Name: "web-02"},
+			}, nil
+		},
+	}
+
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			cmd := &cobra.Command{}
+			p.Cmd = cmd
+			id, err := resolveName(context.Background(), p, "", cmd, in, tt.name)
+			switch expectedErr := tt.expectedErr.(type) {
+			case *cliErr.ResourceNameNotFoundError:
+				if !errors.As(err, &expectedErr) {
+					t.Fatalf("expected name not found error, got %v", err)
+				}
+				return
+			case *cliErr.ResourceNameAmbiguousError:
+				if !errors.As(err, &expectedErr) {
+					t.Fatalf("expected ambiguous name error, got %v", err)
+				}
+				if len(expectedErr.Ids) != 2 {
+					t.Errorf("expected the IDs of both resources, got %v", expectedErr.Ids)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != tt.expectedId {
+				t.Errorf("expected ID %q, got %q", tt.expectedId, id)
+			}
+		})
+	}
+}
+
+func TestResolveNameCache(t *testing.T) {
+	id := testId
+	in := &input{
+		name:     "server-id",
+		resource: "server",
+		list: func(context.Context, *print.Printer, string, *cobra.Command) ([]Candidate, error) {
+			return []Candidate{{Value: id, Name: "web-01"}}, nil
+		},
+	}
+
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	p := print.NewPrinter()
+	listCmd := &cobra.Command{Use: "list"}
+	p.Cmd = listCmd
+	_, err := resolveName(context.Background(), p, "", listCmd, in, "web-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The server is recreated with the same name
+	id = "11111111-1111-1111-1111-111111111111"
+
+	resolvedId, err := resolveName(context.Background(), p, "", listCmd, in, "web-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolvedId != testId {
+		t.Errorf("expected the cached ID %q, got %q", testId, resolvedId)
+	}
+
+	deleteCmd := &cobra.Command{Use: "delete", RunE: func(*cobra.Command, []string) error { return nil }}
+	journal.Enable(deleteCmd)
+	resolvedId, err = resolveName(context.Background(), p, "", deleteCmd, in, "web-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolvedId != id {
+		t.Errorf("expected commands changing resources not to use the cache and to get ID %q, got %q", id, resolvedId)
+	}
+}
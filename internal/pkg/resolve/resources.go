@@ -0,0 +1,519 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	dnsClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/dns/client"
+	gitClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/git/client"
+	iaasClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/iaas/client"
+	logMeClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/logme/client"
+	mariaDBClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/mariadb/client"
+	mongoDBFlexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	observabilityClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/observability/client"
+	openSearchClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/client"
+	postgresFlexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	rabbitMQClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/rabbitmq/client"
+	redisClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/redis/client"
+	secretsManagerClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/secrets-manager/client"
+	skeClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	sqlServerFlexClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Deleted DNS zones and record sets are kept for some time, but can't be selected
+	dnsDeleteSucceededState = "DELETE_SUCCEEDED"
+	// DNS zones and record sets are listed page by page, with the page size of the list commands
+	dnsPageSize = 100
+)
+
+// Inputs whose candidates can be selected when they are missing, and which can reference a resource by its name.
+// Arguments of "create" commands are never selected, as they name the resource to be created.
+var inputs = []input{
+	{scope: "dns", name: "zone-id", resource: "DNS zone", list: listDNSZones},
+	{scope: "dns zone", name: "ZONE_ID", resource: "DNS zone", list: listDNSZones},
+	{scope: "dns record-set", name: "RECORD_SET_ID", resource: "DNS record set", parent: "zone-id", list: listDNSRecordSets},
+	{scope: "server", name: "server-id", resource: "server", list: listServers},
+	{scope: "server", name: "SERVER_ID", resource: "server", list: listServers},
+	{scope: "server", name: "network-id", resource: "network", list: listNetworks},
+	{scope: "server", name: "image-id", resource: "image", list: listImages},
+	{scope: "server volume", name: "VOLUME_ID", resource: "volume", list: listVolumes},
+	{scope: "server public-ip", name: "PUBLIC_IP_ID", resource: "public IP", list: listPublicIPs},
+	{scope: "network", name: "NETWORK_ID", resource: "network", list: listNetworks},
+	{scope: "network-interface", name: "network-id", resource: "network", list: listNetworks},
+	{scope: "network-area", name: "AREA_ID", resource: "network area", parent: "organization-id", list: listNetworkAreas},
+	{scope: "network-area", name: "network-area-id", resource: "network area", parent: "organization-id", list: listNetworkAreas},
+	{scope: "volume", name: "VOLUME_ID", resource: "volume", list: listVolumes},
+	{scope: "volume", name: "volume-id", resource: "volume", list: listVolumes},
+	{scope: "security-group", name: "GROUP_ID", resource: "security group", list: listSecurityGroups},
+	{scope: "security-group", name: "security-group-id", resource: "security group", list: listSecurityGroups},
+	{scope: "image", name: "IMAGE_ID", resource: "image", list: listImages},
+	{scope: "public-ip", name: "PUBLIC_IP_ID", resource: "public IP", list: listPublicIPs},
+	{scope: "ske", name: "CLUSTER_NAME", resource: "SKE cluster", byName: true, list: listSKEClusters},
+	{scope: "postgresflex", name: "instance-id", resource: "PostgreSQL Flex instance", list: listPostgresFlexInstances},
+	{scope: "postgresflex instance", name: "INSTANCE_ID", resource: "PostgreSQL Flex instance", list: listPostgresFlexInstances},
+	{scope: "mongodbflex", name: "instance-id", resource: "MongoDB Flex instance", list: listMongoDBFlexInstances},
+	{scope: "mongodbflex instance", name: "INSTANCE_ID", resource: "MongoDB Flex instance", list: listMongoDBFlexInstances},
+	{scope: "beta sqlserverflex", name: "instance-id", resource: "SQLServer Flex instance", list: listSQLServerFlexInstances},
+	{scope: "beta sqlserverflex instance", name: "INSTANCE_ID", resource: "SQLServer Flex instance", list: listSQLServerFlexInstances},
+	{scope: "logme", name: "instance-id", resource: "LogMe instance", list: listLogMeInstances},
+	{scope: "logme instance", name: "INSTANCE_ID", resource: "LogMe instance", list: listLogMeInstances},
+	{scope: "mariadb", name: "instance-id", resource: "MariaDB instance", list: listMariaDBInstances},
+	{scope: "mariadb instance", name: "INSTANCE_ID", resource: "MariaDB instance", list: listMariaDBInstances},
+	{scope: "opensearch", name: "instance-id", resource: "OpenSearch instance", list: listOpenSearchInstances},
+	{scope: "opensearch instance", name: "INSTANCE_ID", resource: "OpenSearch instance", list: listOpenSearchInstances},
+	{scope: "rabbitmq", name: "instance-id", resource: "RabbitMQ instance", list: listRabbitMQInstances},
+	{scope: "rabbitmq instance", name: "INSTANCE_ID", resource: "RabbitMQ instance", list: listRabbitMQInstances},
+	{scope: "redis", name: "instance-id", resource: "Redis instance", list: listRedisInstances},
+	{scope: "redis instance", name: "INSTANCE_ID", resource: "Redis instance", list: listRedisInstances},
+	{scope: "observability", name: "instance-id", resource: "Observability instance", list: listObservabilityInstances},
+	{scope: "observability", name: "INSTANCE_ID", resource: "Observability instance", list: listObservabilityInstances},
+	{scope: "secrets-manager", name: "instance-id", resource: "Secrets Manager instance", list: listSecretsManagerInstances},
+	{scope: "secrets-manager instance", name: "INSTANCE_ID", resource: "Secrets Manager instance", list: listSecretsManagerInstances},
+	{scope: "git instance", name: "INSTANCE_ID", resource: "STACKIT Git instance", list: listGitInstances},
+}
+
+func listDNSZones(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := dnsClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []Candidate{}
+	for page := int32(1); ; page++ {
+		resp, err := apiClient.ListZones(ctx, projectId).StateNeq(dnsDeleteSucceededState).PageSize(dnsPageSize).Page(page).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("get DNS zones: %w", err)
+		}
+		zones := utils.GetSliceFromPointer(resp.Zones)
+		for _, zone := range zones {
+			candidates = append(candidates, Candidate{Value: zone.GetId(), Name: zone.GetName(), Description: zone.GetDnsName()})
+		}
+		// Stop if no more pages
+		if len(zones) < dnsPageSize {
+			return candidates, nil
+		}
+	}
+}
+
+func listDNSRecordSets(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	zoneId := flags.FlagToStringValue(p, cmd, "zone-id")
+	if projectId == "" || zoneId == "" {
+		return nil, nil
+	}
+	apiClient, err := dnsClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []Candidate{}
+	for page := int32(1); ; page++ {
+		resp, err := apiClient.ListRecordSets(ctx, projectId, zoneId).StateNeq(dnsDeleteSucceededState).PageSize(dnsPageSize).Page(page).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("get DNS record sets: %w", err)
+		}
+		recordSets := utils.GetSliceFromPointer(resp.RrSets)
+		for _, recordSet := range recordSets {
+			candidates = append(candidates, Candidate{
+				Value:       recordSet.GetId(),
+				Name:        recordSet.GetName(),
+				Description: string(recordSet.GetType()),
+			})
+		}
+		// Stop if no more pages
+		if len(recordSets) < dnsPageSize {
+			return candidates, nil
+		}
+	}
+}
+
+func listServers(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListServers(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get servers: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, server := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: server.GetId(), Name: server.GetName()})
+	}
+	return candidates, nil
+}
+
+func listNetworks(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListNetworks(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get networks: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, network := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: network.GetNetworkId(), Name: network.GetName()})
+	}
+	return candidates, nil
+}
+
+func listVolumes(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListVolumes(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get volumes: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, volume := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: volume.GetId(), Name: volume.GetName()})
+	}
+	return candidates, nil
+}
+
+func listSecurityGroups(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListSecurityGroups(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get security groups: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, securityGroup := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: securityGroup.GetId(), Name: securityGroup.GetName()})
+	}
+	return candidates, nil
+}
+
+func listSKEClusters(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := skeClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListClusters(ctx, projectId, viper.GetString(config.RegionKey)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SKE clusters: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, cluster := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: cluster.GetName(), Name: cluster.GetName()})
+	}
+	return candidates, nil
+}
+
+func listPostgresFlexInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := postgresFlexClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId, viper.GetString(config.RegionKey)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get PostgreSQL Flex instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listMongoDBFlexInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := mongoDBFlexClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId, viper.GetString(config.RegionKey)).Tag("").Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get MongoDB Flex instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listImages(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListImages(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get images: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, image := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: image.GetId(), Name: image.GetName()})
+	}
+	return candidates, nil
+}
+
+// listPublicIPs lists the public IPs with their address as name, as public IPs have no name
+func listPublicIPs(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListPublicIPs(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get public IPs: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, publicIp := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: publicIp.GetId(), Name: publicIp.GetIp()})
+	}
+	return candidates, nil
+}
+
+func listNetworkAreas(ctx context.Context, p *print.Printer, cliVersion string, cmd *cobra.Command) ([]Candidate, error) {
+	organizationId := flags.FlagToStringValue(p, cmd, "organization-id")
+	if organizationId == "" {
+		return nil, nil
+	}
+	apiClient, err := iaasClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListNetworkAreas(ctx, organizationId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get network areas: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, area := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: area.GetAreaId(), Name: area.GetName()})
+	}
+	return candidates, nil
+}
+
+func listSQLServerFlexInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := sqlServerFlexClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId, viper.GetString(config.RegionKey)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Items) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listLogMeInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := logMeClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get LogMe instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetInstanceId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listMariaDBInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := mariaDBClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get MariaDB instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetInstanceId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listOpenSearchInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := openSearchClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get OpenSearch instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetInstanceId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listRabbitMQInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := rabbitMQClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get RabbitMQ instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetInstanceId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listRedisInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := redisClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get Redis instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetInstanceId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listObservabilityInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := observabilityClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get Observability instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listSecretsManagerInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := secretsManagerClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get Secrets Manager instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
+
+func listGitInstances(ctx context.Context, p *print.Printer, cliVersion string, _ *cobra.Command) ([]Candidate, error) {
+	projectId := viper.GetString(config.ProjectIdKey)
+	if projectId == "" {
+		return nil, nil
+	}
+	apiClient, err := gitClient.ConfigureClient(p, cliVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := apiClient.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get STACKIT Git instances: %w", err)
+	}
+	candidates := []Candidate{}
+	for _, instance := range utils.GetSliceFromPointer(resp.Instances) {
+		candidates = append(candidates, Candidate{Value: instance.GetId(), Name: instance.GetName()})
+	}
+	return candidates, nil
+}
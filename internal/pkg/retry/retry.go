@@ -0,0 +1,176 @@
+package retry
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/viper"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+const (
+	// Longest time waited between two attempts, unless the API asks for more with the Retry-After header
+	maxBackoff = 30 * time.Second
+)
+
+// Status codes of responses that are always retried, as the request was not processed
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// Status codes of responses that are only retried for idempotent methods,
+// as the request may have been processed before a gateway or the server failed
+var idempotentRetryableStatusCodes = []int{
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusGatewayTimeout,
+}
+
+// Methods of requests that can also be retried on server and gateway errors and on network errors,
+// as repeating them has the same effect as sending them once
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// ConfigOption returns an SDK configuration option that adds the retry policy of the CLI configuration to an API client
+func ConfigOption(p *print.Printer) sdkConfig.ConfigurationOption {
+	return sdkConfig.WithMiddleware(Middleware(p, viper.GetInt(config.RetriesKey), viper.GetDuration(config.RetryBackoffKey)))
+}
+
+// Middleware returns an SDK middleware that retries requests failing with a transient error up to retries times.
+// The time waited before each retry doubles, starting at backoff, unless the API asks for a specific delay with the Retry-After header
+func Middleware(p *print.Printer, retries int, backoff time.Duration) sdkConfig.Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &roundTripperWithRetry{
+			transport: rt,
+			p:         p,
+			retries:   retries,
+			backoff:   backoff,
+		}
+	}
+}
+
+type roundTripperWithRetry struct {
+	transport http.RoundTripper
+	p         *print.Printer
+	retries   int
+	backoff   time.Duration
+}
+
+func (rt *roundTripperWithRetry) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := rt.transport.RoundTrip(attemptReq)
+		if attempt >= rt.retries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := getDelay(resp, rt.backoff, attempt)
+		if err != nil {
+			rt.p.Debug(print.WarningLevel, "request %s %s failed, retrying in %s: %v", req.Method, req.URL, delay, err)
+		} else {
+			rt.p.Debug(print.WarningLevel, "request %s %s failed with status %d, retrying in %s", req.Method, req.URL, resp.StatusCode, delay)
+			// The body is drained so that the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Returns a copy of the request with a fresh body for the retries, as the body of the previous attempt was consumed
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("retry request %s %s: body cannot be read again", req.Method, req.URL)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("retry request %s %s: get body: %w", req.Method, req.URL, err)
+	}
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+	return attemptReq, nil
+}
+
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	idempotent := slices.Contains(idempotentMethods, req.Method)
+	if err != nil {
+		return idempotent
+	}
+	if slices.Contains(retryableStatusCodes, resp.StatusCode) {
+		return true
+	}
+	return idempotent && slices.Contains(idempotentRetryableStatusCodes, resp.StatusCode)
+}
+
+// Returns the time to wait before the next attempt, given by the Retry-After header of the response if present,
+// otherwise by the exponential backoff
+func getDelay(resp *http.Response, backoff time.Duration, attempt int) time.Duration {
+	if resp != nil {
+		delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if ok {
+			return delay
+		}
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	delay := backoff << attempt
+	// A delay smaller than the backoff means that the shift overflowed
+	if delay > maxBackoff || delay < backoff {
+		return maxBackoff
+	}
+	return delay
+}
+
+// Parses the Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	delay := date.Sub(now)
+	if delay < 0 {
+		return 0, true
+	}
+	return delay, true
+}
@@ -0,0 +1,308 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		description      string
+		method           string
+		body             string
+		statusCodes      []int
+		retries          int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			description:      "success",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusOK},
+			retries:          3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "transient error",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			retries:          3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			description:      "retries exhausted",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			retries:          2,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 3,
+		},
+		{
+			description:      "no retries",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			retries:          0,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "client error is not retried",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusNotFound, http.StatusOK},
+			retries:          3,
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "internal server error is retried for idempotent methods",
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusInternalServerError, http.StatusNoContent},
+			retries:          3,
+			expectedStatus:   http.StatusNoContent,
+			expectedAttempts: 2,
+		},
+		{
+			description:      "internal server error is not retried for other methods",
+			method:           http.MethodPost,
+			body:             `{"name":"test"}`,
+			statusCodes:      []int{http.StatusInternalServerError, http.StatusCreated},
+			retries:          3,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "gateway errors are retried for idempotent methods",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK},
+			retries:          3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			description:      "gateway error is not retried for other methods",
+			method:           http.MethodPatch,
+			body:             `{"name":"test"}`,
+			statusCodes:      []int{http.StatusGatewayTimeout, http.StatusOK},
+			retries:          3,
+			expectedStatus:   http.StatusGatewayTimeout,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "transient error with body",
+			method:           http.MethodPost,
+			body:             `{"name":"test"}`,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusCreated},
+			retries:          3,
+			expectedStatus:   http.StatusCreated,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("read request body: %v", err)
+				}
+				if string(body) != tt.body {
+					t.Errorf("attempt %d: expected body %q, got %q", attempts, tt.body, string(body))
+				}
+				w.WriteHeader(tt.statusCodes[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: Middleware(print.NewPrinter(), tt.retries, time.Millisecond)(http.DefaultTransport),
+			}
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req, err := http.NewRequest(tt.method, server.URL, body)
+			if err != nil {
+				t.Fatalf("create request: %v", err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+			defer resp.Body.Close() //nolint:errcheck // only used in tests
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRoundTripContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: Middleware(print.NewPrinter(), 3, time.Millisecond)(http.DefaultTransport),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close() //nolint:errcheck // only used in tests
+		t.Fatalf("expected request to fail")
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Fatalf("request was not canceled with the context")
+	}
+}
+
+func TestGetDelay(t *testing.T) {
+	tests := []struct {
+		description   string
+		retryAfter    string
+		backoff       time.Duration
+		attempt       int
+		expectedDelay time.Duration
+	}{
+		{
+			description:   "first attempt",
+			backoff:       time.Second,
+			attempt:       0,
+			expectedDelay: time.Second,
+		},
+		{
+			description:   "exponential backoff",
+			backoff:       time.Second,
+			attempt:       3,
+			expectedDelay: 8 * time.Second,
+		},
+		{
+			description:   "maximum backoff",
+			backoff:       time.Second,
+			attempt:       10,
+			expectedDelay: maxBackoff,
+		},
+		{
+			description:   "overflow",
+			backoff:       time.Second,
+			attempt:       100,
+			expectedDelay: maxBackoff,
+		},
+		{
+			description:   "no backoff",
+			backoff:       0,
+			attempt:       2,
+			expectedDelay: 0,
+		},
+		{
+			description:   "retry after seconds",
+			retryAfter:    "120",
+			backoff:       time.Second,
+			attempt:       0,
+			expectedDelay: 2 * time.Minute,
+		},
+		{
+			description:   "invalid retry after",
+			retryAfter:    "soon",
+			backoff:       time.Second,
+			attempt:       1,
+			expectedDelay: 2 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			delay := getDelay(resp, tt.backoff, tt.attempt)
+
+			if delay != tt.expectedDelay {
+				t.Fatalf("expected delay %s, got %s", tt.expectedDelay, delay)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description   string
+		value         string
+		isValid       bool
+		expectedDelay time.Duration
+	}{
+		{
+			description:   "seconds",
+			value:         "5",
+			isValid:       true,
+			expectedDelay: 5 * time.Second,
+		},
+		{
+			description:   "http date",
+			value:         now.Add(30 * time.Second).Format(http.TimeFormat),
+			isValid:       true,
+			expectedDelay: 30 * time.Second,
+		},
+		{
+			description:   "http date in the past",
+			value:         now.Add(-time.Minute).Format(http.TimeFormat),
+			isValid:       true,
+			expectedDelay: 0,
+		},
+		{
+			description: "empty",
+			value:       "",
+			isValid:     false,
+		},
+		{
+			description: "negative seconds",
+			value:       "-1",
+			isValid:     false,
+		},
+		{
+			description: "invalid",
+			value:       fmt.Sprintf("in %d seconds", 5),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value, now)
+
+			if ok != tt.isValid {
+				t.Fatalf("expected valid to be %t, got %t", tt.isValid, ok)
+			}
+			if delay != tt.expectedDelay {
+				t.Fatalf("expected delay %s, got %s", tt.expectedDelay, delay)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package sdkclient
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dryrun"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/har"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/journal"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/requestid"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/retry"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/session"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/viper"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// RegionMode defines how the region of the CLI configuration is set on the API client of a service
+type RegionMode int
+
+const (
+	// The region is not set on the API client, as the API is global or takes the region as a request parameter
+	RegionNone RegionMode = iota
+	// The region is always set on the API client, also when a custom endpoint is configured
+	RegionAlways
+	// The region is only set on the API client when no custom endpoint is configured,
+	// as a custom endpoint already points to a specific region
+	RegionDefaultEndpoint
+)
+
+// Service holds what is needed to configure the API client of a STACKIT service
+type Service[T any] struct {
+	// Config key of the custom endpoint of the service
+	CustomEndpointKey string
+	Region            RegionMode
+	// Constructor of the API client in the STACKIT SDK
+	NewAPIClient func(opts ...sdkConfig.ConfigurationOption) (T, error)
+}
+
+// Replaced in tests, as the authentication depends on the stored credentials
+var authenticationConfig = auth.AuthenticationConfig
+
+// ConfigureClient creates the API client of the service, configured with the authentication, user agent, retry policy,
+// region and custom endpoint of the CLI configuration
+func ConfigureClient[T any](p *print.Printer, cliVersion string, service Service[T]) (T, error) {
+	var apiClient T
+
+	// When replaying, the responses are served from the recording, so no credentials are needed
+	authCfgOption := sdkConfig.WithoutAuthentication()
+	if !cassette.IsReplaying() {
+		var err error
+		// In the interactive shell, the authentication is kept between the commands, so that tokens are not fetched again
+		authCfgOption, err = session.Remember(authenticationSessionKey(), func() (sdkConfig.ConfigurationOption, error) {
+			return authenticationConfig(p, auth.AuthorizeUser)
+		})
+		if err != nil {
+			p.Debug(print.ErrorLevel, "configure authentication: %v", err)
+			return apiClient, &errors.AuthError{}
+		}
+	}
+
+	cfgOptions := []sdkConfig.ConfigurationOption{
+		utils.UserAgentConfigOption(cliVersion),
+	}
+	// Added first, so that it is the innermost middleware and records each retry
+	if cassette.IsActive() {
+		cfgOptions = append(cfgOptions, sdkConfig.WithMiddleware(cassette.Middleware))
+	}
+	if har.IsActive() {
+		cfgOptions = append(cfgOptions, sdkConfig.WithMiddleware(har.Middleware))
+	}
+	cfgOptions = append(cfgOptions,
+		retry.ConfigOption(p),
+		sdkConfig.WithMiddleware(requestid.Middleware),
+		authCfgOption,
+	)
+	// Sees the final response of each request, after the retries
+	if journal.IsActive() {
+		cfgOptions = append(cfgOptions, sdkConfig.WithMiddleware(journal.Middleware))
+	}
+	// Added last, so that it is the outermost middleware and requests changing resources are neither retried nor recorded
+	if dryrun.IsActive() {
+		cfgOptions = append(cfgOptions, sdkConfig.WithMiddleware(dryrun.Middleware))
+	}
+
+	customEndpoint := viper.GetString(service.CustomEndpointKey)
+	if service.Region == RegionAlways || (service.Region == RegionDefaultEndpoint && customEndpoint == "") {
+		cfgOptions = append(cfgOptions, sdkConfig.WithRegion(viper.GetString(config.RegionKey)))
+	}
+	if customEndpoint != "" {
+		cfgOptions = append(cfgOptions, sdkConfig.WithEndpoint(customEndpoint))
+	}
+
+	if p.IsDebugLogged() {
+		cfgOptions = append(cfgOptions,
+			sdkConfig.WithMiddleware(print.RequestResponseCapturer(p, nil)),
+		)
+	}
+
+	apiClient, err := service.NewAPIClient(cfgOptions...)
+	if err != nil {
+		p.Debug(print.ErrorLevel, "create new API client: %v", err)
+		return apiClient, &errors.AuthError{}
+	}
+
+	return apiClient, nil
+}
+
+// The authentication is kept per profile, as the credentials are stored per profile
+func authenticationSessionKey() string {
+	profile, err := config.GetProfile()
+	if err != nil {
+		profile = ""
+	}
+	return fmt.Sprintf("authentication/%s", profile)
+}
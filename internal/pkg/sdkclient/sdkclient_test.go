@@ -0,0 +1,178 @@
+package sdkclient
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cassette"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/viper"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+const (
+	testCustomEndpointKey = "test_custom_endpoint"
+	testCustomEndpoint    = "https://test.example.com"
+	testRegion            = "eu01"
+	testToken             = "token"
+	testCliVersion        = "1.0.0"
+)
+
+func newTestAPIClient(opts ...sdkConfig.ConfigurationOption) (*sdkConfig.Configuration, error) {
+	cfg := &sdkConfig.Configuration{}
+	for _, opt := range opts {
+		err := opt(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func TestConfigureClient(t *testing.T) {
+	tests := []struct {
+		description      string
+		region           RegionMode
+		customEndpoint   string
+		authFails        bool
+		replay           bool
+		constructorFails bool
+		isValid          bool
+		expectedRegion   string
+		expectedEndpoint string
+	}{
+		{
+			description: "global service",
+			region:      RegionNone,
+			isValid:     true,
+		},
+		{
+			description:      "global service with custom endpoint",
+			region:           RegionNone,
+			customEndpoint:   testCustomEndpoint,
+			isValid:          true,
+			expectedEndpoint: testCustomEndpoint,
+		},
+		{
+			description:    "region always set",
+			region:         RegionAlways,
+			isValid:        true,
+			expectedRegion: testRegion,
+		},
+		{
+			description:      "region always set with custom endpoint",
+			region:           RegionAlways,
+			customEndpoint:   testCustomEndpoint,
+			isValid:          true,
+			expectedRegion:   testRegion,
+			expectedEndpoint: testCustomEndpoint,
+		},
+		{
+			description:    "region set for default endpoint",
+			region:         RegionDefaultEndpoint,
+			isValid:        true,
+			expectedRegion: testRegion,
+		},
+		{
+			description:      "region not set for custom endpoint",
+			region:           RegionDefaultEndpoint,
+			customEndpoint:   testCustomEndpoint,
+			isValid:          true,
+			expectedEndpoint: testCustomEndpoint,
+		},
+		{
+			description: "authentication fails",
+			authFails:   true,
+			isValid:     false,
+		},
+		{
+			description: "replaying without credentials",
+			authFails:   true,
+			replay:      true,
+			isValid:     true,
+		},
+		{
+			description:      "constructor fails",
+			constructorFails: true,
+			isValid:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			viper.Reset()
+			viper.Set(config.RegionKey, testRegion)
+			viper.Set(testCustomEndpointKey, tt.customEndpoint)
+
+			authenticationConfigBackup := authenticationConfig
+			defer func() { authenticationConfig = authenticationConfigBackup }()
+			authenticationConfig = func(_ *print.Printer, _ func(*print.Printer, bool) error) (sdkConfig.ConfigurationOption, error) {
+				if tt.authFails {
+					return nil, fmt.Errorf("not authenticated")
+				}
+				return sdkConfig.WithToken(testToken), nil
+			}
+
+			if tt.replay {
+				err := cassette.Replay(print.NewPrinter(), t.TempDir())
+				if err != nil {
+					t.Fatalf("start replaying: %v", err)
+				}
+				defer cassette.Stop()
+			}
+
+			service := Service[*sdkConfig.Configuration]{
+				CustomEndpointKey: testCustomEndpointKey,
+				Region:            tt.region,
+				NewAPIClient:      newTestAPIClient,
+			}
+			if tt.constructorFails {
+				service.NewAPIClient = func(...sdkConfig.ConfigurationOption) (*sdkConfig.Configuration, error) {
+					return nil, fmt.Errorf("invalid configuration")
+				}
+			}
+
+			cfg, err := ConfigureClient(print.NewPrinter(), testCliVersion, service)
+
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			// The request ID middleware is always added
+			expectedMiddlewares := 2
+			if tt.replay {
+				if !cfg.NoAuth {
+					t.Errorf("expected authentication to be disabled when replaying")
+				}
+				// The cassette middleware is added too
+				expectedMiddlewares = 3
+			} else if cfg.Token != testToken {
+				t.Errorf("expected token %q, got %q", testToken, cfg.Token)
+			}
+			if cfg.UserAgent != fmt.Sprintf("stackit-cli/%s", testCliVersion) {
+				t.Errorf("unexpected user agent %q", cfg.UserAgent)
+			}
+			if cfg.Region != tt.expectedRegion {
+				t.Errorf("expected region %q, got %q", tt.expectedRegion, cfg.Region)
+			}
+			endpoint := ""
+			if len(cfg.Servers) > 0 {
+				endpoint = cfg.Servers[0].URL
+			}
+			if endpoint != tt.expectedEndpoint {
+				t.Errorf("expected endpoint %q, got %q", tt.expectedEndpoint, endpoint)
+			}
+			// The debug middleware is not added, as the verbosity is not debug
+			if len(cfg.Middleware) != expectedMiddlewares {
+				t.Errorf("expected %d middlewares, got %d", expectedMiddlewares, len(cfg.Middleware))
+			}
+		})
+	}
+}
@@ -1,44 +1,19 @@
 package client
 
 import (
-	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/sdkclient"
 
-	"github.com/spf13/viper"
-	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/alb"
 )
 
-func ConfigureClient(p *print.Printer, cliVersion string) (*alb.APIClient, error) {
-	authCfgOption, err := auth.AuthenticationConfig(p, auth.AuthorizeUser)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "configure authentication: %v", err)
-		return nil, &errors.AuthError{}
-	}
-	cfgOptions := []sdkConfig.ConfigurationOption{
-		utils.UserAgentConfigOption(cliVersion),
-		authCfgOption,
-	}
-
-	customEndpoint := viper.GetString(config.IaaSCustomEndpointKey)
-	if customEndpoint != "" {
-		cfgOptions = append(cfgOptions, sdkConfig.WithEndpoint(customEndpoint))
-	}
-
-	if p.IsVerbosityDebug() {
-		cfgOptions = append(cfgOptions,
-			sdkConfig.WithMiddleware(print.RequestResponseCapturer(p, nil)),
-		)
-	}
-
-	apiClient, err := alb.NewAPIClient(cfgOptions...)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "create new API client: %v", err)
-		return nil, &errors.AuthError{}
-	}
+var service = sdkclient.Service[*alb.APIClient]{
+	CustomEndpointKey: config.IaaSCustomEndpointKey,
+	Region:            sdkclient.RegionNone,
+	NewAPIClient:      alb.NewAPIClient,
+}
 
-	return apiClient, nil
+func ConfigureClient(p *print.Printer, cliVersion string) (*alb.APIClient, error) {
+	return sdkclient.ConfigureClient(p, cliVersion, service)
 }
@@ -1,46 +1,19 @@
 package client
 
 import (
-	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
-
-	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/sdkclient"
 
-	"github.com/spf13/viper"
-	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 )
 
-func ConfigureClient(p *print.Printer, cliVersion string) (*dns.APIClient, error) {
-	authCfgOption, err := auth.AuthenticationConfig(p, auth.AuthorizeUser)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "configure authentication: %v", err)
-		return nil, &errors.AuthError{}
-	}
-	cfgOptions := []sdkConfig.ConfigurationOption{
-		utils.UserAgentConfigOption(cliVersion),
-		authCfgOption,
-	}
-
-	customEndpoint := viper.GetString(config.DNSCustomEndpointKey)
-
-	if customEndpoint != "" {
-		cfgOptions = append(cfgOptions, sdkConfig.WithEndpoint(customEndpoint))
-	}
-
-	if p.IsVerbosityDebug() {
-		cfgOptions = append(cfgOptions,
-			sdkConfig.WithMiddleware(print.RequestResponseCapturer(p, nil)),
-		)
-	}
-
-	apiClient, err := dns.NewAPIClient(cfgOptions...)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "create new API client: %v", err)
-		return nil, &errors.AuthError{}
-	}
+var service = sdkclient.Service[*dns.APIClient]{
+	CustomEndpointKey: config.DNSCustomEndpointKey,
+	Region:            sdkclient.RegionNone,
+	NewAPIClient:      dns.NewAPIClient,
+}
 
-	return apiClient, nil
+func ConfigureClient(p *print.Printer, cliVersion string) (*dns.APIClient, error) {
+	return sdkclient.ConfigureClient(p, cliVersion, service)
 }
@@ -1,45 +1,19 @@
 package client
 
 import (
-	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/sdkclient"
 
-	"github.com/spf13/viper"
-	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/git"
 )
 
-func ConfigureClient(p *print.Printer) (*git.APIClient, error) {
-	var err error
-	var apiClient *git.APIClient
-	var cfgOptions []sdkConfig.ConfigurationOption
-
-	authCfgOption, err := auth.AuthenticationConfig(p, auth.AuthorizeUser)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "configure authentication: %v", err)
-		return nil, &errors.AuthError{}
-	}
-	cfgOptions = append(cfgOptions, authCfgOption)
-
-	customEndpoint := viper.GetString(config.GitCustomEndpointKey)
-
-	if customEndpoint != "" {
-		cfgOptions = append(cfgOptions, sdkConfig.WithEndpoint(customEndpoint))
-	}
-
-	if p.IsVerbosityDebug() {
-		cfgOptions = append(cfgOptions,
-			sdkConfig.WithMiddleware(print.RequestResponseCapturer(p, nil)),
-		)
-	}
-
-	apiClient, err = git.NewAPIClient(cfgOptions...)
-	if err != nil {
-		p.Debug(print.ErrorLevel, "create new API client: %v", err)
-		return nil, &errors.AuthError{}
-	}
+var service = sdkclient.Service[*git.APIClient]{
+	CustomEndpointKey: config.GitCustomEndpointKey,
+	Region:            sdkclient.RegionNone,
+	NewAPIClient:      git.NewAPIClient,
+}
 
-	return apiClient, nil
+func ConfigureClient(p *print.Printer, cliVersion string) (*git.APIClient, error) {
+	return sdkclient.ConfigureClient(p, cliVersion, service)
 }
@@ -0,0 +1,68 @@
+package session
+
+import (
+	"sync"
+)
+
+// State of the interactive shell, kept between the commands run in it.
+// Outside of the shell, nothing is kept and each value is fetched again.
+var (
+	mu     sync.Mutex
+	active bool
+	values map[string]any
+)
+
+// Start starts a session. Values fetched through Remember are kept until Forget or End are called.
+func Start() {
+	mu.Lock()
+	defer mu.Unlock()
+	active = true
+	values = map[string]any{}
+}
+
+// End ends the session and drops the values kept in it
+func End() {
+	mu.Lock()
+	defer mu.Unlock()
+	active = false
+	values = nil
+}
+
+// IsActive returns whether a session is started
+func IsActive() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return active
+}
+
+// Forget drops the values kept in the session, for example after the credentials have changed
+func Forget() {
+	mu.Lock()
+	defer mu.Unlock()
+	if active {
+		values = map[string]any{}
+	}
+}
+
+// Remember returns the value kept in the session for the key. If there is none, it is fetched and, if no error
+// occurred, kept for the next commands. Without a session, the value is always fetched.
+func Remember[T any](key string, fetch func() (T, error)) (T, error) {
+	mu.Lock()
+	if value, ok := values[key].(T); ok && active {
+		mu.Unlock()
+		return value, nil
+	}
+	mu.Unlock()
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if active {
+		values[key] = value
+	}
+	return value, nil
+}
@@ -0,0 +1,71 @@
+package session
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRemember(t *testing.T) {
+	tests := []struct {
+		description     string
+		start           bool
+		forget          bool
+		fetchErr        error
+		expectedFetches int
+	}{
+		{
+			description:     "no session",
+			expectedFetches: 2,
+		},
+		{
+			description:     "session",
+			start:           true,
+			expectedFetches: 1,
+		},
+		{
+			description:     "session forgotten",
+			start:           true,
+			forget:          true,
+			expectedFetches: 2,
+		},
+		{
+			description:     "fetch failed",
+			start:           true,
+			fetchErr:        fmt.Errorf("error"),
+			expectedFetches: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if tt.start {
+				Start()
+				defer End()
+			}
+
+			fetches := 0
+			fetch := func() (string, error) {
+				fetches++
+				return "value", tt.fetchErr
+			}
+
+			for i := 0; i < 2; i++ {
+				value, err := Remember("key", fetch)
+				if tt.fetchErr != nil {
+					if err == nil {
+						t.Fatalf("expected error")
+					}
+				} else if value != "value" {
+					t.Fatalf("expected value %q, got %q", "value", value)
+				}
+				if tt.forget {
+					Forget()
+				}
+			}
+
+			if fetches != tt.expectedFetches {
+				t.Errorf("expected %d fetches, got %d", tt.expectedFetches, fetches)
+			}
+		})
+	}
+}
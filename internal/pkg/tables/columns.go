@@ -0,0 +1,274 @@
+package tables
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Annotation set on the commands that support the --columns and --sort-by flags
+	columnsAnnotation = "columns"
+
+	ColumnsFlag = "columns"
+	SortByFlag  = "sort-by"
+
+	// Column preset that selects all columns, including the wide ones
+	WideColumnsPreset = "wide"
+)
+
+// EnableColumns adds the --columns and --sort-by flags to the command, which select and sort the columns of its tables.
+// It is meant for commands building their tables with NewTableFromFields.
+// Commands sorting their items for all output formats can define their own --sort-by flag before, whose values must be column names.
+func EnableColumns(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(ColumnsFlag, nil, fmt.Sprintf(`Comma-separated list of the table columns to display, in the given order. Use %q to display all available columns`, WideColumnsPreset))
+	if cmd.Flags().Lookup(SortByFlag) == nil {
+		cmd.Flags().String(SortByFlag, "", `Table column to sort the rows by. Prefix it with "-" to sort in descending order`)
+	}
+
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[columnsAnnotation] = "true"
+}
+
+// Returns whether the command supports the --columns and --sort-by flags
+func isColumnsEnabled(cmd *cobra.Command) bool {
+	return cmd != nil && cmd.Annotations[columnsAnnotation] == "true"
+}
+
+// Field describes a column of a list command's table.
+// Each list command declares its fields once and builds its table with NewTableFromFields.
+type Field[T any] struct {
+	// Column header, also used to select (--columns) and sort (--sort-by) the column
+	Header string
+	// Returns the value of the column for the given item
+	Value func(item *T) any
+	// If true, the column is only displayed when selected explicitly or with the "wide" preset
+	Wide bool
+}
+
+// Creates a new table with a column for each field and a row for each item
+func NewTableFromFields[T any](fields []Field[T], items []T) Table {
+	return newTableFromFields(fields, items, nil)
+}
+
+// Creates a new table like NewTableFromFields, with the rows grouped by the key returned for their items.
+// A separator is drawn between consecutive rows of different groups, also when the rows are sorted by the --sort-by flag
+func NewGroupedTableFromFields[T any, K comparable](fields []Field[T], items []T, groupKey func(item *T) K) Table {
+	groups := map[K]int{}
+	return newTableFromFields(fields, items, func(item *T) int {
+		key := groupKey(item)
+		group, ok := groups[key]
+		if !ok {
+			group = len(groups)
+			groups[key] = group
+		}
+		return group
+	})
+}
+
+func newTableFromFields[T any](fields []Field[T], items []T, group func(item *T) int) Table {
+	t := NewTable()
+
+	header := make([]interface{}, 0, len(fields))
+	wideColumns := []string{}
+	for _, f := range fields {
+		header = append(header, f.Header)
+		if f.Wide {
+			wideColumns = append(wideColumns, f.Header)
+		}
+	}
+	t.SetHeader(header...)
+	t.SetWideColumns(wideColumns...)
+
+	for i := range items {
+		row := make([]interface{}, 0, len(fields))
+		for _, f := range fields {
+			row = append(row, f.Value(&items[i]))
+		}
+		if group != nil {
+			t.addGroupedRow(group(&items[i]), row...)
+			continue
+		}
+		t.AddRow(row...)
+	}
+	return t
+}
+
+// Sets the columns that are hidden by default.
+// They are only displayed when selected explicitly or with the "wide" preset
+func (t *Table) SetWideColumns(columns ...string) {
+	t.wideColumns = columns
+}
+
+// Returns the names of the table columns, as accepted by the --columns and --sort-by flags
+func (t *Table) ColumnNames() []string {
+	names := make([]string, 0, len(t.header))
+	for _, h := range t.header {
+		names = append(names, columnName(h))
+	}
+	return names
+}
+
+// Returns a new table with the given columns (in the given order) and the rows sorted by the given column.
+// If no columns are given, all columns except the wide ones are selected.
+// Prefixing the sort column with "-" sorts in descending order.
+// Tables without header are returned unchanged.
+func (t *Table) selectAndSort(columns []string, sortBy string) (Table, error) {
+	if len(t.header) == 0 {
+		return *t, nil
+	}
+
+	indexes, err := t.columnIndexes(columns)
+	if err != nil {
+		return Table{}, err
+	}
+
+	// The rows are sorted by their position, so that their groups are sorted with them
+	order := make([]int, len(t.rows))
+	for i := range order {
+		order[i] = i
+	}
+	sorted := true
+	if sortBy != "" {
+		descending := strings.HasPrefix(sortBy, "-")
+		sortIndex, ok := t.columnIndex(strings.TrimPrefix(sortBy, "-"))
+		if !ok {
+			return Table{}, fmt.Errorf("unknown column %q to sort by, available columns: %s", sortBy, strings.Join(t.ColumnNames(), ", "))
+		}
+		less := func(i, j int) bool {
+			a, b := cell(t.rows[order[i]], sortIndex), cell(t.rows[order[j]], sortIndex)
+			if descending {
+				return lessCell(b, a)
+			}
+			return lessCell(a, b)
+		}
+		sorted = sort.SliceIsSorted(order, less)
+		sort.SliceStable(order, less)
+	}
+
+	if sorted && isAllColumnsInOrder(indexes, len(t.header)) {
+		// Nothing changes, keep the table as it is (including separators and column configurations)
+		return *t, nil
+	}
+	return t.rebuild(indexes, order), nil
+}
+
+// Returns a new table with the given columns of the rows in the given order.
+// The auto-merged columns are merged at their new positions, if they are still selected
+func (t *Table) rebuild(indexes, order []int) Table {
+	rebuilt := NewTable()
+	rebuilt.separateRows = t.separateRows
+	if t.title != "" {
+		rebuilt.SetTitle(t.title)
+	}
+	header := make([]interface{}, 0, len(indexes))
+	autoMergeColumns := []int{}
+	for column, i := range indexes {
+		header = append(header, t.header[i])
+		if slices.Contains(t.autoMergeColumns, i+1) {
+			autoMergeColumns = append(autoMergeColumns, column+1)
+		}
+	}
+	rebuilt.SetHeader(header...)
+	if len(autoMergeColumns) > 0 {
+		rebuilt.EnableAutoMergeOnColumns(autoMergeColumns...)
+	}
+	for _, r := range order {
+		rebuiltRow := make([]interface{}, 0, len(indexes))
+		for _, i := range indexes {
+			rebuiltRow = append(rebuiltRow, cell(t.rows[r], i))
+		}
+		if t.groups != nil {
+			rebuilt.addGroupedRow(t.groups[r], rebuiltRow...)
+			continue
+		}
+		rebuilt.AddRow(rebuiltRow...)
+	}
+	return rebuilt
+}
+
+func (t *Table) columnIndexes(columns []string) ([]int, error) {
+	if len(columns) == 0 {
+		indexes := []int{}
+		for i, h := range t.header {
+			if !t.isWideColumn(h) {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes, nil
+	}
+
+	indexes := []int{}
+	for _, c := range columns {
+		if strings.EqualFold(c, WideColumnsPreset) {
+			for i := range t.header {
+				indexes = append(indexes, i)
+			}
+			continue
+		}
+		i, ok := t.columnIndex(c)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q, available columns: %s", c, strings.Join(t.ColumnNames(), ", "))
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+func isAllColumnsInOrder(indexes []int, numColumns int) bool {
+	if len(indexes) != numColumns {
+		return false
+	}
+	for i, index := range indexes {
+		if i != index {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Table) columnIndex(column string) (int, bool) {
+	for i, h := range t.header {
+		if columnName(h) == columnName(column) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (t *Table) isWideColumn(header string) bool {
+	for _, c := range t.wideColumns {
+		if columnName(c) == columnName(header) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the normalized column name of a header, e.g. "RECORD DATA" becomes "record-data"
+func columnName(header string) string {
+	return strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(strings.TrimSpace(header)))
+}
+
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// Compares cells numerically if both are numbers, otherwise lexicographically
+func lessCell(a, b string) bool {
+	aNumber, aErr := strconv.ParseFloat(a, 64)
+	bNumber, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return aNumber < bNumber
+	}
+	return a < b
+}
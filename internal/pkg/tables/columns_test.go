@@ -0,0 +1,273 @@
+package tables
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testItem struct {
+	Id   string
+	Name string
+	Size int
+	Zone string
+}
+
+var testFields = []Field[testItem]{
+	{Header: "ID", Value: func(i *testItem) any { return i.Id }},
+	{Header: "NAME", Value: func(i *testItem) any { return i.Name }},
+	{Header: "SIZE (GB)", Value: func(i *testItem) any { return i.Size }},
+	{Header: "AVAILABILITY ZONE", Value: func(i *testItem) any { return i.Zone }, Wide: true},
+}
+
+var testItems = []testItem{
+	{Id: "id-1", Name: "b", Size: 10, Zone: "eu01-1"},
+	{Id: "id-2", Name: "a", Size: 9, Zone: "eu01-2"},
+	{Id: "id-3", Name: "c", Size: 100, Zone: "eu01-1"},
+}
+
+func TestNewTableFromFields(t *testing.T) {
+	table := NewTableFromFields(testFields, testItems)
+
+	expectedHeader := []string{"ID", "NAME", "SIZE (GB)", "AVAILABILITY ZONE"}
+	if diff := cmp.Diff(expectedHeader, table.header); diff != "" {
+		t.Errorf("header does not match: %s", diff)
+	}
+	expectedRows := [][]string{
+		{"id-1", "b", "10", "eu01-1"},
+		{"id-2", "a", "9", "eu01-2"},
+		{"id-3", "c", "100", "eu01-1"},
+	}
+	if diff := cmp.Diff(expectedRows, table.rows); diff != "" {
+		t.Errorf("rows do not match: %s", diff)
+	}
+	if diff := cmp.Diff([]string{"AVAILABILITY ZONE"}, table.wideColumns); diff != "" {
+		t.Errorf("wide columns do not match: %s", diff)
+	}
+}
+
+func TestSelectAndSort(t *testing.T) {
+	tests := []struct {
+		description    string
+		columns        []string
+		sortBy         string
+		expectedHeader []string
+		expectedRows   [][]string
+		isValid        bool
+	}{
+		{
+			description:    "default columns",
+			expectedHeader: []string{"ID", "NAME", "SIZE (GB)"},
+			expectedRows: [][]string{
+				{"id-1", "b", "10"},
+				{"id-2", "a", "9"},
+				{"id-3", "c", "100"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "wide preset",
+			columns:        []string{"wide"},
+			expectedHeader: []string{"ID", "NAME", "SIZE (GB)", "AVAILABILITY ZONE"},
+			expectedRows: [][]string{
+				{"id-1", "b", "10", "eu01-1"},
+				{"id-2", "a", "9", "eu01-2"},
+				{"id-3", "c", "100", "eu01-1"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "select and reorder columns",
+			columns:        []string{"availability-zone", "id"},
+			expectedHeader: []string{"AVAILABILITY ZONE", "ID"},
+			expectedRows: [][]string{
+				{"eu01-1", "id-1"},
+				{"eu01-2", "id-2"},
+				{"eu01-1", "id-3"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "column names are normalized",
+			columns:        []string{"Size_(GB)", "NAME"},
+			expectedHeader: []string{"SIZE (GB)", "NAME"},
+			expectedRows: [][]string{
+				{"10", "b"},
+				{"9", "a"},
+				{"100", "c"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "sort by string column",
+			sortBy:         "name",
+			expectedHeader: []string{"ID", "NAME", "SIZE (GB)"},
+			expectedRows: [][]string{
+				{"id-2", "a", "9"},
+				{"id-1", "b", "10"},
+				{"id-3", "c", "100"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "sort by numeric column descending",
+			sortBy:         "-size-(gb)",
+			expectedHeader: []string{"ID", "NAME", "SIZE (GB)"},
+			expectedRows: [][]string{
+				{"id-3", "c", "100"},
+				{"id-1", "b", "10"},
+				{"id-2", "a", "9"},
+			},
+			isValid: true,
+		},
+		{
+			description:    "sort by hidden column",
+			sortBy:         "availability-zone",
+			columns:        []string{"id"},
+			expectedHeader: []string{"ID"},
+			expectedRows: [][]string{
+				{"id-1"},
+				{"id-3"},
+				{"id-2"},
+			},
+			isValid: true,
+		},
+		{
+			description: "unknown column",
+			columns:     []string{"unknown"},
+			isValid:     false,
+		},
+		{
+			description: "unknown sort column",
+			sortBy:      "unknown",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			table := NewTableFromFields(testFields, testItems)
+			selected, err := table.selectAndSort(tt.columns, tt.sortBy)
+
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if !tt.isValid {
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedHeader, selected.header); diff != "" {
+				t.Errorf("header does not match: %s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedRows, selected.rows); diff != "" {
+				t.Errorf("rows do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectAndSortWithoutHeader(t *testing.T) {
+	table := NewTable()
+	table.AddRow("ID", "id-1")
+	table.AddRow("NAME", "name-1")
+
+	selected, err := table.selectAndSort([]string{"id"}, "name")
+	if err != nil {
+		t.Fatalf("failed on table without header: %v", err)
+	}
+	if diff := cmp.Diff(table.rows, selected.rows); diff != "" {
+		t.Errorf("table without header should be unchanged: %s", diff)
+	}
+}
+
+// Returns the rendered rows of the table by their first cell, with "---" for the separators between them
+func renderedRows(table *Table) []string {
+	lines := strings.Split(strings.TrimSpace(table.Render()), "\n")
+	rows := []string{}
+	// The first two lines are the header and its separator
+	for _, line := range lines[2:] {
+		if strings.Contains(line, "┼") {
+			rows = append(rows, "---")
+			continue
+		}
+		rows = append(rows, strings.TrimSpace(strings.Split(line, "│")[0]))
+	}
+	return rows
+}
+
+func TestNewGroupedTableFromFields(t *testing.T) {
+	tests := []struct {
+		description  string
+		columns      []string
+		sortBy       string
+		expectedRows []string
+	}{
+		{
+			description:  "groups in the order of the items",
+			expectedRows: []string{"id-1", "---", "id-2", "---", "id-3"},
+		},
+		{
+			description:  "groups sorted together",
+			columns:      []string{"id", "availability-zone"},
+			sortBy:       "availability-zone",
+			expectedRows: []string{"id-1", "id-3", "---", "id-2"},
+		},
+		{
+			description:  "sorted by another column",
+			sortBy:       "name",
+			expectedRows: []string{"id-2", "---", "id-1", "id-3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			table := NewGroupedTableFromFields(testFields, testItems, func(i *testItem) string { return i.Zone })
+
+			selected, err := table.selectAndSort(tt.columns, tt.sortBy)
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedRows, renderedRows(&selected)); diff != "" {
+				t.Errorf("rows and separators do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectAndSortAutoMerge(t *testing.T) {
+	tests := []struct {
+		description              string
+		columns                  []string
+		expectedAutoMergeColumns []int
+	}{
+		{
+			description:              "columns in order",
+			expectedAutoMergeColumns: []int{2},
+		},
+		{
+			description:              "columns reordered",
+			columns:                  []string{"name", "id"},
+			expectedAutoMergeColumns: []int{1},
+		},
+		{
+			description:              "column dropped",
+			columns:                  []string{"id", "availability-zone"},
+			expectedAutoMergeColumns: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			table := NewTableFromFields(testFields, testItems)
+			table.EnableAutoMergeOnColumns(2)
+
+			selected, err := table.selectAndSort(tt.columns, "")
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedAutoMergeColumns, selected.autoMergeColumns); diff != "" {
+				t.Errorf("auto-merged columns do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package tables
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
+	"strings"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 
@@ -9,8 +12,26 @@ import (
 	"github.com/jedib0t/go-pretty/v6/text"
 )
 
+// Needed to avoid importing the globalflags package
+// Originally defined in "internal/pkg/globalflags/global_flags.go"
+const noHeadersFlag = "no-headers"
+
 type Table struct {
 	table table.Writer
+
+	// Plain copies of the table contents, used to render the tabular output formats
+	title  string
+	header []string
+	rows   [][]string
+
+	// Columns hidden unless selected with the --columns flag
+	wideColumns []string
+	// Whether a separator is drawn between all rows
+	separateRows bool
+	// Group of each row, if the rows are grouped. A separator is drawn between consecutive rows of different groups
+	groups []int
+	// Columns, numbered from 1, whose cells with similar values are merged
+	autoMergeColumns []int
 }
 
 // Creates a new table
@@ -23,6 +44,7 @@ func NewTable() Table {
 
 // Sets the title of the table
 func (t *Table) SetTitle(title string) {
+	t.title = title
 	t.table.SetTitle(title)
 
 	// prevent title wrapping by setting the width of the first column to the length of the title
@@ -37,11 +59,13 @@ func (t *Table) SetTitle(title string) {
 
 // Sets the header of the table
 func (t *Table) SetHeader(header ...interface{}) {
+	t.header = toStrings(header)
 	t.table.AppendHeader(table.Row(header))
 }
 
 // Adds a row to the table
 func (t *Table) AddRow(row ...interface{}) {
+	t.rows = append(t.rows, toStrings(row))
 	t.table.AppendRow(table.Row(row))
 }
 
@@ -50,8 +74,23 @@ func (t *Table) AddSeparator() {
 	t.table.AppendSeparator()
 }
 
+// Adds a row belonging to the given group, with a separator before it if the previous row belongs to another group
+func (t *Table) addGroupedRow(group int, row ...interface{}) {
+	if len(t.groups) > 0 && t.groups[len(t.groups)-1] != group {
+		t.AddSeparator()
+	}
+	t.groups = append(t.groups, group)
+	t.AddRow(row...)
+}
+
+// Draws a separator between all rows, also when the rows are selected or sorted by the flags of the table output
+func (t *Table) EnableRowSeparators() {
+	t.separateRows = true
+}
+
 // Enables auto-merging of cells with similar values in the given columns
 func (t *Table) EnableAutoMergeOnColumns(columns ...int) {
+	t.autoMergeColumns = columns
 	var colConfigs []table.ColumnConfig
 	for _, c := range columns {
 		colConfigs = append(colConfigs, table.ColumnConfig{Number: c, AutoMerge: true})
@@ -67,25 +106,201 @@ func (t *Table) Render() string {
 	t.table.Style().Title.Align = text.AlignCenter
 
 	t.table.Style().Options.DrawBorder = false
-	t.table.Style().Options.SeparateRows = false
+	t.table.Style().Options.SeparateRows = t.separateRows
 	t.table.Style().Options.SeparateColumns = true
 	t.table.Style().Options.SeparateHeader = true
 
 	return fmt.Sprintf("\n%s\n\n", t.table.Render())
 }
 
-// Displays the table in the command's stdout
-func (t *Table) Display(p *print.Printer) error {
-	return p.PagerDisplay(t.Render())
+// Returns the table rendered as CSV (RFC 4180). The title is omitted
+func (t *Table) RenderCSV(noHeaders bool) (string, error) {
+	return t.renderDelimited(',', noHeaders)
 }
 
-// Displays multiple tables in the command's stdout
-func DisplayTables(p *print.Printer, tables []Table) error {
-	renderedTables := ""
+// Returns the table rendered as tab-separated values. The title is omitted
+func (t *Table) RenderTSV(noHeaders bool) (string, error) {
+	return t.renderDelimited('\t', noHeaders)
+}
 
-	for _, t := range tables {
-		renderedTables += t.Render()
+func (t *Table) renderDelimited(delimiter rune, noHeaders bool) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = delimiter
+	if !noHeaders && len(t.header) > 0 {
+		err := w.Write(t.header)
+		if err != nil {
+			return "", fmt.Errorf("write header: %w", err)
+		}
+	}
+	err := w.WriteAll(t.rows)
+	if err != nil {
+		return "", fmt.Errorf("write rows: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// Returns the table rendered as a Markdown table, with the title as heading.
+// Markdown tables require a header row, so an empty one is rendered if the table has no header or noHeaders is set
+func (t *Table) RenderMarkdown(noHeaders bool) string {
+	numColumns := len(t.header)
+	for _, row := range t.rows {
+		numColumns = max(numColumns, len(row))
+	}
+	if numColumns == 0 {
+		return ""
 	}
 
-	return p.PagerDisplay(renderedTables)
+	header := make([]string, numColumns)
+	if !noHeaders {
+		copy(header, t.header)
+	}
+	delimiterRow := make([]string, numColumns)
+	for i := range delimiterRow {
+		delimiterRow[i] = "---"
+	}
+
+	var sb strings.Builder
+	if t.title != "" {
+		sb.WriteString(fmt.Sprintf("### %s\n\n", escapeMarkdown(t.title)))
+	}
+	writeMarkdownRow(&sb, header, numColumns)
+	writeMarkdownRow(&sb, delimiterRow, numColumns)
+	for _, row := range t.rows {
+		writeMarkdownRow(&sb, row, numColumns)
+	}
+	return sb.String()
+}
+
+func writeMarkdownRow(sb *strings.Builder, row []string, numColumns int) {
+	sb.WriteString("|")
+	for i := 0; i < numColumns; i++ {
+		cell := ""
+		if i < len(row) {
+			cell = escapeMarkdown(row[i])
+		}
+		sb.WriteString(fmt.Sprintf(" %s |", cell))
+	}
+	sb.WriteString("\n")
+}
+
+// Escapes the characters that would break a Markdown table cell
+func escapeMarkdown(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`|`, `\|`,
+		"\r\n", "<br>",
+		"\n", "<br>",
+	).Replace(s)
+}
+
+// Displays the table in the command's stdout, in the given output format
+func (t *Table) Display(p *print.Printer, outputFormat string) error {
+	return DisplayTables(p, []Table{*t}, outputFormat)
+}
+
+// Prints the table to the command's stdout, in the given output format.
+// Unlike Display, the pretty output isn't shown in the pager
+func (t *Table) Print(p *print.Printer, outputFormat string) error {
+	return displayTables(p, []Table{*t}, outputFormat, false)
+}
+
+// Displays multiple tables in the command's stdout, in the given output format.
+// The columns and row order of the tables can be changed with the --columns and --sort-by flags of commands supporting them
+func DisplayTables(p *print.Printer, tables []Table, outputFormat string) error {
+	return displayTables(p, tables, outputFormat, true)
+}
+
+func displayTables(p *print.Printer, tables []Table, outputFormat string, usePager bool) error {
+	outputFormat, _ = print.ParseOutputFormat(outputFormat)
+	noHeaders := boolFlagValue(p, noHeadersFlag)
+	var columns []string
+	sortBy := ""
+	if isColumnsEnabled(p.Cmd) {
+		columns = stringSliceFlagValue(p, ColumnsFlag)
+		sortBy = stringFlagValue(p, SortByFlag)
+	}
+
+	for i := range tables {
+		selected, err := tables[i].selectAndSort(columns, sortBy)
+		if err != nil {
+			return err
+		}
+		tables[i] = selected
+	}
+
+	switch outputFormat {
+	case print.CSVOutputFormat, print.TSVOutputFormat, print.MarkdownOutputFormat:
+		renderedTables := make([]string, 0, len(tables))
+		for i := range tables {
+			rendered, err := tables[i].renderTabular(outputFormat, noHeaders)
+			if err != nil {
+				return err
+			}
+			renderedTables = append(renderedTables, rendered)
+		}
+		p.Outputf("%s", strings.Join(renderedTables, "\n"))
+		return nil
+	default:
+		renderedTables := ""
+		for i := range tables {
+			if noHeaders {
+				tables[i].table.ResetHeaders()
+			}
+			renderedTables += tables[i].Render()
+		}
+		if !usePager {
+			p.Outputln(renderedTables)
+			return nil
+		}
+		return p.PagerDisplay(renderedTables)
+	}
+}
+
+// Returns the value of a flag of the table output, or "" if the command doesn't have it
+func stringFlagValue(p *print.Printer, name string) string {
+	if p.Cmd == nil {
+		return ""
+	}
+	f := p.Cmd.Flags().Lookup(name)
+	if f == nil {
+		return ""
+	}
+	return f.Value.String()
+}
+
+func boolFlagValue(p *print.Printer, name string) bool {
+	return stringFlagValue(p, name) == "true"
+}
+
+func stringSliceFlagValue(p *print.Printer, name string) []string {
+	if p.Cmd == nil {
+		return nil
+	}
+	values, err := p.Cmd.Flags().GetStringSlice(name)
+	if err != nil {
+		return nil
+	}
+	return values
+}
+
+func (t *Table) renderTabular(outputFormat string, noHeaders bool) (string, error) {
+	switch outputFormat {
+	case print.CSVOutputFormat:
+		return t.RenderCSV(noHeaders)
+	case print.TSVOutputFormat:
+		return t.RenderTSV(noHeaders)
+	case print.MarkdownOutputFormat:
+		return t.RenderMarkdown(noHeaders), nil
+	default:
+		return "", fmt.Errorf("unsupported tabular output format %q", outputFormat)
+	}
+}
+
+func toStrings(cells []interface{}) []string {
+	result := make([]string, 0, len(cells))
+	for _, c := range cells {
+		result = append(result, fmt.Sprint(c))
+	}
+	return result
 }
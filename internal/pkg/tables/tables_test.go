@@ -0,0 +1,234 @@
+package tables
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newTestTable() Table {
+	table := NewTable()
+	table.SetTitle("Record sets")
+	table.SetHeader("ID", "NAME", "RECORD DATA")
+	table.AddRow("id-1", "example.com.", "1.2.3.4, 5.6.7.8")
+	table.AddSeparator()
+	table.AddRow("id-2", `say "hello"`, "a|b\nc")
+	return table
+}
+
+func TestRenderCSV(t *testing.T) {
+	tests := []struct {
+		description string
+		noHeaders   bool
+		expected    string
+	}{
+		{
+			description: "with headers",
+			expected:    "ID,NAME,RECORD DATA\nid-1,example.com.,\"1.2.3.4, 5.6.7.8\"\nid-2,\"say \"\"hello\"\"\",\"a|b\nc\"\n",
+		},
+		{
+			description: "without headers",
+			noHeaders:   true,
+			expected:    "id-1,example.com.,\"1.2.3.4, 5.6.7.8\"\nid-2,\"say \"\"hello\"\"\",\"a|b\nc\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			table := newTestTable()
+			rendered, err := table.RenderCSV(tt.noHeaders)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if rendered != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, rendered)
+			}
+		})
+	}
+}
+
+func TestRenderTSV(t *testing.T) {
+	table := newTestTable()
+	rendered, err := table.RenderTSV(false)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	expected := "ID\tNAME\tRECORD DATA\nid-1\texample.com.\t1.2.3.4, 5.6.7.8\nid-2\t\"say \"\"hello\"\"\"\t\"a|b\nc\"\n"
+	if rendered != expected {
+		t.Errorf("expected %q, got %q", expected, rendered)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		description string
+		table       func() Table
+		noHeaders   bool
+		expected    string
+	}{
+		{
+			description: "with headers",
+			table:       newTestTable,
+			expected: "### Record sets\n\n" +
+				"| ID | NAME | RECORD DATA |\n" +
+				"| --- | --- | --- |\n" +
+				"| id-1 | example.com. | 1.2.3.4, 5.6.7.8 |\n" +
+				"| id-2 | say \"hello\" | a\\|b<br>c |\n",
+		},
+		{
+			description: "without headers",
+			table:       newTestTable,
+			noHeaders:   true,
+			expected: "### Record sets\n\n" +
+				"|  |  |  |\n" +
+				"| --- | --- | --- |\n" +
+				"| id-1 | example.com. | 1.2.3.4, 5.6.7.8 |\n" +
+				"| id-2 | say \"hello\" | a\\|b<br>c |\n",
+		},
+		{
+			description: "table without header",
+			table: func() Table {
+				table := NewTable()
+				table.AddRow("ID", "id-1")
+				table.AddRow("NAME", "name-1")
+				return table
+			},
+			expected: "|  |  |\n" +
+				"| --- | --- |\n" +
+				"| ID | id-1 |\n" +
+				"| NAME | name-1 |\n",
+		},
+		{
+			description: "empty table",
+			table:       NewTable,
+			expected:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			table := tt.table()
+			rendered := table.RenderMarkdown(tt.noHeaders)
+			if rendered != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, rendered)
+			}
+		})
+	}
+}
+
+func TestDisplayTables(t *testing.T) {
+	tests := []struct {
+		description  string
+		outputFormat string
+		noHeaders    bool
+		expected     string
+	}{
+		{
+			description:  "csv",
+			outputFormat: print.CSVOutputFormat,
+			expected:     "ID,NAME\nid-1,name-1\n\nKEY,VALUE\nfoo,bar\n",
+		},
+		{
+			description:  "tsv without headers",
+			outputFormat: print.TSVOutputFormat,
+			noHeaders:    true,
+			expected:     "id-1\tname-1\n\nfoo\tbar\n",
+		},
+		{
+			description:  "markdown",
+			outputFormat: print.MarkdownOutputFormat,
+			expected:     "| ID | NAME |\n| --- | --- |\n| id-1 | name-1 |\n\n| KEY | VALUE |\n| --- | --- |\n| foo | bar |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			viper.Reset()
+			var buf bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&buf)
+			err := globalflags.Configure(cmd.Flags())
+			if err != nil {
+				t.Fatalf("configure global flags: %v", err)
+			}
+			if tt.noHeaders {
+				err = cmd.Flags().Set(globalflags.NoHeadersFlag, "true")
+				if err != nil {
+					t.Fatalf("set no headers: %v", err)
+				}
+			}
+			p := &print.Printer{
+				Cmd:       cmd,
+				Verbosity: print.ErrorLevel,
+			}
+
+			table1 := NewTable()
+			table1.SetHeader("ID", "NAME")
+			table1.AddRow("id-1", "name-1")
+			table2 := NewTable()
+			table2.SetHeader("KEY", "VALUE")
+			table2.AddRow("foo", "bar")
+
+			err = DisplayTables(p, []Table{table1, table2}, tt.outputFormat)
+			if err != nil {
+				t.Fatalf("display tables: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestDisplayTablesSortBy(t *testing.T) {
+	tests := []struct {
+		description    string
+		columnsEnabled bool
+		expected       string
+	}{
+		{
+			description:    "columns enabled",
+			columnsEnabled: true,
+			expected:       "NAME\na\nb\n",
+		},
+		{
+			description: "sort-by flag of a command not supporting columns",
+			expected:    "NAME\nb\na\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var buf bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&buf)
+			if tt.columnsEnabled {
+				EnableColumns(cmd)
+			} else {
+				cmd.Flags().String(SortByFlag, "", "")
+			}
+			err := cmd.Flags().Set(SortByFlag, "name")
+			if err != nil {
+				t.Fatalf("set sort by: %v", err)
+			}
+			p := &print.Printer{
+				Cmd:       cmd,
+				Verbosity: print.ErrorLevel,
+			}
+
+			table := NewTable()
+			table.SetHeader("NAME")
+			table.AddRow("b")
+			table.AddRow("a")
+
+			err = table.Display(p, print.CSVOutputFormat)
+			if err != nil {
+				t.Fatalf("display table: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const indent = "  "
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Block of a Terraform configuration, e.g. a resource
+type Block struct {
+	Type       string
+	Labels     []string
+	Attributes []Attribute
+	Blocks     []*Block
+}
+
+// Attribute of a block or an object
+type Attribute struct {
+	Name  string
+	Value any
+}
+
+// Reference is an expression referring to another object, e.g. "var.project_id". It is written without quotes.
+type Reference string
+
+// Object is written as an object with the attributes, e.g. the nested attributes of a resource
+type Object []Attribute
+
+// NewResource returns the block of a resource with the type and name
+func NewResource(resourceType, name string) *Block {
+	return &Block{Type: "resource", Labels: []string{resourceType, name}}
+}
+
+// Set adds the attribute to the block. Empty values, i.e. nil pointers, empty strings, slices and maps,
+// are left out, so that the default of the provider is used.
+func (b *Block) Set(name string, value any) *Block {
+	b.Attributes = setAttribute(b.Attributes, name, value)
+	return b
+}
+
+// Set adds the attribute to the object, leaving out empty values as Block.Set
+func (o Object) Set(name string, value any) Object {
+	return setAttribute(o, name, value)
+}
+
+func setAttribute(attributes []Attribute, name string, value any) []Attribute {
+	value = dereference(value)
+	if isEmpty(value) {
+		return attributes
+	}
+	return append(attributes, Attribute{Name: name, Value: value})
+}
+
+func dereference(value any) any {
+	switch v := value.(type) {
+	case *string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *int64:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *bool:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *[]string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *map[string]string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	}
+	return value
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case Reference:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case map[string]string:
+		return len(v) == 0
+	case Object:
+		return len(v) == 0
+	case []Object:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Name converts the name of a resource into a valid Terraform name, e.g. "my server" into "my_server"
+func Name(name string) string {
+	converted := invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_")
+	converted = strings.Trim(converted, "_")
+	if converted == "" || (converted[0] >= '0' && converted[0] <= '9') || converted[0] == '-' {
+		converted = "_" + converted
+	}
+	return converted
+}
+
+// Names hands out unique Terraform names per resource type
+type Names map[string]int
+
+// Unique returns the Terraform name of the resource, adding a suffix if the name is already used for the type
+func (n Names) Unique(resourceType, name string) string {
+	converted := Name(name)
+	key := resourceType + "." + converted
+	n[key]++
+	if n[key] == 1 {
+		return converted
+	}
+	return fmt.Sprintf("%s_%d", converted, n[key])
+}
+
+// String returns the block in HCL syntax
+func (b *Block) String() string {
+	var sb strings.Builder
+	b.write(&sb, 0)
+	return sb.String()
+}
+
+func (b *Block) write(sb *strings.Builder, level int) {
+	prefix := strings.Repeat(indent, level)
+	sb.WriteString(prefix + b.Type)
+	for _, label := range b.Labels {
+		sb.WriteString(" " + quote(label))
+	}
+	sb.WriteString(" {\n")
+	writeAttributes(sb, b.Attributes, level+1)
+	for i, nested := range b.Blocks {
+		// Nested blocks are separated by an empty line from the attributes and from each other
+		if i > 0 || len(b.Attributes) > 0 {
+			sb.WriteString("\n")
+		}
+		nested.write(sb, level+1)
+	}
+	sb.WriteString(prefix + "}\n")
+}
+
+// writeAttributes writes one attribute per line, aligning the equal signs like "terraform fmt"
+func writeAttributes(sb *strings.Builder, attributes []Attribute, level int) {
+	width := 0
+	for _, a := range attributes {
+		width = max(width, len(a.Name))
+	}
+	prefix := strings.Repeat(indent, level)
+	for _, a := range attributes {
+		fmt.Fprintf(sb, "%s%-*s = %s\n", prefix, width, a.Name, formatValue(a.Value, level))
+	}
+}
+
+func formatValue(value any, level int) string {
+	switch v := value.(type) {
+	case string:
+		return quote(v)
+	case Reference:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		items := make([]string, len(v))
+		for i := range v {
+			items[i] = quote(v[i])
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		object := Object{}
+		for _, k := range keys {
+			object = append(object, Attribute{Name: quote(k), Value: v[k]})
+		}
+		return formatValue(object, level)
+	case Object:
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		writeAttributes(&sb, v, level+1)
+		sb.WriteString(strings.Repeat(indent, level) + "}")
+		return sb.String()
+	case []Object:
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		prefix := strings.Repeat(indent, level+1)
+		for _, object := range v {
+			sb.WriteString(prefix + formatValue(object, level+1) + ",\n")
+		}
+		sb.WriteString(strings.Repeat(indent, level) + "]")
+		return sb.String()
+	}
+	return quote(fmt.Sprintf("%v", value))
+}
+
+// quote returns the string as a quoted HCL string, escaping template sequences
+func quote(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// NewVariable returns the block of a string variable with the default value
+func NewVariable(name, defaultValue string) *Block {
+	return (&Block{Type: "variable", Labels: []string{name}}).
+		Set("type", Reference("string")).
+		Set("default", defaultValue)
+}
@@ -0,0 +1,120 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		description string
+		block       *Block
+		expected    string
+	}{
+		{
+			description: "resource",
+			block: NewResource("stackit_server", "web").
+				Set("project_id", Reference("var.project_id")).
+				Set("name", "web").
+				Set("keypair_name", utils.Ptr("")).
+				Set("description", (*string)(nil)).
+				Set("size", utils.Ptr(int64(64))).
+				Set("stateful", utils.Ptr(false)).
+				Set("nameservers", []string{"1.1.1.1", "8.8.8.8"}).
+				Set("labels", map[string]string{"b": "2", "a": "1"}).
+				Set("port_range", Object{}.Set("min", int64(80)).Set("max", int64(443))).
+				Set("node_pools", []Object{Object{}.Set("name", "pool")}),
+			expected: `resource "stackit_server" "web" {
+  project_id  = var.project_id
+  name        = "web"
+  size        = 64
+  stateful    = false
+  nameservers = ["1.1.1.1", "8.8.8.8"]
+  labels      = {
+    "a" = "1"
+    "b" = "2"
+  }
+  port_range  = {
+    min = 80
+    max = 443
+  }
+  node_pools  = [
+    {
+      name = "pool"
+    },
+  ]
+}
+`,
+		},
+		{
+			description: "nested blocks",
+			block: &Block{Type: "terraform", Blocks: []*Block{
+				(&Block{Type: "required_providers"}).Set("stackit", Object{}.Set("source", "stackitcloud/stackit")),
+			}},
+			expected: `terraform {
+  required_providers {
+    stackit = {
+      source = "stackitcloud/stackit"
+    }
+  }
+}
+`,
+		},
+		{
+			description: "template sequences",
+			block:       NewVariable("greeting", "${hello} %{world}"),
+			expected: `variable "greeting" {
+  type    = string
+  default = "$${hello} %%{world}"
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diff := cmp.Diff(tt.block.String(), tt.expected)
+			if diff != "" {
+				t.Fatalf("Output does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "web", expected: "web"},
+		{name: "My Server", expected: "my_server"},
+		{name: "www.example.com A", expected: "www_example_com_a"},
+		{name: "01-db", expected: "_01-db"},
+		{name: "", expected: "_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Name(tt.name); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	names := Names{}
+	got := []string{
+		names.Unique("stackit_server", "web"),
+		names.Unique("stackit_server", "Web"),
+		names.Unique("stackit_network", "web"),
+	}
+	expected := []string{"web", "web_2", "web"}
+	diff := cmp.Diff(got, expected)
+	if diff != "" {
+		t.Fatalf("Names do not match: %s", diff)
+	}
+}
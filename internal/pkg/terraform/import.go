@@ -0,0 +1,61 @@
+package terraform
+
+import (
+	"fmt"
+	"strings"
+)
+
+// importIdFormats are the parts of the import IDs expected by the STACKIT provider, which are joined by commas
+var importIdFormats = map[string][]string{
+	"stackit_network":               {"project_id", "network_id"},
+	"stackit_security_group":        {"project_id", "security_group_id"},
+	"stackit_security_group_rule":   {"project_id", "security_group_id", "security_group_rule_id"},
+	"stackit_server":                {"project_id", "server_id"},
+	"stackit_volume":                {"project_id", "volume_id"},
+	"stackit_dns_zone":              {"project_id", "zone_id"},
+	"stackit_dns_record_set":        {"project_id", "zone_id", "record_set_id"},
+	"stackit_postgresflex_instance": {"project_id", "region", "instance_id"},
+	"stackit_ske_cluster":           {"project_id", "region", "name"},
+	"stackit_logme_instance":        {"project_id", "instance_id"},
+	"stackit_mariadb_instance":      {"project_id", "instance_id"},
+	"stackit_opensearch_instance":   {"project_id", "instance_id"},
+	"stackit_rabbitmq_instance":     {"project_id", "instance_id"},
+	"stackit_redis_instance":        {"project_id", "instance_id"},
+}
+
+// ImportId returns the import ID of a resource of the type, with the IDs in the order of its format
+func ImportId(resourceType string, ids ...string) (string, error) {
+	parts, ok := importIdFormats[resourceType]
+	if !ok {
+		return "", fmt.Errorf("resource type %q can't be imported", resourceType)
+	}
+	if len(ids) != len(parts) {
+		return "", fmt.Errorf("import ID of %s needs %d parts (%s), got %d", resourceType, len(parts), strings.Join(parts, ", "), len(ids))
+	}
+	for i, id := range ids {
+		if id == "" {
+			return "", fmt.Errorf("import ID of %s: %s is empty", resourceType, parts[i])
+		}
+	}
+	return strings.Join(ids, ","), nil
+}
+
+// NewImport returns the import block of the resource, e.g.
+//
+//	import {
+//	  to = stackit_server.web
+//	  id = "<project_id>,<server_id>"
+//	}
+func NewImport(resource *Block, ids ...string) (*Block, error) {
+	if resource.Type != "resource" || len(resource.Labels) != 2 {
+		return nil, fmt.Errorf("only resources can be imported")
+	}
+	resourceType, name := resource.Labels[0], resource.Labels[1]
+	id, err := ImportId(resourceType, ids...)
+	if err != nil {
+		return nil, err
+	}
+	return (&Block{Type: "import"}).
+		Set("to", Reference(resourceType+"."+name)).
+		Set("id", id), nil
+}
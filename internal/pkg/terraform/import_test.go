@@ -0,0 +1,96 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportId(t *testing.T) {
+	tests := []struct {
+		description  string
+		resourceType string
+		ids          []string
+		isValid      bool
+		expected     string
+	}{
+		{
+			description:  "server",
+			resourceType: "stackit_server",
+			ids:          []string{"project", "server"},
+			isValid:      true,
+			expected:     "project,server",
+		},
+		{
+			description:  "security group rule",
+			resourceType: "stackit_security_group_rule",
+			ids:          []string{"project", "group", "rule"},
+			isValid:      true,
+			expected:     "project,group,rule",
+		},
+		{
+			description:  "regional resource",
+			resourceType: "stackit_ske_cluster",
+			ids:          []string{"project", "eu01", "cluster"},
+			isValid:      true,
+			expected:     "project,eu01,cluster",
+		},
+		{
+			description:  "missing part",
+			resourceType: "stackit_dns_record_set",
+			ids:          []string{"project", "zone"},
+			isValid:      false,
+		},
+		{
+			description:  "empty part",
+			resourceType: "stackit_server",
+			ids:          []string{"project", ""},
+			isValid:      false,
+		},
+		{
+			description:  "unknown resource type",
+			resourceType: "stackit_unknown",
+			ids:          []string{"project", "id"},
+			isValid:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			id, err := ImportId(tt.resourceType, tt.ids...)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if id != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, id)
+			}
+		})
+	}
+}
+
+func TestNewImport(t *testing.T) {
+	block, err := NewImport(NewResource("stackit_server", "web01"), "project", "server")
+	if err != nil {
+		t.Fatalf("NewImport() failed: %v", err)
+	}
+	expected := `import {
+  to = stackit_server.web01
+  id = "project,server"
+}
+`
+	diff := cmp.Diff(block.String(), expected)
+	if diff != "" {
+		t.Fatalf("Output does not match: %s", diff)
+	}
+
+	_, err = NewImport(NewVariable("project_id", "project"), "project")
+	if err == nil {
+		t.Fatalf("NewImport() did not fail for a variable")
+	}
+}
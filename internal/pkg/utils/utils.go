@@ -66,6 +66,20 @@ func ValidateUUID(value string) error {
 	return nil
 }
 
+// IsBuiltinCommand returns whether the name is one of the subcommands of the root command, or one of the commands added by Cobra
+func IsBuiltinCommand(root *cobra.Command, name string) bool {
+	switch name {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // ConvertInt64PToFloat64P converts an int64 pointer to a float64 pointer
 // This function will return nil if the input is nil
 func ConvertInt64PToFloat64P(i *int64) *float64 {
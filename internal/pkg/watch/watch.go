@@ -0,0 +1,304 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	WatchFlag    = "watch"
+	IntervalFlag = "interval"
+
+	intervalDefault = 5 * time.Second
+	// Shortest interval accepted by the --interval flag, to avoid flooding the API with requests
+	minInterval = time.Second
+
+	// Moves the cursor to the top left corner and clears the screen
+	clearScreen = "\033[H\033[2J"
+
+	EventTypeAdded    = "ADDED"
+	EventTypeModified = "MODIFIED"
+	EventTypeDeleted  = "DELETED"
+)
+
+// Event is printed (as newline-delimited JSON) for each change of the output when watching with the "json" output format
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Object    any       `json:"object"`
+}
+
+// Enable adds the --watch and --interval flags to the command.
+// When the --watch flag is set, the command's RunE (which fetches the resources and outputs them) is called repeatedly
+// until it fails or the user interrupts it.
+func Enable(p *print.Printer, cmd *cobra.Command) {
+	runE := cmd.RunE
+	if runE == nil {
+		return
+	}
+	cmd.Flags().Bool(WatchFlag, false, `If set, the command is run repeatedly and its output is refreshed. With the "json" output format, changes are printed as newline-delimited JSON events`)
+	cmd.Flags().Duration(IntervalFlag, intervalDefault, fmt.Sprintf("Time between refreshes of the output when the --%s flag is set", WatchFlag))
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !flags.FlagToBoolValue(p, cmd, WatchFlag) {
+			return runE(cmd, args)
+		}
+		globalFlags := globalflags.Parse(p, cmd)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		w := &watcher{
+			cmd:           cmd,
+			outputFormat:  globalFlags.OutputFormat,
+			interval:      flags.FlagWithDefaultToDurationValue(p, cmd, IntervalFlag),
+			isTerminal:    isTerminal(cmd.OutOrStdout()),
+			endOnNotFound: cmd.Name() == "describe",
+			now:           time.Now,
+			run: func() error {
+				return runE(cmd, args)
+			},
+		}
+		return w.watch(ctx)
+	}
+}
+
+// Validate returns an error if the --watch flag is set and the --interval flag is invalid
+func Validate(p *print.Printer, cmd *cobra.Command) error {
+	if !flags.FlagToBoolValue(p, cmd, WatchFlag) {
+		return nil
+	}
+	interval := flags.FlagWithDefaultToDurationValue(p, cmd, IntervalFlag)
+	if interval < minInterval {
+		return &errors.FlagValidationError{
+			Flag:    IntervalFlag,
+			Details: fmt.Sprintf("must be at least %s", minInterval),
+		}
+	}
+	return nil
+}
+
+type watcher struct {
+	cmd          *cobra.Command
+	outputFormat string
+	interval     time.Duration
+	isTerminal   bool
+	// Whether a resource that is not found anymore ends the watch, as describe commands watch a single resource
+	endOnNotFound bool
+	now           func() time.Time
+	run           func() error
+
+	// Whether a previous run succeeded
+	succeeded bool
+	// Output of the previous run
+	previousOutput []byte
+	// JSON objects of the previous run, by key, and their order
+	previousObjects map[string]any
+	previousKeys    []string
+}
+
+func (w *watcher) watch(ctx context.Context) error {
+	out := w.cmd.OutOrStdout()
+	errOut := w.cmd.ErrOrStderr()
+	defer func() {
+		w.cmd.SetOut(out)
+		w.cmd.SetErr(errOut)
+	}()
+
+	for {
+		// The output of each run is captured, so that it can be redrawn or compared with the previous one
+		var outBuf, errBuf bytes.Buffer
+		w.cmd.SetOut(&outBuf)
+		w.cmd.SetErr(&errBuf)
+		err := w.run()
+		w.cmd.SetOut(out)
+		w.cmd.SetErr(errOut)
+		if err != nil {
+			if w.endOnNotFound && w.succeeded && errors.ExitCode(err) == errors.ExitCodeNotFound {
+				return w.writeDeleted(out)
+			}
+			return err
+		}
+		w.succeeded = true
+
+		if w.isJSON() {
+			_, err = errOut.Write(errBuf.Bytes())
+			if err != nil {
+				return fmt.Errorf("write output: %w", err)
+			}
+			err = w.writeEvents(out, outBuf.Bytes())
+		} else {
+			err = w.writeOutput(out, outBuf.Bytes(), errBuf.Bytes())
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.interval):
+		}
+	}
+}
+
+func (w *watcher) isJSON() bool {
+	format, _ := print.ParseOutputFormat(w.outputFormat)
+	return format == print.JSONOutputFormat
+}
+
+// Writes that the watched resource was deleted, as a DELETED event with the "json" output format
+func (w *watcher) writeDeleted(out io.Writer) error {
+	if w.isJSON() {
+		return w.writeEvents(out, nil)
+	}
+	_, err := fmt.Fprintln(out, "The resource was deleted")
+	if err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	return nil
+}
+
+// Writes the output of a run. On a terminal, the screen is redrawn with the latest output.
+// Otherwise, the output is only written if it changed
+func (w *watcher) writeOutput(out io.Writer, output, errOutput []byte) error {
+	if w.isTerminal {
+		header := fmt.Sprintf("Every %s: %s    %s\n", w.interval, w.cmd.CommandPath(), w.now().Format(time.DateTime))
+		_, err := fmt.Fprintf(out, "%s%s%s%s", clearScreen, header, errOutput, output)
+		if err != nil {
+			return fmt.Errorf("write output: %w", err)
+		}
+		return nil
+	}
+
+	if w.previousOutput != nil && bytes.Equal(w.previousOutput, output) {
+		return nil
+	}
+	w.previousOutput = output
+	_, err := fmt.Fprintf(out, "%s%s", errOutput, output)
+	if err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	return nil
+}
+
+// Writes an event for each object that was added, modified or deleted since the previous run.
+// List outputs are compared item by item, other outputs as a whole
+func (w *watcher) writeEvents(out io.Writer, output []byte) error {
+	objects, keys, err := parseObjects(output)
+	if err != nil {
+		return err
+	}
+
+	events := []Event{}
+	timestamp := w.now()
+	for _, key := range keys {
+		previous, ok := w.previousObjects[key]
+		switch {
+		case !ok:
+			events = append(events, Event{Type: EventTypeAdded, Timestamp: timestamp, Object: objects[key]})
+		case !jsonEqual(previous, objects[key]):
+			events = append(events, Event{Type: EventTypeModified, Timestamp: timestamp, Object: objects[key]})
+		}
+	}
+	for _, key := range w.previousKeys {
+		if _, ok := objects[key]; !ok {
+			events = append(events, Event{Type: EventTypeDeleted, Timestamp: timestamp, Object: w.previousObjects[key]})
+		}
+	}
+	w.previousObjects = objects
+	w.previousKeys = keys
+
+	for _, e := range events {
+		details, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		_, err = fmt.Fprintf(out, "%s\n", details)
+		if err != nil {
+			return fmt.Errorf("write event: %w", err)
+		}
+	}
+	return nil
+}
+
+// Parses the JSON output of a run into objects identified by a key.
+// Items of a list are identified by their "id" or "name" field, other outputs are a single object.
+// An empty output (e.g. when a list command finds no items) has no objects
+func parseObjects(output []byte) (objects map[string]any, keys []string, err error) {
+	objects = map[string]any{}
+	if len(bytes.TrimSpace(output)) == 0 {
+		return objects, keys, nil
+	}
+
+	var data any
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	decoder.UseNumber()
+	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse JSON output: %w", err)
+	}
+
+	items, ok := data.([]any)
+	if !ok {
+		return map[string]any{"": data}, []string{""}, nil
+	}
+	for i, item := range items {
+		key := itemKey(item, i)
+		if _, exists := objects[key]; exists {
+			key = fmt.Sprintf("%s#%d", key, i)
+		}
+		objects[key] = item
+		keys = append(keys, key)
+	}
+	return objects, keys, nil
+}
+
+func itemKey(item any, index int) string {
+	object, ok := item.(map[string]any)
+	if !ok {
+		return fmt.Sprintf("#%d", index)
+	}
+	for _, field := range []string{"id", "name"} {
+		for k, v := range object {
+			if strings.EqualFold(k, field) {
+				if s, ok := v.(string); ok && s != "" {
+					return s
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+func jsonEqual(a, b any) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,328 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+var testTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// Returns a watcher whose runs print the given outputs, and fail once all outputs are printed
+func newTestWatcher(outputFormat string, isTerminal bool, outputs []string) *watcher {
+	cmd := &cobra.Command{Use: "list"}
+	runs := 0
+	w := &watcher{
+		cmd:          cmd,
+		outputFormat: outputFormat,
+		interval:     time.Millisecond,
+		isTerminal:   isTerminal,
+		now:          func() time.Time { return testTime },
+	}
+	w.run = func() error {
+		if runs >= len(outputs) {
+			return fmt.Errorf("no more outputs")
+		}
+		cmd.Print(outputs[runs])
+		runs++
+		return nil
+	}
+	return w
+}
+
+func TestWatchEvents(t *testing.T) {
+	tests := []struct {
+		description    string
+		outputs        []string
+		expectedOutput string
+	}{
+		{
+			description: "list",
+			outputs: []string{
+				`[{"id": "id-1", "status": "CREATING"}, {"id": "id-2", "status": "ACTIVE"}]`,
+				`[{"id": "id-1", "status": "CREATING"}, {"id": "id-2", "status": "ACTIVE"}]`,
+				`[{"id": "id-1", "status": "ACTIVE"}, {"id": "id-3", "status": "CREATING"}]`,
+			},
+			expectedOutput: `{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"CREATING"}}
+{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-2","status":"ACTIVE"}}
+{"type":"MODIFIED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"ACTIVE"}}
+{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-3","status":"CREATING"}}
+{"type":"DELETED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-2","status":"ACTIVE"}}
+`,
+		},
+		{
+			description: "list becomes empty",
+			outputs: []string{
+				`[{"name": "a", "size": 10}]`,
+				``,
+			},
+			expectedOutput: `{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"name":"a","size":10}}
+{"type":"DELETED","timestamp":"2024-01-01T12:00:00Z","object":{"name":"a","size":10}}
+`,
+		},
+		{
+			description: "single object",
+			outputs: []string{
+				`{"id": "id-1", "status": "CREATING"}`,
+				`{"id": "id-1", "status": "CREATING"}`,
+				`{"id": "id-1", "status": "ACTIVE"}`,
+			},
+			expectedOutput: `{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"CREATING"}}
+{"type":"MODIFIED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"ACTIVE"}}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			w := newTestWatcher(print.JSONOutputFormat, false, tt.outputs)
+			var buf bytes.Buffer
+			w.cmd.SetOut(&buf)
+
+			err := w.watch(cancelledAfterRuns(w, len(tt.outputs)))
+			if err != nil {
+				t.Fatalf("failed to watch: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedOutput, buf.String()); diff != "" {
+				t.Errorf("output does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWatchOutput(t *testing.T) {
+	outputs := []string{"table 1\n", "table 1\n", "table 2\n"}
+
+	t.Run("terminal", func(t *testing.T) {
+		w := newTestWatcher(print.PrettyOutputFormat, true, outputs)
+		var buf bytes.Buffer
+		w.cmd.SetOut(&buf)
+
+		err := w.watch(cancelledAfterRuns(w, len(outputs)))
+		if err != nil {
+			t.Fatalf("failed to watch: %v", err)
+		}
+		header := "Every 1ms: list    2024-01-01 12:00:00\n"
+		expected := clearScreen + header + "table 1\n" + clearScreen + header + "table 1\n" + clearScreen + header + "table 2\n"
+		if diff := cmp.Diff(expected, buf.String()); diff != "" {
+			t.Errorf("output does not match: %s", diff)
+		}
+	})
+
+	t.Run("not a terminal", func(t *testing.T) {
+		w := newTestWatcher(print.PrettyOutputFormat, false, outputs)
+		var buf bytes.Buffer
+		w.cmd.SetOut(&buf)
+
+		err := w.watch(cancelledAfterRuns(w, len(outputs)))
+		if err != nil {
+			t.Fatalf("failed to watch: %v", err)
+		}
+		if diff := cmp.Diff("table 1\ntable 2\n", buf.String()); diff != "" {
+			t.Errorf("output does not match: %s", diff)
+		}
+	})
+}
+
+func TestWatchError(t *testing.T) {
+	w := newTestWatcher(print.PrettyOutputFormat, false, []string{})
+	var buf bytes.Buffer
+	w.cmd.SetOut(&buf)
+
+	err := w.watch(context.Background())
+	if err == nil {
+		t.Fatalf("did not fail when the command failed")
+	}
+	if w.cmd.OutOrStdout() != &buf {
+		t.Errorf("output of the command was not restored")
+	}
+}
+
+func TestWatchDeleted(t *testing.T) {
+	tests := []struct {
+		description    string
+		outputFormat   string
+		endOnNotFound  bool
+		outputs        []string
+		isValid        bool
+		expectedOutput string
+	}{
+		{
+			description:   "json",
+			outputFormat:  print.JSONOutputFormat,
+			endOnNotFound: true,
+			outputs:       []string{`{"id": "id-1", "status": "ACTIVE"}`},
+			isValid:       true,
+			expectedOutput: `{"type":"ADDED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"ACTIVE"}}
+{"type":"DELETED","timestamp":"2024-01-01T12:00:00Z","object":{"id":"id-1","status":"ACTIVE"}}
+`,
+		},
+		{
+			description:    "pretty",
+			outputFormat:   print.PrettyOutputFormat,
+			endOnNotFound:  true,
+			outputs:        []string{"table 1\n"},
+			isValid:        true,
+			expectedOutput: "table 1\nThe resource was deleted\n",
+		},
+		{
+			description:   "not found in first run",
+			outputFormat:  print.JSONOutputFormat,
+			endOnNotFound: true,
+			outputs:       []string{},
+			isValid:       false,
+		},
+		{
+			description:  "list",
+			outputFormat: print.JSONOutputFormat,
+			outputs:      []string{`[{"id": "id-1"}]`},
+			isValid:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			w := newTestWatcher(tt.outputFormat, false, tt.outputs)
+			w.endOnNotFound = tt.endOnNotFound
+			// The resource is not found once all outputs are printed
+			run := w.run
+			w.run = func() error {
+				err := run()
+				if err != nil {
+					return fmt.Errorf("get instance: %w", oapierror.NewError(http.StatusNotFound, "Not Found"))
+				}
+				return nil
+			}
+			var buf bytes.Buffer
+			w.cmd.SetOut(&buf)
+
+			err := w.watch(context.Background())
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail when the resource was not found")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to watch: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedOutput, buf.String()); diff != "" {
+				t.Errorf("output does not match: %s", diff)
+			}
+		})
+	}
+}
+
+// Returns a context that is cancelled after the given number of runs of the watcher
+func cancelledAfterRuns(w *watcher, runs int) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	run := w.run
+	count := 0
+	w.run = func() error {
+		err := run()
+		count++
+		if count == runs {
+			cancel()
+		}
+		return err
+	}
+	return ctx
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		enabled     bool
+		flagValues  map[string]string
+		isValid     bool
+	}{
+		{
+			description: "watch not set",
+			enabled:     false,
+			flagValues:  map[string]string{},
+			isValid:     true,
+		},
+		{
+			description: "watch enabled command",
+			enabled:     true,
+			flagValues:  map[string]string{WatchFlag: "true"},
+			isValid:     true,
+		},
+		{
+			description: "custom interval",
+			enabled:     true,
+			flagValues:  map[string]string{WatchFlag: "true", IntervalFlag: "1m"},
+			isValid:     true,
+		},
+		{
+			description: "interval too short",
+			enabled:     true,
+			flagValues:  map[string]string{WatchFlag: "true", IntervalFlag: "100ms"},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p := print.NewPrinter()
+			cmd := &cobra.Command{
+				Use:  "describe",
+				RunE: func(*cobra.Command, []string) error { return nil },
+			}
+			p.Cmd = cmd
+			err := globalflags.Configure(cmd.Flags())
+			if err != nil {
+				t.Fatalf("failed to configure global flags: %v", err)
+			}
+			if tt.enabled {
+				Enable(p, cmd)
+			}
+			for flag, value := range tt.flagValues {
+				err := cmd.Flags().Set(flag, value)
+				if err != nil {
+					t.Fatalf("failed to set flag %q: %v", flag, err)
+				}
+			}
+
+			err = Validate(p, cmd)
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if hasFlag := cmd.Flags().Lookup(WatchFlag) != nil; hasFlag != tt.enabled {
+				t.Errorf("expected the --%s flag to be defined: %t", WatchFlag, tt.enabled)
+			}
+		})
+	}
+}
+
+func TestItemKey(t *testing.T) {
+	items := []string{
+		`{"id": "id-1", "name": "a"}`,
+		`{"ID": "id-2"}`,
+		`{"name": "b"}`,
+		`{"size": 10}`,
+		`"value"`,
+	}
+	objects, keys, err := parseObjects([]byte("[" + strings.Join(items, ",") + "]"))
+	if err != nil {
+		t.Fatalf("failed to parse objects: %v", err)
+	}
+	expectedKeys := []string{"id-1", "id-2", "b", "#3", "#4"}
+	if diff := cmp.Diff(expectedKeys, keys); diff != "" {
+		t.Errorf("keys do not match: %s", diff)
+	}
+	if len(objects) != len(items) {
+		t.Errorf("expected %d objects, got %d", len(items), len(objects))
+	}
+}